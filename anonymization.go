@@ -0,0 +1,170 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// These constants are the statuses of an AnonymizationProgress
+const (
+	AnonymizationStatusInProgress         = "IN_PROGRESS"
+	AnonymizationStatusCompleted          = "COMPLETED"
+	AnonymizationStatusInterrupted        = "INTERRUPTED"
+	AnonymizationStatusVerificationFailed = "VERIFICATION_FAILED"
+	AnonymizationStatusUndefined          = "UNDEFINED"
+)
+
+// AnonymizationMessages contains the messages of a single anonymization error or warning
+type AnonymizationMessages struct {
+	ErrorMessages []string          `json:"errorMessages,omitempty" structs:"errorMessages,omitempty"`
+	Errors        map[string]string `json:"errors,omitempty" structs:"errors,omitempty"`
+}
+
+// AnonymizationAffectedEntity is an entity which is changed by the anonymization of a user, e.g. a project lead
+type AnonymizationAffectedEntity struct {
+	Type                string `json:"type,omitempty" structs:"type,omitempty"`
+	Description         string `json:"description,omitempty" structs:"description,omitempty"`
+	NumberOfOccurrences int    `json:"numberOfOccurrences,omitempty" structs:"numberOfOccurrences,omitempty"`
+	URI                 string `json:"uriDisplayName,omitempty" structs:"uriDisplayName,omitempty"`
+}
+
+// AnonymizationValidation is the result of the validation of the anonymization of a user.
+// The anonymization can only be scheduled if there are no Errors, the Warnings should be checked by the admin.
+type AnonymizationValidation struct {
+	Errors                        map[string]AnonymizationMessages         `json:"errors,omitempty" structs:"errors,omitempty"`
+	Warnings                      map[string]AnonymizationMessages         `json:"warnings,omitempty" structs:"warnings,omitempty"`
+	Expand                        string                                   `json:"expand,omitempty" structs:"expand,omitempty"`
+	Deleted                       bool                                     `json:"deleted,omitempty" structs:"deleted,omitempty"`
+	Email                         string                                   `json:"email,omitempty" structs:"email,omitempty"`
+	UserKey                       string                                   `json:"userKey,omitempty" structs:"userKey,omitempty"`
+	UserName                      string                                   `json:"userName,omitempty" structs:"userName,omitempty"`
+	DisplayName                   string                                   `json:"displayName,omitempty" structs:"displayName,omitempty"`
+	Operations                    []string                                 `json:"operations,omitempty" structs:"operations,omitempty"`
+	BusinessLogicValidationFailed bool                                     `json:"businessLogicValidationFailed,omitempty" structs:"businessLogicValidationFailed,omitempty"`
+	AffectedEntities              map[string][]AnonymizationAffectedEntity `json:"affectedEntities,omitempty" structs:"affectedEntities,omitempty"`
+}
+
+// AnonymizationProgress is the progress of a scheduled anonymization
+type AnonymizationProgress struct {
+	Errors          map[string]AnonymizationMessages `json:"errors,omitempty" structs:"errors,omitempty"`
+	Warnings        map[string]AnonymizationMessages `json:"warnings,omitempty" structs:"warnings,omitempty"`
+	UserKey         string                           `json:"userKey,omitempty" structs:"userKey,omitempty"`
+	UserName        string                           `json:"userName,omitempty" structs:"userName,omitempty"`
+	FullName        string                           `json:"fullName,omitempty" structs:"fullName,omitempty"`
+	ProgressURL     string                           `json:"progressUrl,omitempty" structs:"progressUrl,omitempty"`
+	CurrentProgress int                              `json:"currentProgress,omitempty" structs:"currentProgress,omitempty"`
+	CurrentSubTask  string                           `json:"currentSubTask,omitempty" structs:"currentSubTask,omitempty"`
+	SubmittedTime   string                           `json:"submittedTime,omitempty" structs:"submittedTime,omitempty"`
+	StartTime       string                           `json:"startTime,omitempty" structs:"startTime,omitempty"`
+	FinishTime      string                           `json:"finishTime,omitempty" structs:"finishTime,omitempty"`
+	Operations      []string                         `json:"operations,omitempty" structs:"operations,omitempty"`
+	Status          string                           `json:"status,omitempty" structs:"status,omitempty"`
+	IsRerun         bool                             `json:"isRerun,omitempty" structs:"isRerun,omitempty"`
+}
+
+// Done reports if the anonymization is finished, successfully or not
+func (p *AnonymizationProgress) Done() bool {
+	return p.Status != AnonymizationStatusInProgress && p.Status != ""
+}
+
+// ValidateAnonymizationWithContext validates the anonymization of the user with the given key.
+// expand is a comma separated list of the details to include, e.g. "affectedEntities".
+// JIRA Server / Data Center only, the user needs the system administrator permission.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.7.0/#api/2/user/anonymization-validateUserAnonymization
+func (s *UserService) ValidateAnonymizationWithContext(ctx context.Context, userKey, expand string) (*AnonymizationValidation, *Response, error) {
+	apiEndpoint, err := addOptions("rest/api/2/user/anonymization", &struct {
+		UserKey string `url:"userKey"`
+		Expand  string `url:"expand,omitempty"`
+	}{userKey, expand})
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	validation := new(AnonymizationValidation)
+	resp, err := s.client.Do(req, validation)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return validation, resp, nil
+}
+
+// ValidateAnonymization wraps ValidateAnonymizationWithContext using the background context.
+func (s *UserService) ValidateAnonymization(userKey, expand string) (*AnonymizationValidation, *Response, error) {
+	return s.ValidateAnonymizationWithContext(context.Background(), userKey, expand)
+}
+
+// ScheduleAnonymizationWithContext schedules the anonymization of the user with the given key.
+// The entities owned by the user, e.g. projects they lead, are transferred to the user with newOwnerKey.
+// The returned task ID is used with GetAnonymizationProgress, it is 0 if JIRA did not return the progress URL.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.7.0/#api/2/user/anonymization-scheduleUserAnonymization
+func (s *UserService) ScheduleAnonymizationWithContext(ctx context.Context, userKey, newOwnerKey string) (int64, *Response, error) {
+	payload := struct {
+		UserKey     string `json:"userKey"`
+		NewOwnerKey string `json:"newOwnerKey,omitempty"`
+	}{userKey, newOwnerKey}
+	req, err := s.client.NewRequestWithContext(ctx, "POST", "rest/api/2/user/anonymization", payload)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return 0, resp, NewJiraError(resp, err)
+	}
+	defer resp.Body.Close()
+
+	return anonymizationTaskID(resp.Header.Get("Location")), resp, nil
+}
+
+// ScheduleAnonymization wraps ScheduleAnonymizationWithContext using the background context.
+func (s *UserService) ScheduleAnonymization(userKey, newOwnerKey string) (int64, *Response, error) {
+	return s.ScheduleAnonymizationWithContext(context.Background(), userKey, newOwnerKey)
+}
+
+// GetAnonymizationProgressWithContext returns the progress of the anonymization task with the given ID.
+// If taskID is 0, the progress of the last anonymization is returned.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.7.0/#api/2/user/anonymization-getProgress
+func (s *UserService) GetAnonymizationProgressWithContext(ctx context.Context, taskID int64) (*AnonymizationProgress, *Response, error) {
+	apiEndpoint := "rest/api/2/user/anonymization/progress"
+	if taskID != 0 {
+		apiEndpoint += fmt.Sprintf("?taskId=%d", taskID)
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	progress := new(AnonymizationProgress)
+	resp, err := s.client.Do(req, progress)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return progress, resp, nil
+}
+
+// GetAnonymizationProgress wraps GetAnonymizationProgressWithContext using the background context.
+func (s *UserService) GetAnonymizationProgress(taskID int64) (*AnonymizationProgress, *Response, error) {
+	return s.GetAnonymizationProgressWithContext(context.Background(), taskID)
+}
+
+// anonymizationTaskID returns the taskId of a progress URL, or 0 if there is none
+func anonymizationTaskID(progressURL string) int64 {
+	u, err := url.Parse(progressURL)
+	if err != nil {
+		return 0
+	}
+	id, err := strconv.ParseInt(u.Query().Get("taskId"), 10, 64)
+	if err != nil {
+		return 0
+	}
+	return id
+}
@@ -0,0 +1,69 @@
+package jira
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestUserService_ValidateAnonymization(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/anonymization", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/user/anonymization?expand=affectedEntities&userKey=JIRAUSER10100")
+		fmt.Fprint(w, `{"errors":{},"warnings":{"USER_NOT_DELETED":{"errorMessages":["The user is active"]}},"userKey":"JIRAUSER10100","userName":"fred","operations":["USER_NAME_CHANGE","USER_KEY_CHANGE"],"affectedEntities":{"ANONYMIZE":[{"type":"ANONYMIZE","description":"Comments","numberOfOccurrences":12}]}}`)
+	})
+
+	validation, _, err := testClient.User.ValidateAnonymization("JIRAUSER10100", "affectedEntities")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(validation.Errors) != 0 || validation.Warnings["USER_NOT_DELETED"].ErrorMessages[0] != "The user is active" {
+		t.Errorf("Unexpected validation %+v", validation)
+	}
+	if entities := validation.AffectedEntities["ANONYMIZE"]; len(entities) != 1 || entities[0].NumberOfOccurrences != 12 {
+		t.Errorf("Unexpected affected entities %+v", validation.AffectedEntities)
+	}
+}
+
+func TestUserService_ScheduleAnonymization(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/anonymization", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		b, _ := ioutil.ReadAll(r.Body)
+		if string(b) != `{"userKey":"JIRAUSER10100","newOwnerKey":"admin"}`+"\n" {
+			t.Errorf("Unexpected body %s", b)
+		}
+		w.Header().Set("Location", testServer.URL+"/rest/api/2/user/anonymization/progress?taskId=10200")
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	taskID, _, err := testClient.User.ScheduleAnonymization("JIRAUSER10100", "admin")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if taskID != 10200 {
+		t.Errorf("Expected the task ID 10200, got %d", taskID)
+	}
+}
+
+func TestUserService_GetAnonymizationProgress(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/anonymization/progress", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/user/anonymization/progress?taskId=10200")
+		fmt.Fprint(w, `{"userKey":"JIRAUSER10100","currentProgress":100,"status":"COMPLETED"}`)
+	})
+
+	progress, _, err := testClient.User.GetAnonymizationProgress(10200)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if !progress.Done() || progress.CurrentProgress != 100 {
+		t.Errorf("Expected a completed anonymization, got %+v", progress)
+	}
+}
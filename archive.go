@@ -0,0 +1,229 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// These constants are the statuses of a TaskProgress
+const (
+	TaskStatusEnqueued        = "ENQUEUED"
+	TaskStatusRunning         = "RUNNING"
+	TaskStatusComplete        = "COMPLETE"
+	TaskStatusFailed          = "FAILED"
+	TaskStatusCancelRequested = "CANCEL_REQUESTED"
+	TaskStatusCancelled       = "CANCELLED"
+	TaskStatusDead            = "DEAD"
+)
+
+// IssueArchivalResult is the result of ArchiveIssues and RestoreIssues
+type IssueArchivalResult struct {
+	// Errors contains the issues which could not be archived or restored, by the reason of the failure
+	Errors                map[string]IssueArchivalError `json:"errors,omitempty" structs:"errors,omitempty"`
+	NumberOfIssuesUpdated int                           `json:"numberOfIssuesUpdated" structs:"numberOfIssuesUpdated"`
+}
+
+// IssueArchivalError lists the issues which failed for the same reason
+type IssueArchivalError struct {
+	Count          int      `json:"count" structs:"count"`
+	IssueIdsOrKeys []string `json:"issueIdsOrKeys" structs:"issueIdsOrKeys"`
+	Message        string   `json:"message" structs:"message"`
+}
+
+// ArchivedIssuesExportOptions filters the archived issues of ExportArchivedIssues.
+// The dates are formatted as "2006-01-02".
+type ArchivedIssuesExportOptions struct {
+	ArchivedBy        []string                 `json:"archivedBy,omitempty"`
+	ArchivedDateRange *ArchivedIssuesDateRange `json:"archivedDateRange,omitempty"`
+	IssueTypes        []string                 `json:"issueTypes,omitempty"`
+	Projects          []string                 `json:"projects,omitempty"`
+	Reporters         []string                 `json:"reporters,omitempty"`
+}
+
+// ArchivedIssuesDateRange is the range of the archive dates of ArchivedIssuesExportOptions
+type ArchivedIssuesDateRange struct {
+	DateAfter  string `json:"dateAfter"`
+	DateBefore string `json:"dateBefore"`
+}
+
+// ArchivedIssuesExportTask is the task which generates the export of the archived issues
+type ArchivedIssuesExportTask struct {
+	TaskID        string `json:"taskId" structs:"taskId"`
+	Status        string `json:"status" structs:"status"`
+	Progress      int    `json:"progress" structs:"progress"`
+	SubmittedTime string `json:"submittedTime" structs:"submittedTime"`
+	Payload       string `json:"payload,omitempty" structs:"payload,omitempty"`
+}
+
+// TaskProgress is the progress of a long-running task of JIRA
+type TaskProgress struct {
+	Self           string          `json:"self" structs:"self"`
+	ID             string          `json:"id" structs:"id"`
+	Description    string          `json:"description,omitempty" structs:"description,omitempty"`
+	Status         string          `json:"status" structs:"status"`
+	Message        string          `json:"message,omitempty" structs:"message,omitempty"`
+	Result         json.RawMessage `json:"result,omitempty" structs:"result,omitempty"`
+	SubmittedBy    int64           `json:"submittedBy,omitempty" structs:"submittedBy,omitempty"`
+	Progress       int             `json:"progress" structs:"progress"`
+	ElapsedRuntime int64           `json:"elapsedRuntime,omitempty" structs:"elapsedRuntime,omitempty"`
+	Submitted      int64           `json:"submitted,omitempty" structs:"submitted,omitempty"`
+	Started        int64           `json:"started,omitempty" structs:"started,omitempty"`
+	Finished       int64           `json:"finished,omitempty" structs:"finished,omitempty"`
+	LastUpdate     int64           `json:"lastUpdate,omitempty" structs:"lastUpdate,omitempty"`
+}
+
+// Done reports if the task is finished, successfully or not
+func (t *TaskProgress) Done() bool {
+	switch t.Status {
+	case TaskStatusComplete, TaskStatusFailed, TaskStatusCancelled, TaskStatusDead:
+		return true
+	}
+	return false
+}
+
+// FileURL returns the location of the file generated by a completed export task, or "" if there is none.
+// The result is either the URL itself or an object with a "fileUrl".
+func (t *TaskProgress) FileURL() string {
+	var fileURL string
+	if err := json.Unmarshal(t.Result, &fileURL); err == nil {
+		return fileURL
+	}
+	var result struct {
+		FileURL string `json:"fileUrl"`
+	}
+	if err := json.Unmarshal(t.Result, &result); err == nil {
+		return result.FileURL
+	}
+	return ""
+}
+
+// ArchiveIssueWithContext archives a single issue. JIRA Data Center only.
+// Archived issues are read only and hidden from the search.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/issue-archiveIssue
+func (s *IssueService) ArchiveIssueWithContext(ctx context.Context, issueID string) (*Response, error) {
+	return s.archival(ctx, fmt.Sprintf("rest/api/2/issue/%s/archive", issueID))
+}
+
+// ArchiveIssue wraps ArchiveIssueWithContext using the background context.
+func (s *IssueService) ArchiveIssue(issueID string) (*Response, error) {
+	return s.ArchiveIssueWithContext(context.Background(), issueID)
+}
+
+// RestoreIssueWithContext restores a single archived issue. JIRA Data Center only.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/issue-restoreIssue
+func (s *IssueService) RestoreIssueWithContext(ctx context.Context, issueID string) (*Response, error) {
+	return s.archival(ctx, fmt.Sprintf("rest/api/2/issue/%s/restore", issueID))
+}
+
+// RestoreIssue wraps RestoreIssueWithContext using the background context.
+func (s *IssueService) RestoreIssue(issueID string) (*Response, error) {
+	return s.RestoreIssueWithContext(context.Background(), issueID)
+}
+
+// ArchiveIssuesWithContext archives up to 1000 issues, identified by ID or key. JIRA Cloud Premium and Enterprise only.
+// The issues which could not be archived are reported in the Errors of the result.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issues/#api-rest-api-2-issue-archive-put
+func (s *IssueService) ArchiveIssuesWithContext(ctx context.Context, issueIDs []string) (*IssueArchivalResult, *Response, error) {
+	return s.bulkArchival(ctx, "rest/api/2/issue/archive", issueIDs)
+}
+
+// ArchiveIssues wraps ArchiveIssuesWithContext using the background context.
+func (s *IssueService) ArchiveIssues(issueIDs []string) (*IssueArchivalResult, *Response, error) {
+	return s.ArchiveIssuesWithContext(context.Background(), issueIDs)
+}
+
+// RestoreIssuesWithContext restores up to 1000 archived issues, identified by ID or key. JIRA Cloud Premium and Enterprise only.
+// The issues which could not be restored are reported in the Errors of the result.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issues/#api-rest-api-2-issue-unarchive-put
+func (s *IssueService) RestoreIssuesWithContext(ctx context.Context, issueIDs []string) (*IssueArchivalResult, *Response, error) {
+	return s.bulkArchival(ctx, "rest/api/2/issue/unarchive", issueIDs)
+}
+
+// RestoreIssues wraps RestoreIssuesWithContext using the background context.
+func (s *IssueService) RestoreIssues(issueIDs []string) (*IssueArchivalResult, *Response, error) {
+	return s.RestoreIssuesWithContext(context.Background(), issueIDs)
+}
+
+// ExportArchivedIssuesWithContext starts the export of the archived issues matching options into a CSV file. JIRA Cloud only.
+// The export runs as a task, poll GetTask with the returned task ID until it is done
+// and download the file from TaskProgress.FileURL.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issues/#api-rest-api-2-issues-archive-export-put
+func (s *IssueService) ExportArchivedIssuesWithContext(ctx context.Context, options *ArchivedIssuesExportOptions) (*ArchivedIssuesExportTask, *Response, error) {
+	if options == nil {
+		options = &ArchivedIssuesExportOptions{}
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", "rest/api/2/issues/archive/export", options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	task := new(ArchivedIssuesExportTask)
+	resp, err := s.client.Do(req, task)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return task, resp, nil
+}
+
+// ExportArchivedIssues wraps ExportArchivedIssuesWithContext using the background context.
+func (s *IssueService) ExportArchivedIssues(options *ArchivedIssuesExportOptions) (*ArchivedIssuesExportTask, *Response, error) {
+	return s.ExportArchivedIssuesWithContext(context.Background(), options)
+}
+
+// GetTaskWithContext returns the progress of a long-running task, e.g. of ExportArchivedIssues.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-tasks/#api-rest-api-2-task-taskid-get
+func (s *IssueService) GetTaskWithContext(ctx context.Context, taskID string) (*TaskProgress, *Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "GET", fmt.Sprintf("rest/api/2/task/%s", taskID), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	task := new(TaskProgress)
+	resp, err := s.client.Do(req, task)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return task, resp, nil
+}
+
+// GetTask wraps GetTaskWithContext using the background context.
+func (s *IssueService) GetTask(taskID string) (*TaskProgress, *Response, error) {
+	return s.GetTaskWithContext(context.Background(), taskID)
+}
+
+func (s *IssueService) archival(ctx context.Context, apiEndpoint string) (*Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+func (s *IssueService) bulkArchival(ctx context.Context, apiEndpoint string, issueIDs []string) (*IssueArchivalResult, *Response, error) {
+	payload := struct {
+		IssueIdsOrKeys []string `json:"issueIdsOrKeys"`
+	}{issueIDs}
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(IssueArchivalResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result, resp, nil
+}
@@ -0,0 +1,83 @@
+package jira
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestIssueService_ArchiveIssue(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/archive", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		w.WriteHeader(http.StatusNoContent)
+	})
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/restore", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Issue.ArchiveIssue("EX-1"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if _, err := testClient.Issue.RestoreIssue("EX-1"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestIssueService_RestoreIssues(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/unarchive", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		b, _ := ioutil.ReadAll(r.Body)
+		if !strings.Contains(string(b), `"issueIdsOrKeys":["EX-1","EX-2"]`) {
+			t.Errorf("Unexpected body %s", b)
+		}
+		fmt.Fprint(w, `{"errors":{"issueIsNotArchived":{"count":1,"issueIdsOrKeys":["EX-2"],"message":"The issue is not archived."}},"numberOfIssuesUpdated":1}`)
+	})
+
+	result, _, err := testClient.Issue.RestoreIssues([]string{"EX-1", "EX-2"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if result.NumberOfIssuesUpdated != 1 || result.Errors["issueIsNotArchived"].IssueIdsOrKeys[0] != "EX-2" {
+		t.Errorf("Unexpected result %+v", result)
+	}
+}
+
+func TestIssueService_ExportArchivedIssues(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issues/archive/export", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		b, _ := ioutil.ReadAll(r.Body)
+		if !strings.Contains(string(b), `"projects":["EX"]`) {
+			t.Errorf("Unexpected body %s", b)
+		}
+		fmt.Fprint(w, `{"taskId":"10990","status":"ENQUEUED","progress":0,"submittedTime":"2022-08-01T12:00:00.000+0000"}`)
+	})
+	testMux.HandleFunc("/rest/api/2/task/10990", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"self":"https://example.atlassian.net/rest/api/2/task/10990","id":"10990","status":"COMPLETE","progress":100,"result":{"fileUrl":"https://example.atlassian.net/export/archived-issues.csv"}}`)
+	})
+
+	task, _, err := testClient.Issue.ExportArchivedIssues(&ArchivedIssuesExportOptions{Projects: []string{"EX"}})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if task.TaskID != "10990" || task.Status != TaskStatusEnqueued {
+		t.Errorf("Unexpected task %+v", task)
+	}
+
+	progress, _, err := testClient.Issue.GetTask(task.TaskID)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if !progress.Done() || progress.FileURL() != "https://example.atlassian.net/export/archived-issues.csv" {
+		t.Errorf("Unexpected progress %+v", progress)
+	}
+}
@@ -0,0 +1,518 @@
+package jira
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// DefaultAttachmentDownloadConcurrency is the number of attachments downloaded in parallel
+// by DownloadAllAttachments and DownloadAllAttachmentsZip if no concurrency is given.
+const DefaultAttachmentDownloadConcurrency = 4
+
+// ProgressFunc is called while an attachment is transferred.
+// sent is the number of bytes transferred so far, total the size of the attachment or -1 if it is unknown.
+type ProgressFunc func(sent, total int64)
+
+// progressReader reports the number of bytes read from r to f
+type progressReader struct {
+	r     io.Reader
+	sent  int64
+	total int64
+	f     ProgressFunc
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.r.Read(b)
+	if n > 0 {
+		p.sent += int64(n)
+		if p.f != nil {
+			p.f(p.sent, p.total)
+		}
+	}
+	return n, err
+}
+
+// PostAttachmentWithProgressWithContext uploads r (io.Reader) as an attachment to a given issueID.
+// In contrast to PostAttachment the multipart body is streamed from r instead of
+// being buffered in memory, so it is suited for large files.
+// size is the size of the attachment in bytes, or -1 if it is unknown. If the size is known,
+// the request is sent with a Content-Length header, otherwise it is sent chunked.
+// progress is called after every chunk read from r and may be nil.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issue/{issueIdOrKey}/attachments-addAttachment
+func (s *IssueService) PostAttachmentWithProgressWithContext(ctx context.Context, issueID string, r io.Reader, attachmentName string, size int64, progress ProgressFunc) (*[]Attachment, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/attachments", issueID)
+
+	// The multipart header and trailer are rendered upfront, the file content is streamed in between
+	b := new(bytes.Buffer)
+	writer := multipart.NewWriter(b)
+	if _, err := writer.CreateFormFile("file", attachmentName); err != nil {
+		return nil, nil, err
+	}
+	header := b.String()
+	b.Reset()
+	if err := writer.Close(); err != nil {
+		return nil, nil, err
+	}
+	trailer := b.String()
+
+	if size < 0 {
+		size = -1
+	}
+	body := io.MultiReader(
+		bytes.NewBufferString(header),
+		&progressReader{r: r, total: size, f: progress},
+		bytes.NewBufferString(trailer),
+	)
+
+	req, err := s.client.NewRawRequestWithContext(ctx, "POST", apiEndpoint, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	if size >= 0 {
+		req.ContentLength = int64(len(header)) + size + int64(len(trailer))
+	}
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req.Header.Set("X-Atlassian-Token", "no-check")
+
+	attachment := new([]Attachment)
+	resp, err := s.client.Do(req, attachment)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return nil, resp, jerr
+	}
+
+	return attachment, resp, nil
+}
+
+// PostAttachmentWithProgress wraps PostAttachmentWithProgressWithContext using the background context.
+func (s *IssueService) PostAttachmentWithProgress(issueID string, r io.Reader, attachmentName string, size int64, progress ProgressFunc) (*[]Attachment, *Response, error) {
+	return s.PostAttachmentWithProgressWithContext(context.Background(), issueID, r, attachmentName, size, progress)
+}
+
+// AttachmentMeta contains the attachment settings of the JIRA instance
+type AttachmentMeta struct {
+	Enabled bool `json:"enabled" structs:"enabled"`
+	// UploadLimit is the maximum size of an attachment in bytes
+	UploadLimit int64 `json:"uploadLimit" structs:"uploadLimit"`
+}
+
+// GetAttachmentMetaWithContext returns the attachment settings of the JIRA instance.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/attachment-getAttachmentMeta
+func (s *IssueService) GetAttachmentMetaWithContext(ctx context.Context) (*AttachmentMeta, *Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "GET", "rest/api/2/attachment/meta", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	meta := new(AttachmentMeta)
+	resp, err := s.client.Do(req, meta)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return meta, resp, nil
+}
+
+// GetAttachmentMeta wraps GetAttachmentMetaWithContext using the background context.
+func (s *IssueService) GetAttachmentMeta() (*AttachmentMeta, *Response, error) {
+	return s.GetAttachmentMetaWithContext(context.Background())
+}
+
+// These constants are the reasons of an AttachmentValidationError
+const (
+	// AttachmentErrorDisabled means attachments are disabled on the JIRA instance
+	AttachmentErrorDisabled = "disabled"
+	// AttachmentErrorTooLarge means the attachment exceeds the upload limit of the JIRA instance
+	AttachmentErrorTooLarge = "too-large"
+	// AttachmentErrorExtension means the extension of the filename is not allowed
+	AttachmentErrorExtension = "extension-not-allowed"
+)
+
+// AttachmentValidationError is returned by PostAttachmentWithOptions if the attachment is rejected before the upload
+type AttachmentValidationError struct {
+	Reason   string
+	Filename string
+	// Size is the size of the attachment in bytes, or -1 if it is unknown.
+	// If an upload was aborted because it exceeded the limit, Size is the number of bytes read until then.
+	Size int64
+	// Limit is the upload limit of the JIRA instance, only set for AttachmentErrorTooLarge
+	Limit int64
+}
+
+// Error returns the description of the rejection
+func (e *AttachmentValidationError) Error() string {
+	switch e.Reason {
+	case AttachmentErrorDisabled:
+		return fmt.Sprintf("attachment %q rejected: attachments are disabled", e.Filename)
+	case AttachmentErrorTooLarge:
+		return fmt.Sprintf("attachment %q rejected: the size of %d bytes exceeds the limit of %d bytes", e.Filename, e.Size, e.Limit)
+	case AttachmentErrorExtension:
+		return fmt.Sprintf("attachment %q rejected: the extension %q is not allowed", e.Filename, filepath.Ext(e.Filename))
+	}
+	return fmt.Sprintf("attachment %q rejected: %s", e.Filename, e.Reason)
+}
+
+// AttachmentUploadOptions specifies the checks of PostAttachmentWithOptions
+type AttachmentUploadOptions struct {
+	// SniffContentType detects the content type from the content and sends it with the file,
+	// instead of letting JIRA guess it from the filename
+	SniffContentType bool
+	// EnforceSizeLimit fetches the attachment settings with GetAttachmentMeta
+	// and rejects the attachment if attachments are disabled or it exceeds the upload limit
+	EnforceSizeLimit bool
+	// DisallowedExtensions rejects filenames with one of the extensions, e.g. ".exe" or "bat".
+	// They are compared case insensitively.
+	DisallowedExtensions []string
+}
+
+// PostAttachmentWithOptionsWithContext uploads r (io.Reader) as an attachment to a given issueID, like PostAttachment.
+// The content is checked according to options before it is uploaded,
+// a rejected attachment is reported with an *AttachmentValidationError.
+// Like PostAttachmentWithProgress, the content is streamed from r instead of being buffered in memory.
+// If the size of r can not be determined upfront, the upload is aborted as soon as it exceeds the upload limit.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issue/{issueIdOrKey}/attachments-addAttachment
+func (s *IssueService) PostAttachmentWithOptionsWithContext(ctx context.Context, issueID string, r io.Reader, attachmentName string, options *AttachmentUploadOptions) (*[]Attachment, *Response, error) {
+	if options == nil {
+		options = &AttachmentUploadOptions{}
+	}
+	if r == nil {
+		r = bytes.NewReader(nil)
+	}
+	size := readerSize(r)
+
+	ext := strings.ToLower(filepath.Ext(attachmentName))
+	for _, disallowed := range options.DisallowedExtensions {
+		disallowed = strings.ToLower(disallowed)
+		if !strings.HasPrefix(disallowed, ".") {
+			disallowed = "." + disallowed
+		}
+		if ext == disallowed {
+			return nil, nil, &AttachmentValidationError{Reason: AttachmentErrorExtension, Filename: attachmentName, Size: size}
+		}
+	}
+
+	limit := int64(-1)
+	if options.EnforceSizeLimit {
+		meta, resp, err := s.GetAttachmentMetaWithContext(ctx)
+		if err != nil {
+			return nil, resp, err
+		}
+		if !meta.Enabled {
+			return nil, nil, &AttachmentValidationError{Reason: AttachmentErrorDisabled, Filename: attachmentName, Size: size}
+		}
+		if meta.UploadLimit > 0 {
+			limit = meta.UploadLimit
+			if size > limit {
+				return nil, nil, &AttachmentValidationError{Reason: AttachmentErrorTooLarge, Filename: attachmentName, Size: size, Limit: limit}
+			}
+			r = io.LimitReader(r, limit+1)
+		}
+	}
+
+	contentType := "application/octet-stream"
+	if options.SniffContentType {
+		// http.DetectContentType considers at most the first 512 bytes
+		head := make([]byte, 512)
+		n, err := io.ReadFull(r, head)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return nil, nil, err
+		}
+		contentType = http.DetectContentType(head[:n])
+		r = io.MultiReader(bytes.NewReader(head[:n]), r)
+	}
+
+	pr, pw := io.Pipe()
+	writer := multipart.NewWriter(pw)
+	var tooLarge *AttachmentValidationError
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		h := make(textproto.MIMEHeader)
+		h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file"; filename="%s"`, quoteEscaper.Replace(attachmentName)))
+		h.Set("Content-Type", contentType)
+		fw, err := writer.CreatePart(h)
+		if err != nil {
+			pw.CloseWithError(err)
+			return
+		}
+		n, err := io.Copy(fw, r)
+		if err != nil {
+			pw.CloseWithError(err)
+			return
+		}
+		if limit >= 0 && n > limit {
+			tooLarge = &AttachmentValidationError{Reason: AttachmentErrorTooLarge, Filename: attachmentName, Size: n, Limit: limit}
+			pw.CloseWithError(tooLarge)
+			return
+		}
+		pw.CloseWithError(writer.Close())
+	}()
+
+	req, err := s.client.NewRawRequestWithContext(ctx, "POST", fmt.Sprintf("rest/api/2/issue/%s/attachments", issueID), pr)
+	if err != nil {
+		pr.Close()
+		<-done
+		return nil, nil, err
+	}
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req.Header.Set("X-Atlassian-Token", "no-check")
+
+	attachment := new([]Attachment)
+	resp, err := s.client.Do(req, attachment)
+	// Unblock the writer if the request failed before the body was read completely
+	pr.Close()
+	<-done
+	if tooLarge != nil {
+		return nil, resp, tooLarge
+	}
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return attachment, resp, nil
+}
+
+// readerSize returns the number of bytes left in r, or -1 if it can not be determined without reading r
+func readerSize(r io.Reader) int64 {
+	switch v := r.(type) {
+	case interface{ Len() int }:
+		return int64(v.Len())
+	case *os.File:
+		info, err := v.Stat()
+		if err != nil || !info.Mode().IsRegular() {
+			return -1
+		}
+		offset, err := v.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return -1
+		}
+		return info.Size() - offset
+	}
+	return -1
+}
+
+// PostAttachmentWithOptions wraps PostAttachmentWithOptionsWithContext using the background context.
+func (s *IssueService) PostAttachmentWithOptions(issueID string, r io.Reader, attachmentName string, options *AttachmentUploadOptions) (*[]Attachment, *Response, error) {
+	return s.PostAttachmentWithOptionsWithContext(context.Background(), issueID, r, attachmentName, options)
+}
+
+// quoteEscaper escapes the filename of a multipart form file like mime/multipart does
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+// DownloadAllAttachmentsWithContext downloads all attachments of the issue into the directory dir.
+// At most concurrency attachments are downloaded in parallel (DefaultAttachmentDownloadConcurrency if <= 0).
+// The filenames of the attachments are preserved, colliding filenames are made unique
+// by adding a counter, e.g. "report (1).pdf". The paths of the written files are returned.
+// If a download fails, its file is removed, the attachments downloaded completely until then are kept.
+func (s *IssueService) DownloadAllAttachmentsWithContext(ctx context.Context, issueID, dir string, concurrency int) ([]string, error) {
+	attachments, err := s.getAttachments(ctx, issueID)
+	if err != nil {
+		return nil, err
+	}
+
+	names := uniqueAttachmentNames(attachments)
+	paths := make([]string, len(attachments))
+	for i, name := range names {
+		paths[i] = filepath.Join(dir, name)
+	}
+
+	err = s.downloadAttachments(ctx, attachments, concurrency, func(i int, body io.Reader) error {
+		f, err := os.Create(paths[i])
+		if err != nil {
+			return err
+		}
+		_, err = io.Copy(f, body)
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			// Do not leave a truncated file behind
+			os.Remove(paths[i])
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return paths, nil
+}
+
+// DownloadAllAttachments wraps DownloadAllAttachmentsWithContext using the background context.
+func (s *IssueService) DownloadAllAttachments(issueID, dir string, concurrency int) ([]string, error) {
+	return s.DownloadAllAttachmentsWithContext(context.Background(), issueID, dir, concurrency)
+}
+
+// DownloadAllAttachmentsZipWithContext downloads all attachments of the issue and writes them as zip archive to w.
+// At most concurrency attachments are downloaded in parallel (DefaultAttachmentDownloadConcurrency if <= 0).
+// The downloads are buffered in temporary files, the archive entries are written in the order of the attachments.
+// The filenames are preserved and deduplicated like in DownloadAllAttachments.
+func (s *IssueService) DownloadAllAttachmentsZipWithContext(ctx context.Context, issueID string, w io.Writer, concurrency int) error {
+	attachments, err := s.getAttachments(ctx, issueID)
+	if err != nil {
+		return err
+	}
+
+	tmpDir, err := ioutil.TempDir("", "jira-attachments")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	paths := make([]string, len(attachments))
+	for i := range attachments {
+		paths[i] = filepath.Join(tmpDir, fmt.Sprintf("%d", i))
+	}
+
+	err = s.downloadAttachments(ctx, attachments, concurrency, func(i int, body io.Reader) error {
+		f, err := os.Create(paths[i])
+		if err != nil {
+			return err
+		}
+		_, err = io.Copy(f, body)
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			// Do not leave a truncated file behind
+			os.Remove(paths[i])
+		}
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	archive := zip.NewWriter(w)
+	for i, name := range uniqueAttachmentNames(attachments) {
+		entry, err := archive.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate})
+		if err != nil {
+			return err
+		}
+		f, err := os.Open(paths[i])
+		if err != nil {
+			return err
+		}
+		_, err = io.Copy(entry, f)
+		f.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return archive.Close()
+}
+
+// DownloadAllAttachmentsZip wraps DownloadAllAttachmentsZipWithContext using the background context.
+func (s *IssueService) DownloadAllAttachmentsZip(issueID string, w io.Writer, concurrency int) error {
+	return s.DownloadAllAttachmentsZipWithContext(context.Background(), issueID, w, concurrency)
+}
+
+// getAttachments returns the attachments of the issue
+func (s *IssueService) getAttachments(ctx context.Context, issueID string) ([]*Attachment, error) {
+	issue, _, err := s.GetWithContext(ctx, issueID, &GetQueryOptions{Fields: "attachment"})
+	if err != nil {
+		return nil, err
+	}
+	if issue.Fields == nil {
+		return []*Attachment{}, nil
+	}
+	return issue.Fields.Attachments, nil
+}
+
+// downloadAttachments downloads the attachments with at most concurrency parallel requests
+// and passes the content of each attachment, identified by its index, to f.
+// The first error stops the remaining downloads and is returned.
+func (s *IssueService) downloadAttachments(ctx context.Context, attachments []*Attachment, concurrency int, f func(i int, body io.Reader) error) error {
+	if concurrency <= 0 {
+		concurrency = DefaultAttachmentDownloadConcurrency
+	}
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+	failed := func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return firstErr != nil
+	}
+
+	sem := make(chan struct{}, concurrency)
+	for i, attachment := range attachments {
+		sem <- struct{}{}
+		if failed() {
+			<-sem
+			break
+		}
+
+		wg.Add(1)
+		go func(i int, attachment *Attachment) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			err := s.downloadAttachment(ctx, attachment, func(body io.Reader) error {
+				return f(i, body)
+			})
+			if err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				mu.Unlock()
+			}
+		}(i, attachment)
+	}
+	wg.Wait()
+
+	return firstErr
+}
+
+// downloadAttachment downloads a single attachment and passes its content to f
+func (s *IssueService) downloadAttachment(ctx context.Context, attachment *Attachment, f func(body io.Reader) error) error {
+	resp, err := s.DownloadAttachmentWithContext(ctx, attachment.ID)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return f(resp.Body)
+}
+
+// uniqueAttachmentNames returns a safe and unique filename for each attachment.
+// Path components are stripped and duplicates are numbered, e.g. "report (1).pdf".
+func uniqueAttachmentNames(attachments []*Attachment) []string {
+	names := make([]string, len(attachments))
+	seen := map[string]bool{}
+
+	for i, attachment := range attachments {
+		name := filepath.Base(strings.Replace(attachment.Filename, "\\", "/", -1))
+		if name == "." || name == "/" || name == ".." {
+			name = "attachment-" + attachment.ID
+		}
+
+		unique := name
+		ext := filepath.Ext(name)
+		for n := 1; seen[strings.ToLower(unique)]; n++ {
+			unique = fmt.Sprintf("%s (%d)%s", strings.TrimSuffix(name, ext), n, ext)
+		}
+		seen[strings.ToLower(unique)] = true
+		names[i] = unique
+	}
+
+	return names
+}
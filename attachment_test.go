@@ -0,0 +1,335 @@
+package jira
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestIssueService_PostAttachmentWithProgress(t *testing.T) {
+	setup()
+	defer teardown()
+
+	content := strings.Repeat("0123456789", 1000)
+
+	testMux.HandleFunc("/rest/api/2/issue/10000/attachments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, "/rest/api/2/issue/10000/attachments")
+
+		if got := r.Header.Get("X-Atlassian-Token"); got != "no-check" {
+			t.Errorf("Expected X-Atlassian-Token no-check. Got %s", got)
+		}
+		if r.ContentLength <= int64(len(content)) {
+			t.Errorf("Expected Content-Length to include the multipart envelope. Got %d", r.ContentLength)
+		}
+
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			t.Fatalf("Error given: %s", err)
+		}
+		defer file.Close()
+		if header.Filename != "digits.txt" {
+			t.Errorf("Expected filename digits.txt. Got %s", header.Filename)
+		}
+		got, _ := ioutil.ReadAll(file)
+		if string(got) != content {
+			t.Errorf("Unexpected file content of length %d", len(got))
+		}
+
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `[{"self":"http://jira/jira/rest/api/2/attachment/228924","id":"228924","filename":"digits.txt","size":10000,"mimeType":"text/plain"}]`)
+	})
+
+	var lastSent, lastTotal int64
+	calls := 0
+	attachments, _, err := testClient.Issue.PostAttachmentWithProgress("10000", strings.NewReader(content), "digits.txt", int64(len(content)), func(sent, total int64) {
+		calls++
+		lastSent, lastTotal = sent, total
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if attachments == nil || len(*attachments) != 1 || (*attachments)[0].Filename != "digits.txt" {
+		t.Errorf("Unexpected attachments %+v", attachments)
+	}
+	if calls == 0 || lastSent != int64(len(content)) || lastTotal != int64(len(content)) {
+		t.Errorf("Unexpected progress: %d calls, %d/%d bytes", calls, lastSent, lastTotal)
+	}
+}
+
+func TestIssueService_PostAttachmentWithProgress_UnknownSize(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/issue/10000/attachments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		file, _, err := r.FormFile("file")
+		if err != nil {
+			t.Fatalf("Error given: %s", err)
+		}
+		defer file.Close()
+		got, _ := ioutil.ReadAll(file)
+		if string(got) != "streamed" {
+			t.Errorf("Unexpected file content %q", got)
+		}
+		fmt.Fprint(w, `[{"id":"228925","filename":"stream.txt"}]`)
+	})
+
+	var lastTotal int64
+	_, _, err := testClient.Issue.PostAttachmentWithProgress("10000", ioutil.NopCloser(strings.NewReader("streamed")), "stream.txt", -1, func(sent, total int64) {
+		lastTotal = total
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if lastTotal != -1 {
+		t.Errorf("Expected unknown total -1. Got %d", lastTotal)
+	}
+}
+
+func setupAttachmentsMux(t *testing.T) {
+	testMux.HandleFunc("/rest/api/2/issue/TEST-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/issue/TEST-1?fields=attachment")
+		fmt.Fprint(w, `{"key":"TEST-1","fields":{"attachment":[{"id":"1","filename":"report.pdf"},{"id":"2","filename":"report.pdf"},{"id":"3","filename":"../notes.txt"}]}}`)
+	})
+	for id, content := range map[string]string{"1": "first report", "2": "second report", "3": "notes"} {
+		content := content
+		testMux.HandleFunc("/secure/attachment/"+id+"/", func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "GET")
+			fmt.Fprint(w, content)
+		})
+	}
+}
+
+func TestIssueService_DownloadAllAttachments(t *testing.T) {
+	setup()
+	defer teardown()
+	setupAttachmentsMux(t)
+
+	dir, err := ioutil.TempDir("", "go-jira-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	paths, err := testClient.Issue.DownloadAllAttachments("TEST-1", dir, 2)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	expected := map[string]string{
+		filepath.Join(dir, "report.pdf"):     "first report",
+		filepath.Join(dir, "report (1).pdf"): "second report",
+		filepath.Join(dir, "notes.txt"):      "notes",
+	}
+	if len(paths) != len(expected) {
+		t.Fatalf("Expected %d files. Got %v", len(expected), paths)
+	}
+	for _, path := range paths {
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			t.Fatalf("Error given: %s", err)
+		}
+		if string(content) != expected[path] {
+			t.Errorf("Unexpected content of %s: %q", path, content)
+		}
+	}
+}
+
+func TestIssueService_DownloadAllAttachmentsZip(t *testing.T) {
+	setup()
+	defer teardown()
+	setupAttachmentsMux(t)
+
+	buf := new(bytes.Buffer)
+	if err := testClient.Issue.DownloadAllAttachmentsZip("TEST-1", buf, 0); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	archive, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	names := []string{}
+	for _, f := range archive.File {
+		names = append(names, f.Name)
+	}
+	if !reflect.DeepEqual(names, []string{"report.pdf", "report (1).pdf", "notes.txt"}) {
+		t.Errorf("Unexpected archive entries %v", names)
+	}
+
+	rc, err := archive.File[1].Open()
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	defer rc.Close()
+	content, _ := ioutil.ReadAll(rc)
+	if string(content) != "second report" {
+		t.Errorf("Unexpected content %q", content)
+	}
+}
+
+func TestIssueService_DownloadAllAttachments_Error(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/TEST-1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"key":"TEST-1","fields":{"attachment":[{"id":"1","filename":"missing.txt"}]}}`)
+	})
+	testMux.HandleFunc("/secure/attachment/1/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	if err := testClient.Issue.DownloadAllAttachmentsZip("TEST-1", ioutil.Discard, 1); err == nil {
+		t.Error("Expected an error. Got none")
+	}
+}
+
+func TestIssueService_DownloadAllAttachments_Truncated(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/TEST-1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"key":"TEST-1","fields":{"attachment":[{"id":"1","filename":"report.pdf"}]}}`)
+	})
+	testMux.HandleFunc("/secure/attachment/1/", func(w http.ResponseWriter, r *http.Request) {
+		// The connection is closed before the announced length was sent
+		w.Header().Set("Content-Length", "100")
+		fmt.Fprint(w, "partial")
+	})
+
+	dir, err := ioutil.TempDir("", "go-jira-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	if _, err := testClient.Issue.DownloadAllAttachments("TEST-1", dir, 1); err == nil {
+		t.Error("Expected an error. Got none")
+	}
+	if _, err := os.Stat(filepath.Join(dir, "report.pdf")); !os.IsNotExist(err) {
+		t.Errorf("Expected the truncated file to be removed, got %v", err)
+	}
+}
+
+func TestIssueService_GetAttachmentMeta(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/attachment/meta", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/attachment/meta")
+		fmt.Fprint(w, `{"enabled":true,"uploadLimit":1000000}`)
+	})
+
+	meta, _, err := testClient.Issue.GetAttachmentMeta()
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if !meta.Enabled || meta.UploadLimit != 1000000 {
+		t.Errorf("Unexpected meta %+v", meta)
+	}
+}
+
+func TestIssueService_PostAttachmentWithOptions(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/attachment/meta", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"enabled":true,"uploadLimit":10}`)
+	})
+	testMux.HandleFunc("/rest/api/2/issue/10000/attachments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		if token := r.Header.Get("X-Atlassian-Token"); token == "" {
+			t.Error("Expected the X-Atlassian-Token header")
+		}
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			t.Fatalf("Error given: %s", err)
+		}
+		defer file.Close()
+		if header.Filename != "image" {
+			t.Errorf("Expected the filename image, got %s", header.Filename)
+		}
+		if contentType := header.Header.Get("Content-Type"); contentType != "image/png" {
+			t.Errorf("Expected the sniffed content type image/png, got %s", contentType)
+		}
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `[{"id":"10001","filename":"image","mimeType":"image/png"}]`)
+	})
+
+	options := &AttachmentUploadOptions{SniffContentType: true, EnforceSizeLimit: true, DisallowedExtensions: []string{"exe"}}
+	attachments, _, err := testClient.Issue.PostAttachmentWithOptions("10000", strings.NewReader("\x89PNG\r\n\x1a\n"), "image", options)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(*attachments) != 1 || (*attachments)[0].ID != "10001" {
+		t.Errorf("Unexpected attachments %+v", attachments)
+	}
+}
+
+func TestIssueService_PostAttachmentWithOptions_Rejected(t *testing.T) {
+	setup()
+	defer teardown()
+	enabled := true
+	testMux.HandleFunc("/rest/api/2/attachment/meta", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"enabled":%t,"uploadLimit":4}`, enabled)
+	})
+	testMux.HandleFunc("/rest/api/2/issue/10000/attachments", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("The attachment should not be uploaded")
+	})
+
+	tests := []struct {
+		name     string
+		filename string
+		content  string
+		enabled  bool
+		reason   string
+	}{
+		{"extension", "setup.EXE", "MZ", true, AttachmentErrorExtension},
+		{"too large", "notes.txt", "hello", true, AttachmentErrorTooLarge},
+		{"disabled", "notes.txt", "hi", false, AttachmentErrorDisabled},
+	}
+	options := &AttachmentUploadOptions{EnforceSizeLimit: true, DisallowedExtensions: []string{".exe"}}
+	for _, test := range tests {
+		enabled = test.enabled
+		_, _, err := testClient.Issue.PostAttachmentWithOptions("10000", strings.NewReader(test.content), test.filename, options)
+		verr, ok := err.(*AttachmentValidationError)
+		if !ok {
+			t.Errorf("%s: expected an AttachmentValidationError, got %v", test.name, err)
+			continue
+		}
+		if verr.Reason != test.reason || verr.Filename != test.filename {
+			t.Errorf("%s: unexpected error %+v", test.name, verr)
+		}
+	}
+}
+
+func TestIssueService_PostAttachmentWithOptions_RejectedWhileStreaming(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/attachment/meta", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"enabled":true,"uploadLimit":4}`)
+	})
+	testMux.HandleFunc("/rest/api/2/issue/10000/attachments", func(w http.ResponseWriter, r *http.Request) {
+		ioutil.ReadAll(r.Body)
+		w.WriteHeader(http.StatusBadRequest)
+	})
+
+	// The MultiReader hides the size, so the limit is enforced while the content is streamed
+	content := io.MultiReader(strings.NewReader("hello world"))
+	options := &AttachmentUploadOptions{EnforceSizeLimit: true, SniffContentType: true}
+	_, _, err := testClient.Issue.PostAttachmentWithOptions("10000", content, "notes.txt", options)
+	verr, ok := err.(*AttachmentValidationError)
+	if !ok {
+		t.Fatalf("Expected an AttachmentValidationError, got %v", err)
+	}
+	if verr.Reason != AttachmentErrorTooLarge || verr.Limit != 4 || verr.Size != 5 {
+		t.Errorf("Unexpected error %+v", verr)
+	}
+}
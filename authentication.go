@@ -1,10 +1,15 @@
 package jira
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
+	"strings"
+	"sync"
+	"time"
 )
 
 const (
@@ -28,6 +33,28 @@ type AuthenticationService struct {
 
 	// Basic auth password
 	password string
+
+	// Session credentials, used to re-acquire an expired session
+	sessionUsername string
+	sessionPassword string
+
+	// Automatic re-authentication of expired sessions, see EnableSessionReauthentication
+	reauth *sessionReauth
+}
+
+// sessionReauth serializes the re-authentication of concurrent requests.
+// mu also guards enabled and hook.
+type sessionReauth struct {
+	mu      sync.Mutex
+	enabled bool
+	hook    func(err error)
+}
+
+// copy returns a new sessionReauth with the same settings as r
+func (r *sessionReauth) copy() *sessionReauth {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return &sessionReauth{enabled: r.enabled, hook: r.hook}
 }
 
 // Session represents a Session JSON response by the JIRA API.
@@ -47,7 +74,25 @@ type Session struct {
 	Cookies []*http.Cookie
 }
 
-// AcquireSessionCookie creates a new session for a user in JIRA.
+// sessionExport is the JSON document written by ExportSession and read by ImportSession.
+type sessionExport struct {
+	BaseURL    string    `json:"baseURL"`
+	ExportedAt time.Time `json:"exportedAt"`
+	Session    *Session  `json:"session"`
+}
+
+// PersonalAccessToken represents a Personal Access Token of JIRA Server / Data Center.
+// RawToken is only returned once, when the token is created. It is used with PATAuthTransport.
+type PersonalAccessToken struct {
+	ID             int    `json:"id,omitempty" structs:"id,omitempty"`
+	Name           string `json:"name,omitempty" structs:"name,omitempty"`
+	CreatedAt      string `json:"createdAt,omitempty" structs:"createdAt,omitempty"`
+	ExpiringAt     string `json:"expiringAt,omitempty" structs:"expiringAt,omitempty"`
+	LastAccessedAt string `json:"lastAccessedAt,omitempty" structs:"lastAccessedAt,omitempty"`
+	RawToken       string `json:"rawToken,omitempty" structs:"rawToken,omitempty"`
+}
+
+// AcquireSessionCookieWithContext creates a new session for a user in JIRA.
 // Once a session has been successfully created it can be used to access any of JIRA's remote APIs and also the web UI by passing the appropriate HTTP Cookie header.
 // The header will by automatically applied to every API request.
 // Note that it is generally preferrable to use HTTP BASIC authentication with the REST API.
@@ -56,7 +101,7 @@ type Session struct {
 // JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#auth/1/session
 //
 // Deprecated: Use CookieAuthTransport instead
-func (s *AuthenticationService) AcquireSessionCookie(username, password string) (bool, error) {
+func (s *AuthenticationService) AcquireSessionCookieWithContext(ctx context.Context, username, password string) (bool, error) {
 	apiEndpoint := "rest/auth/1/session"
 	body := struct {
 		Username string `json:"username"`
@@ -66,7 +111,7 @@ func (s *AuthenticationService) AcquireSessionCookie(username, password string)
 		password,
 	}
 
-	req, err := s.client.NewRequest("POST", apiEndpoint, body)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, body)
 	if err != nil {
 		return false, err
 	}
@@ -85,12 +130,21 @@ func (s *AuthenticationService) AcquireSessionCookie(username, password string)
 		return false, fmt.Errorf("Auth at JIRA instance failed (HTTP(S) request). Status code: %d", resp.StatusCode)
 	}
 
-	s.client.session = session
+	s.client.setSession(session)
 	s.authType = authTypeSession
+	s.sessionUsername = username
+	s.sessionPassword = password
 
 	return true, nil
 }
 
+// AcquireSessionCookie wraps AcquireSessionCookieWithContext using the background context.
+//
+// Deprecated: Use CookieAuthTransport instead
+func (s *AuthenticationService) AcquireSessionCookie(username, password string) (bool, error) {
+	return s.AcquireSessionCookieWithContext(context.Background(), username, password)
+}
+
 // SetBasicAuth sets username and password for the basic auth against the JIRA instance.
 //
 // Deprecated: Use BasicAuthTransport instead
@@ -104,7 +158,7 @@ func (s *AuthenticationService) SetBasicAuth(username, password string) {
 func (s *AuthenticationService) Authenticated() bool {
 	if s != nil {
 		if s.authType == authTypeSession {
-			return s.client.session != nil
+			return s.client.getSession() != nil
 		} else if s.authType == authTypeBasic {
 			return s.username != ""
 		}
@@ -113,19 +167,19 @@ func (s *AuthenticationService) Authenticated() bool {
 	return false
 }
 
-// Logout logs out the current user that has been authenticated and the session in the client is destroyed.
+// LogoutWithContext logs out the current user that has been authenticated and the session in the client is destroyed.
 //
 // JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#auth/1/session
 //
 // Deprecated: Use CookieAuthTransport to create base client.  Logging out is as simple as not using the
 // client anymore
-func (s *AuthenticationService) Logout() error {
-	if s.authType != authTypeSession || s.client.session == nil {
+func (s *AuthenticationService) LogoutWithContext(ctx context.Context) error {
+	if s.authType != authTypeSession || s.client.getSession() == nil {
 		return fmt.Errorf("no user is authenticated")
 	}
 
 	apiEndpoint := "rest/auth/1/session"
-	req, err := s.client.NewRequest("DELETE", apiEndpoint, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
 	if err != nil {
 		return fmt.Errorf("Creating the request to log the user out failed : %s", err)
 	}
@@ -139,25 +193,33 @@ func (s *AuthenticationService) Logout() error {
 	}
 
 	// If logout successful, delete session
-	s.client.session = nil
+	s.client.setSession(nil)
 
 	return nil
 
 }
 
-// GetCurrentUser gets the details of the current user.
+// Logout wraps LogoutWithContext using the background context.
+//
+// Deprecated: Use CookieAuthTransport to create base client.  Logging out is as simple as not using the
+// client anymore
+func (s *AuthenticationService) Logout() error {
+	return s.LogoutWithContext(context.Background())
+}
+
+// GetCurrentUserWithContext gets the details of the current user.
 //
 // JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#auth/1/session
-func (s *AuthenticationService) GetCurrentUser() (*Session, error) {
+func (s *AuthenticationService) GetCurrentUserWithContext(ctx context.Context) (*Session, error) {
 	if s == nil {
 		return nil, fmt.Errorf("AUthenticaiton Service is not instantiated")
 	}
-	if s.authType != authTypeSession || s.client.session == nil {
+	if s.authType != authTypeSession || s.client.getSession() == nil {
 		return nil, fmt.Errorf("No user is authenticated yet")
 	}
 
 	apiEndpoint := "rest/auth/1/session"
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		return nil, fmt.Errorf("Could not create request for getting user info : %s", err)
 	}
@@ -185,3 +247,199 @@ func (s *AuthenticationService) GetCurrentUser() (*Session, error) {
 
 	return ret, nil
 }
+
+// GetCurrentUser wraps GetCurrentUserWithContext using the background context.
+func (s *AuthenticationService) GetCurrentUser() (*Session, error) {
+	return s.GetCurrentUserWithContext(context.Background())
+}
+
+// ExportSession writes the session acquired by AcquireSessionCookie as JSON to w.
+// The session cookies and metadata can be stored (e.g. in a file) and reused with ImportSession,
+// so short-lived processes don't have to authenticate on every run.
+// The JSON contains the session cookies, so treat it like a password.
+func (s *AuthenticationService) ExportSession(w io.Writer) error {
+	if s.authType != authTypeSession || s.client.getSession() == nil {
+		return fmt.Errorf("no user is authenticated")
+	}
+
+	export := sessionExport{
+		BaseURL:    s.client.baseURL.String(),
+		ExportedAt: time.Now(),
+		Session:    s.client.getSession(),
+	}
+	return json.NewEncoder(w).Encode(export)
+}
+
+// ImportSession reads a session written by ExportSession from r and uses it for all following requests.
+// The session must have been exported by a Client with the same base URL.
+// Expired cookies are dropped, if all cookies are expired an error is returned and a new session needs to be acquired.
+// JIRA may still reject an imported session, e.g. after a logout or a server side timeout.
+func (s *AuthenticationService) ImportSession(r io.Reader) error {
+	var export sessionExport
+	if err := json.NewDecoder(r).Decode(&export); err != nil {
+		return fmt.Errorf("Could not decode the session : %s", err)
+	}
+	if export.Session == nil {
+		return fmt.Errorf("The session is empty")
+	}
+	if baseURL := s.client.baseURL.String(); export.BaseURL != baseURL {
+		return fmt.Errorf("The session was acquired for %s, not %s", export.BaseURL, baseURL)
+	}
+
+	now := time.Now()
+	cookies := make([]*http.Cookie, 0, len(export.Session.Cookies))
+	for _, cookie := range export.Session.Cookies {
+		if !cookie.Expires.IsZero() && cookie.Expires.Before(now) {
+			continue
+		}
+		cookies = append(cookies, cookie)
+	}
+	if len(cookies) == 0 {
+		return fmt.Errorf("The session is expired")
+	}
+	export.Session.Cookies = cookies
+
+	s.client.setSession(export.Session)
+	s.authType = authTypeSession
+	return nil
+}
+
+// CreatePersonalAccessTokenWithContext creates a Personal Access Token for the current user.
+// The token expires after expirationDays days, it never expires if expirationDays is 0 (if allowed by the instance).
+// The token is only returned once in PersonalAccessToken.RawToken.
+//
+// JIRA docs: https://confluence.atlassian.com/enterprise/using-personal-access-tokens-1026032365.html
+func (s *AuthenticationService) CreatePersonalAccessTokenWithContext(ctx context.Context, name string, expirationDays int) (*PersonalAccessToken, *Response, error) {
+	apiEndpoint := "rest/pat/latest/tokens"
+	body := struct {
+		Name               string `json:"name"`
+		ExpirationDuration int    `json:"expirationDuration,omitempty"`
+	}{name, expirationDays}
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	token := new(PersonalAccessToken)
+	resp, err := s.client.Do(req, token)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return token, resp, nil
+}
+
+// CreatePersonalAccessToken wraps CreatePersonalAccessTokenWithContext using the background context.
+func (s *AuthenticationService) CreatePersonalAccessToken(name string, expirationDays int) (*PersonalAccessToken, *Response, error) {
+	return s.CreatePersonalAccessTokenWithContext(context.Background(), name, expirationDays)
+}
+
+// GetPersonalAccessTokensWithContext returns the Personal Access Tokens of the current user, without their raw tokens.
+//
+// JIRA docs: https://confluence.atlassian.com/enterprise/using-personal-access-tokens-1026032365.html
+func (s *AuthenticationService) GetPersonalAccessTokensWithContext(ctx context.Context) ([]PersonalAccessToken, *Response, error) {
+	apiEndpoint := "rest/pat/latest/tokens"
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tokens := []PersonalAccessToken{}
+	resp, err := s.client.Do(req, &tokens)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return tokens, resp, nil
+}
+
+// GetPersonalAccessTokens wraps GetPersonalAccessTokensWithContext using the background context.
+func (s *AuthenticationService) GetPersonalAccessTokens() ([]PersonalAccessToken, *Response, error) {
+	return s.GetPersonalAccessTokensWithContext(context.Background())
+}
+
+// RevokePersonalAccessTokenWithContext revokes the Personal Access Token with the given ID.
+//
+// JIRA docs: https://confluence.atlassian.com/enterprise/using-personal-access-tokens-1026032365.html
+func (s *AuthenticationService) RevokePersonalAccessTokenWithContext(ctx context.Context, tokenID int) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/pat/latest/tokens/%d", tokenID)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// RevokePersonalAccessToken wraps RevokePersonalAccessTokenWithContext using the background context.
+func (s *AuthenticationService) RevokePersonalAccessToken(tokenID int) (*Response, error) {
+	return s.RevokePersonalAccessTokenWithContext(context.Background(), tokenID)
+}
+
+// EnableSessionReauthentication makes the client re-acquire the session cookie when a request fails with 401 Unauthorized,
+// e.g. because the session expired during a long-running job. The request is retried once with the new session.
+// Requests with a body which can not be replayed (see http.Request.GetBody) are not retried.
+// hook is called after every re-authentication with its error (nil on success), it may be nil.
+// This only applies to sessions acquired with AcquireSessionCookie.
+func (s *AuthenticationService) EnableSessionReauthentication(hook func(err error)) {
+	s.reauth.mu.Lock()
+	s.reauth.enabled, s.reauth.hook = true, hook
+	s.reauth.mu.Unlock()
+}
+
+// DisableSessionReauthentication turns the automatic re-authentication off again
+func (s *AuthenticationService) DisableSessionReauthentication() {
+	s.reauth.mu.Lock()
+	s.reauth.enabled, s.reauth.hook = false, nil
+	s.reauth.mu.Unlock()
+}
+
+// reauthenticate re-acquires the session after req failed with 401 Unauthorized and returns the request to retry.
+// used is the session req was sent with, if it was replaced in the meantime (e.g. by a concurrent request)
+// the new session is used without authenticating again.
+func (s *AuthenticationService) reauthenticate(req *http.Request, used *Session) (*http.Request, bool) {
+	// The login request itself is never retried, it would deadlock on reauth.mu
+	if strings.HasSuffix(req.URL.Path, "rest/auth/1/session") {
+		return nil, false
+	}
+	if req.Body != nil && req.GetBody == nil {
+		return nil, false
+	}
+
+	reauth := s.reauth
+	reauth.mu.Lock()
+	if !reauth.enabled || s.authType != authTypeSession || s.sessionUsername == "" {
+		reauth.mu.Unlock()
+		return nil, false
+	}
+	if s.client.getSession() == used {
+		_, err := s.AcquireSessionCookieWithContext(req.Context(), s.sessionUsername, s.sessionPassword)
+		if reauth.hook != nil {
+			reauth.hook(err)
+		}
+		if err != nil {
+			reauth.mu.Unlock()
+			return nil, false
+		}
+	}
+	session := s.client.getSession()
+	reauth.mu.Unlock()
+
+	retry := cloneRequest(req)
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, false
+		}
+		retry.Body = body
+	}
+	retry.Header.Del("Cookie")
+	if session != nil {
+		for _, cookie := range session.Cookies {
+			retry.AddCookie(cookie)
+		}
+	}
+	return retry, true
+}
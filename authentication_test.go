@@ -6,7 +6,9 @@ import (
 	"io/ioutil"
 	"net/http"
 	"reflect"
+	"sync"
 	"testing"
+	"time"
 )
 
 func TestAuthenticationService_AcquireSessionCookie_Failure(t *testing.T) {
@@ -319,3 +321,263 @@ func TestAuthenticationService_Logout_FailWithoutLogin(t *testing.T) {
 		t.Error("Expected not nil, got nil")
 	}
 }
+
+func TestAuthenticationService_ExportImportSession(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/auth/1/session", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		http.SetCookie(w, &http.Cookie{Name: "JSESSIONID", Value: "12345678901234567890"})
+		fmt.Fprint(w, `{"session":{"name":"JSESSIONID","value":"12345678901234567890"},"loginInfo":{"failedLoginCount":10,"loginCount":127}}`)
+	})
+	testMux.HandleFunc("/rest/api/2/myself", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		cookie, err := r.Cookie("JSESSIONID")
+		if err != nil || cookie.Value != "12345678901234567890" {
+			t.Errorf("Expected the imported session cookie, got %v", cookie)
+		}
+		fmt.Fprint(w, `{"name":"foo"}`)
+	})
+
+	if _, err := testClient.Authentication.AcquireSessionCookie("foo", "bar"); err != nil {
+		t.Fatalf("No error expected. Got %s", err)
+	}
+
+	var buf bytes.Buffer
+	if err := testClient.Authentication.ExportSession(&buf); err != nil {
+		t.Fatalf("No error expected. Got %s", err)
+	}
+
+	client, _ := NewClient(nil, testServer.URL)
+	if err := client.Authentication.ImportSession(&buf); err != nil {
+		t.Fatalf("No error expected. Got %s", err)
+	}
+	if !client.Authentication.Authenticated() {
+		t.Error("Expected the client to be authenticated after the import")
+	}
+	if _, _, err := client.User.GetSelf(); err != nil {
+		t.Errorf("No error expected. Got %s", err)
+	}
+}
+
+func TestAuthenticationService_ImportSession_Invalid(t *testing.T) {
+	setup()
+	defer teardown()
+
+	expired := `{"baseURL":"` + testServer.URL + `","session":{"Cookies":[{"Name":"JSESSIONID","Value":"1","Expires":"2001-01-01T00:00:00Z"}]}}`
+	if err := testClient.Authentication.ImportSession(bytes.NewBufferString(expired)); err == nil {
+		t.Error("Expected an error for an expired session")
+	}
+
+	otherHost := `{"baseURL":"https://other.example.com/","session":{"Cookies":[{"Name":"JSESSIONID","Value":"1"}]}}`
+	if err := testClient.Authentication.ImportSession(bytes.NewBufferString(otherHost)); err == nil {
+		t.Error("Expected an error for a session of another instance")
+	}
+
+	if testClient.Authentication.Authenticated() {
+		t.Error("Expected the client not to be authenticated")
+	}
+}
+
+func TestAuthenticationService_ExportSession_WithoutLogin(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var buf bytes.Buffer
+	if err := testClient.Authentication.ExportSession(&buf); err == nil {
+		t.Error("Expected an error without a session")
+	}
+}
+
+func TestAuthenticationService_PersonalAccessTokens(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/pat/latest/tokens", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "POST":
+			b, _ := ioutil.ReadAll(r.Body)
+			if string(b) != `{"name":"ci","expirationDuration":90}`+"\n" {
+				t.Errorf("Unexpected body %s", b)
+			}
+			fmt.Fprint(w, `{"id":1,"name":"ci","createdAt":"2021-01-01T10:00:00.000+0000","expiringAt":"2021-04-01T10:00:00.000+0000","rawToken":"NjY0NDc2OTQ3MjI2"}`)
+		case "GET":
+			fmt.Fprint(w, `[{"id":1,"name":"ci","createdAt":"2021-01-01T10:00:00.000+0000","expiringAt":"2021-04-01T10:00:00.000+0000"}]`)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+	testMux.HandleFunc("/rest/pat/latest/tokens/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	token, _, err := testClient.Authentication.CreatePersonalAccessToken("ci", 90)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if token.ID != 1 || token.RawToken != "NjY0NDc2OTQ3MjI2" {
+		t.Errorf("Unexpected token %+v", token)
+	}
+
+	tokens, _, err := testClient.Authentication.GetPersonalAccessTokens()
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(tokens) != 1 || tokens[0].Name != "ci" || tokens[0].RawToken != "" {
+		t.Errorf("Unexpected tokens %+v", tokens)
+	}
+
+	if _, err := testClient.Authentication.RevokePersonalAccessToken(1); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestAuthenticationService_SessionReauthentication(t *testing.T) {
+	setup()
+	defer teardown()
+
+	logins := 0
+	valid := ""
+	testMux.HandleFunc("/rest/auth/1/session", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		logins++
+		valid = fmt.Sprintf("session-%d", logins)
+		http.SetCookie(w, &http.Cookie{Name: "JSESSIONID", Value: valid})
+		fmt.Fprintf(w, `{"session":{"name":"JSESSIONID","value":"%s"}}`, valid)
+	})
+	testMux.HandleFunc("/rest/api/2/issue/TEST-1/comment", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		cookie, err := r.Cookie("JSESSIONID")
+		if err != nil || cookie.Value != valid {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		b, _ := ioutil.ReadAll(r.Body)
+		if !bytes.Contains(b, []byte(`"body":"Hello"`)) {
+			t.Errorf("Expected the body to be replayed, got %s", b)
+		}
+		fmt.Fprint(w, `{"id":"10000","body":"Hello"}`)
+	})
+
+	if _, err := testClient.Authentication.AcquireSessionCookie("foo", "bar"); err != nil {
+		t.Fatalf("No error expected. Got %s", err)
+	}
+
+	// Without re-authentication the expired session fails
+	valid = "expired"
+	if _, _, err := testClient.Issue.AddComment("TEST-1", &Comment{Body: "Hello"}); err == nil {
+		t.Fatal("Expected an error for the expired session")
+	}
+
+	var events []error
+	testClient.Authentication.EnableSessionReauthentication(func(err error) {
+		events = append(events, err)
+	})
+	comment, _, err := testClient.Issue.AddComment("TEST-1", &Comment{Body: "Hello"})
+	if err != nil {
+		t.Fatalf("No error expected. Got %s", err)
+	}
+	if comment.ID != "10000" {
+		t.Errorf("Unexpected comment %+v", comment)
+	}
+	if logins != 2 {
+		t.Errorf("Expected the session to be acquired again, got %d logins", logins)
+	}
+	if len(events) != 1 || events[0] != nil {
+		t.Errorf("Expected one successful re-authentication event, got %v", events)
+	}
+}
+
+func TestAuthenticationService_SessionReauthentication_Failure(t *testing.T) {
+	setup()
+	defer teardown()
+
+	logins := 0
+	testMux.HandleFunc("/rest/auth/1/session", func(w http.ResponseWriter, r *http.Request) {
+		logins++
+		if logins > 1 {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, `{"session":{"name":"JSESSIONID","value":"1"}}`)
+	})
+	testMux.HandleFunc("/rest/api/2/myself", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	if _, err := testClient.Authentication.AcquireSessionCookie("foo", "bar"); err != nil {
+		t.Fatalf("No error expected. Got %s", err)
+	}
+	var events []error
+	testClient.Authentication.EnableSessionReauthentication(func(err error) {
+		events = append(events, err)
+	})
+
+	if _, _, err := testClient.User.GetSelf(); err == nil {
+		t.Error("Expected an error")
+	}
+	if logins != 2 {
+		t.Errorf("Expected exactly one re-authentication attempt, got %d logins", logins)
+	}
+	if len(events) != 1 || events[0] == nil {
+		t.Errorf("Expected one failed re-authentication event, got %v", events)
+	}
+}
+
+func TestAuthenticationService_SessionReauthentication_Concurrent(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var mu sync.Mutex
+	logins, attempts := 0, 0
+	valid := ""
+	testMux.HandleFunc("/rest/auth/1/session", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		logins++
+		valid = fmt.Sprintf("session-%d", logins)
+		http.SetCookie(w, &http.Cookie{Name: "JSESSIONID", Value: valid})
+		fmt.Fprintf(w, `{"session":{"name":"JSESSIONID","value":"%s"}}`, valid)
+	})
+	testMux.HandleFunc("/rest/api/2/myself", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		cookie, err := r.Cookie("JSESSIONID")
+		if err != nil || cookie.Value != valid {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		// The first request with the new session fails, the retry policy sends it again
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"name":"foo"}`)
+	})
+
+	client, _ := NewClient(nil, testServer.URL, WithRetryPolicy(RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond}))
+	if _, err := client.Authentication.AcquireSessionCookie("foo", "bar"); err != nil {
+		t.Fatalf("No error expected. Got %s", err)
+	}
+	client.Authentication.EnableSessionReauthentication(nil)
+	mu.Lock()
+	valid = "expired"
+	mu.Unlock()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, _, err := client.User.GetSelf(); err != nil {
+				t.Errorf("No error expected. Got %s", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if logins != 2 {
+		t.Errorf("Expected the session to be acquired again once, got %d logins", logins)
+	}
+}
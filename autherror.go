@@ -0,0 +1,94 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// These constants are the kinds of an AuthError
+const (
+	// AuthErrorBadCredentials means the username, password or token was rejected
+	AuthErrorBadCredentials = "bad-credentials"
+	// AuthErrorExpiredToken means the (OAuth or Personal Access) token is expired or revoked
+	AuthErrorExpiredToken = "expired-token"
+	// AuthErrorForbidden means the user is authenticated, but lacks the permission
+	AuthErrorForbidden = "forbidden"
+	// AuthErrorCaptcha means JIRA requires a CAPTCHA to be solved in the browser
+	// after too many failed logins, API requests are denied until then
+	AuthErrorCaptcha = "captcha"
+)
+
+// AuthError is returned for requests rejected with 401 Unauthorized or 403 Forbidden.
+// Kind classifies the failure, so callers can take the right recovery action,
+// e.g. ask for new credentials, refresh the token or send the user to LoginURL.
+// Use AsAuthError to find it in the errors returned by the services.
+type AuthError struct {
+	Kind       string
+	StatusCode int
+	// LoginReason is the value of the X-Seraph-LoginReason header, e.g. "AUTHENTICATED_FAILED"
+	LoginReason string
+	// DeniedReason is the value of the X-Authentication-Denied-Reason header, e.g. "CAPTCHA_CHALLENGE; login-url=..."
+	DeniedReason string
+	// LoginURL is the URL to solve the CAPTCHA at, if JIRA sent one
+	LoginURL string
+}
+
+// Error returns the description of the failure
+func (e *AuthError) Error() string {
+	return fmt.Sprintf("Request failed. Please analyze the request body for more details. Status code: %d (%s)", e.StatusCode, e.Kind)
+}
+
+// AsAuthError returns the AuthError contained in err, e.g. in the HTTPError of an *Error
+func AsAuthError(err error) (*AuthError, bool) {
+	for err != nil {
+		switch e := err.(type) {
+		case *AuthError:
+			return e, true
+		case *Error:
+			err = e.HTTPError
+			continue
+		}
+		cause := errors.Cause(err)
+		if cause == err {
+			return nil, false
+		}
+		err = cause
+	}
+	return nil, false
+}
+
+// newAuthError classifies a 401 or 403 response by its status code and the Seraph and WWW-Authenticate headers.
+// It returns nil for all other responses.
+func newAuthError(r *http.Response) *AuthError {
+	if r.StatusCode != http.StatusUnauthorized && r.StatusCode != http.StatusForbidden {
+		return nil
+	}
+
+	e := &AuthError{
+		StatusCode:   r.StatusCode,
+		LoginReason:  r.Header.Get("X-Seraph-LoginReason"),
+		DeniedReason: r.Header.Get("X-Authentication-Denied-Reason"),
+	}
+	for _, part := range strings.Split(e.DeniedReason, ";") {
+		part = strings.TrimSpace(part)
+		if strings.HasPrefix(part, "login-url=") {
+			e.LoginURL = strings.TrimPrefix(part, "login-url=")
+		}
+	}
+
+	authenticate := strings.ToLower(r.Header.Get("WWW-Authenticate"))
+	switch {
+	case strings.Contains(e.DeniedReason, "CAPTCHA_CHALLENGE") || strings.Contains(e.LoginReason, "AUTHENTICATION_DENIED"):
+		e.Kind = AuthErrorCaptcha
+	case strings.Contains(authenticate, "invalid_token") || strings.Contains(authenticate, "expired"):
+		e.Kind = AuthErrorExpiredToken
+	case r.StatusCode == http.StatusForbidden || strings.Contains(e.LoginReason, "AUTHORISATION_FAILED"):
+		e.Kind = AuthErrorForbidden
+	default:
+		e.Kind = AuthErrorBadCredentials
+	}
+	return e
+}
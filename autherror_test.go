@@ -0,0 +1,58 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestCheckResponse_AuthErrors(t *testing.T) {
+	tests := []struct {
+		status int
+		header map[string]string
+		kind   string
+	}{
+		{http.StatusUnauthorized, map[string]string{"X-Seraph-LoginReason": "AUTHENTICATED_FAILED"}, AuthErrorBadCredentials},
+		{http.StatusUnauthorized, map[string]string{"WWW-Authenticate": `Bearer error="invalid_token"`}, AuthErrorExpiredToken},
+		{http.StatusForbidden, map[string]string{"X-Seraph-LoginReason": "AUTHORISATION_FAILED"}, AuthErrorForbidden},
+		{http.StatusForbidden, map[string]string{
+			"X-Seraph-LoginReason":           "AUTHENTICATION_DENIED",
+			"X-Authentication-Denied-Reason": "CAPTCHA_CHALLENGE; login-url=https://jira.example.com/login.jsp",
+		}, AuthErrorCaptcha},
+	}
+
+	for _, test := range tests {
+		setup()
+		testMux.HandleFunc("/rest/api/2/myself", func(w http.ResponseWriter, r *http.Request) {
+			for k, v := range test.header {
+				w.Header().Set(k, v)
+			}
+			w.WriteHeader(test.status)
+		})
+
+		_, _, err := testClient.User.GetSelf()
+		authErr, ok := AsAuthError(err)
+		if !ok {
+			t.Fatalf("Expected an AuthError for status %d, got %v", test.status, err)
+		}
+		if authErr.Kind != test.kind {
+			t.Errorf("Expected kind %q, got %q", test.kind, authErr.Kind)
+		}
+		if authErr.StatusCode != test.status {
+			t.Errorf("Expected status code %d, got %d", test.status, authErr.StatusCode)
+		}
+		if test.kind == AuthErrorCaptcha && authErr.LoginURL != "https://jira.example.com/login.jsp" {
+			t.Errorf("Expected the login URL to be parsed, got %q", authErr.LoginURL)
+		}
+		teardown()
+	}
+}
+
+func TestAsAuthError_NoAuthError(t *testing.T) {
+	if _, ok := AsAuthError(fmt.Errorf("Request failed")); ok {
+		t.Error("Expected no AuthError")
+	}
+	if _, ok := AsAuthError(nil); ok {
+		t.Error("Expected no AuthError for nil")
+	}
+}
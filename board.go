@@ -1,6 +1,7 @@
 package jira
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"time"
@@ -22,6 +23,10 @@ type BoardsList struct {
 	Values     []Board `json:"values" structs:"values"`
 }
 
+func (l *BoardsList) pageInfo() PageInfo {
+	return PageInfo{StartAt: l.StartAt, MaxResults: l.MaxResults, Total: l.Total, IsLast: l.IsLast}
+}
+
 // Board represents a JIRA agile board
 type Board struct {
 	ID       int    `json:"id,omitempty" structs:"id,omitempty"`
@@ -62,6 +67,10 @@ type SprintsList struct {
 	Values     []Sprint `json:"values" structs:"values"`
 }
 
+func (l *SprintsList) pageInfo() PageInfo {
+	return PageInfo{StartAt: l.StartAt, MaxResults: l.MaxResults, Total: l.Total, IsLast: l.IsLast}
+}
+
 // Sprint represents a sprint on JIRA agile board
 type Sprint struct {
 	ID            int        `json:"id" structs:"id"`
@@ -72,6 +81,7 @@ type Sprint struct {
 	OriginBoardID int        `json:"originBoardId" structs:"originBoardId"`
 	Self          string     `json:"self" structs:"self"`
 	State         string     `json:"state" structs:"state"`
+	Goal          string     `json:"goal,omitempty" structs:"goal,omitempty"`
 }
 
 // BoardConfiguration represents a boardConfiguration of a jira board
@@ -124,16 +134,41 @@ type BoardConfigurationColumnStatus struct {
 	Self string `json:"self"`
 }
 
-// GetAllBoards will returns all boards. This only includes boards that the user has permission to view.
+// BoardIssuesOptions specifies the optional parameters to the BoardService.GetIssues and BoardService.GetBacklogIssues
+type BoardIssuesOptions struct {
+	// JQL filters the issues further, in addition to the filter of the board
+	JQL string `url:"jql,omitempty"`
+	// Fields is the list of fields to return for each issue. By default, all navigable fields are returned.
+	Fields        []string `url:"fields,comma,omitempty"`
+	Expand        string   `url:"expand,omitempty"`
+	ValidateQuery bool     `url:"validateQuery,omitempty"`
+	StartAt       int      `url:"startAt,omitempty"`
+	// MaxResults is the maximum number of issues per page. Default: 50.
+	MaxResults int `url:"maxResults,omitempty"`
+}
+
+// boardIssuesResult is a page of the issues of a board
+type boardIssuesResult struct {
+	StartAt    int     `json:"startAt"`
+	MaxResults int     `json:"maxResults"`
+	Total      int     `json:"total"`
+	Issues     []Issue `json:"issues"`
+}
+
+func (l *boardIssuesResult) pageInfo() PageInfo {
+	return PageInfo{StartAt: l.StartAt, MaxResults: l.MaxResults, Total: l.Total}
+}
+
+// GetAllBoardsWithContext will returns all boards. This only includes boards that the user has permission to view.
 //
 // JIRA API docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/board-getAllBoards
-func (s *BoardService) GetAllBoards(opt *BoardListOptions) (*BoardsList, *Response, error) {
+func (s *BoardService) GetAllBoardsWithContext(ctx context.Context, opt *BoardListOptions) (*BoardsList, *Response, error) {
 	apiEndpoint := "rest/agile/1.0/board"
 	url, err := addOptions(apiEndpoint, opt)
 	if err != nil {
 		return nil, nil, err
 	}
-	req, err := s.client.NewRequest("GET", url, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -148,13 +183,18 @@ func (s *BoardService) GetAllBoards(opt *BoardListOptions) (*BoardsList, *Respon
 	return boards, resp, err
 }
 
-// GetBoard will returns the board for the given boardID.
+// GetAllBoards wraps GetAllBoardsWithContext using the background context.
+func (s *BoardService) GetAllBoards(opt *BoardListOptions) (*BoardsList, *Response, error) {
+	return s.GetAllBoardsWithContext(context.Background(), opt)
+}
+
+// GetBoardWithContext will returns the board for the given boardID.
 // This board will only be returned if the user has permission to view it.
 //
 // JIRA API docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/board-getBoard
-func (s *BoardService) GetBoard(boardID int) (*Board, *Response, error) {
+func (s *BoardService) GetBoardWithContext(ctx context.Context, boardID int) (*Board, *Response, error) {
 	apiEndpoint := fmt.Sprintf("rest/agile/1.0/board/%v", boardID)
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -169,7 +209,12 @@ func (s *BoardService) GetBoard(boardID int) (*Board, *Response, error) {
 	return board, resp, nil
 }
 
-// CreateBoard creates a new board. Board name, type and filter Id is required.
+// GetBoard wraps GetBoardWithContext using the background context.
+func (s *BoardService) GetBoard(boardID int) (*Board, *Response, error) {
+	return s.GetBoardWithContext(context.Background(), boardID)
+}
+
+// CreateBoardWithContext creates a new board. Board name, type and filter Id is required.
 // name - Must be less than 255 characters.
 // type - Valid values: scrum, kanban
 // filterId - Id of a filter that the user has permissions to view.
@@ -177,9 +222,9 @@ func (s *BoardService) GetBoard(boardID int) (*Board, *Response, error) {
 // board will be created instead (remember that board sharing depends on the filter sharing).
 //
 // JIRA API docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/board-createBoard
-func (s *BoardService) CreateBoard(board *Board) (*Board, *Response, error) {
+func (s *BoardService) CreateBoardWithContext(ctx context.Context, board *Board) (*Board, *Response, error) {
 	apiEndpoint := "rest/agile/1.0/board"
-	req, err := s.client.NewRequest("POST", apiEndpoint, board)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, board)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -194,12 +239,17 @@ func (s *BoardService) CreateBoard(board *Board) (*Board, *Response, error) {
 	return responseBoard, resp, nil
 }
 
-// DeleteBoard will delete an agile board.
+// CreateBoard wraps CreateBoardWithContext using the background context.
+func (s *BoardService) CreateBoard(board *Board) (*Board, *Response, error) {
+	return s.CreateBoardWithContext(context.Background(), board)
+}
+
+// DeleteBoardWithContext will delete an agile board.
 //
 // JIRA API docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/board-deleteBoard
-func (s *BoardService) DeleteBoard(boardID int) (*Board, *Response, error) {
+func (s *BoardService) DeleteBoardWithContext(ctx context.Context, boardID int) (*Board, *Response, error) {
 	apiEndpoint := fmt.Sprintf("rest/agile/1.0/board/%v", boardID)
-	req, err := s.client.NewRequest("DELETE", apiEndpoint, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -211,17 +261,22 @@ func (s *BoardService) DeleteBoard(boardID int) (*Board, *Response, error) {
 	return nil, resp, err
 }
 
-// GetAllSprints will return all sprints from a board, for a given board Id.
+// DeleteBoard wraps DeleteBoardWithContext using the background context.
+func (s *BoardService) DeleteBoard(boardID int) (*Board, *Response, error) {
+	return s.DeleteBoardWithContext(context.Background(), boardID)
+}
+
+// GetAllSprintsWithContext will return all sprints from a board, for a given board Id.
 // This only includes sprints that the user has permission to view.
 //
 // JIRA API docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/board/{boardId}/sprint
-func (s *BoardService) GetAllSprints(boardID string) ([]Sprint, *Response, error) {
+func (s *BoardService) GetAllSprintsWithContext(ctx context.Context, boardID string) ([]Sprint, *Response, error) {
 	id, err := strconv.Atoi(boardID)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	result, response, err := s.GetAllSprintsWithOptions(id, &GetAllSprintsOptions{})
+	result, response, err := s.GetAllSprintsWithOptionsWithContext(ctx, id, &GetAllSprintsOptions{})
 	if err != nil {
 		return nil, nil, err
 	}
@@ -229,17 +284,22 @@ func (s *BoardService) GetAllSprints(boardID string) ([]Sprint, *Response, error
 	return result.Values, response, nil
 }
 
-// GetAllSprintsWithOptions will return sprints from a board, for a given board Id and filtering options
+// GetAllSprints wraps GetAllSprintsWithContext using the background context.
+func (s *BoardService) GetAllSprints(boardID string) ([]Sprint, *Response, error) {
+	return s.GetAllSprintsWithContext(context.Background(), boardID)
+}
+
+// GetAllSprintsWithOptionsWithContext will return sprints from a board, for a given board Id and filtering options
 // This only includes sprints that the user has permission to view.
 //
 // JIRA API docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/board/{boardId}/sprint
-func (s *BoardService) GetAllSprintsWithOptions(boardID int, options *GetAllSprintsOptions) (*SprintsList, *Response, error) {
+func (s *BoardService) GetAllSprintsWithOptionsWithContext(ctx context.Context, boardID int, options *GetAllSprintsOptions) (*SprintsList, *Response, error) {
 	apiEndpoint := fmt.Sprintf("rest/agile/1.0/board/%d/sprint", boardID)
 	url, err := addOptions(apiEndpoint, options)
 	if err != nil {
 		return nil, nil, err
 	}
-	req, err := s.client.NewRequest("GET", url, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -253,12 +313,17 @@ func (s *BoardService) GetAllSprintsWithOptions(boardID int, options *GetAllSpri
 	return result, resp, err
 }
 
-// GetBoardConfiguration will return a board configuration for a given board Id
+// GetAllSprintsWithOptions wraps GetAllSprintsWithOptionsWithContext using the background context.
+func (s *BoardService) GetAllSprintsWithOptions(boardID int, options *GetAllSprintsOptions) (*SprintsList, *Response, error) {
+	return s.GetAllSprintsWithOptionsWithContext(context.Background(), boardID, options)
+}
+
+// GetBoardConfigurationWithContext will return a board configuration for a given board Id
 // Jira API docs:https://developer.atlassian.com/cloud/jira/software/rest/#api-rest-agile-1-0-board-boardId-configuration-get
-func (s *BoardService) GetBoardConfiguration(boardID int) (*BoardConfiguration, *Response, error) {
+func (s *BoardService) GetBoardConfigurationWithContext(ctx context.Context, boardID int) (*BoardConfiguration, *Response, error) {
 	apiEndpoint := fmt.Sprintf("rest/agile/1.0/board/%d/configuration", boardID)
 
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 
 	if err != nil {
 		return nil, nil, err
@@ -273,3 +338,102 @@ func (s *BoardService) GetBoardConfiguration(boardID int) (*BoardConfiguration,
 	return result, resp, err
 
 }
+
+// GetBoardConfiguration wraps GetBoardConfigurationWithContext using the background context.
+func (s *BoardService) GetBoardConfiguration(boardID int) (*BoardConfiguration, *Response, error) {
+	return s.GetBoardConfigurationWithContext(context.Background(), boardID)
+}
+
+// GetIssuesWithContext returns a page of the issues of a board, matching the filter of the board and options.JQL.
+// The paging values of the page are set in the PageInfo of the Response.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/board-getIssuesForBoard
+func (s *BoardService) GetIssuesWithContext(ctx context.Context, boardID int, options *BoardIssuesOptions) ([]Issue, *Response, error) {
+	return s.getIssues(ctx, fmt.Sprintf("rest/agile/1.0/board/%d/issue", boardID), options)
+}
+
+// GetIssues wraps GetIssuesWithContext using the background context.
+func (s *BoardService) GetIssues(boardID int, options *BoardIssuesOptions) ([]Issue, *Response, error) {
+	return s.GetIssuesWithContext(context.Background(), boardID, options)
+}
+
+// GetBacklogIssuesWithContext returns a page of the issues in the backlog of a board, i.e. issues of a scrum board,
+// which are in no active or future sprint. Kanban boards have a backlog only if it is enabled.
+// The paging values of the page are set in the PageInfo of the Response.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/board-getIssuesForBacklog
+func (s *BoardService) GetBacklogIssuesWithContext(ctx context.Context, boardID int, options *BoardIssuesOptions) ([]Issue, *Response, error) {
+	return s.getIssues(ctx, fmt.Sprintf("rest/agile/1.0/board/%d/backlog", boardID), options)
+}
+
+// GetBacklogIssues wraps GetBacklogIssuesWithContext using the background context.
+func (s *BoardService) GetBacklogIssues(boardID int, options *BoardIssuesOptions) ([]Issue, *Response, error) {
+	return s.GetBacklogIssuesWithContext(context.Background(), boardID, options)
+}
+
+// GetIssuesPagesWithContext calls f for all issues of all pages of GetIssues.
+// Returning an error from f stops the paging and the error is returned.
+func (s *BoardService) GetIssuesPagesWithContext(ctx context.Context, boardID int, options *BoardIssuesOptions, f func(Issue) error) error {
+	return s.issuePages(ctx, fmt.Sprintf("rest/agile/1.0/board/%d/issue", boardID), options, f)
+}
+
+// GetIssuesPages wraps GetIssuesPagesWithContext using the background context.
+func (s *BoardService) GetIssuesPages(boardID int, options *BoardIssuesOptions, f func(Issue) error) error {
+	return s.GetIssuesPagesWithContext(context.Background(), boardID, options, f)
+}
+
+// GetBacklogIssuesPagesWithContext calls f for all issues of all pages of GetBacklogIssues.
+// Returning an error from f stops the paging and the error is returned.
+func (s *BoardService) GetBacklogIssuesPagesWithContext(ctx context.Context, boardID int, options *BoardIssuesOptions, f func(Issue) error) error {
+	return s.issuePages(ctx, fmt.Sprintf("rest/agile/1.0/board/%d/backlog", boardID), options, f)
+}
+
+// GetBacklogIssuesPages wraps GetBacklogIssuesPagesWithContext using the background context.
+func (s *BoardService) GetBacklogIssuesPages(boardID int, options *BoardIssuesOptions, f func(Issue) error) error {
+	return s.GetBacklogIssuesPagesWithContext(context.Background(), boardID, options, f)
+}
+
+func (s *BoardService) getIssues(ctx context.Context, apiEndpoint string, options *BoardIssuesOptions) ([]Issue, *Response, error) {
+	url, err := addOptions(apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(boardIssuesResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result.Issues, resp, nil
+}
+
+// issuePages walks through the pages of the issues of a board endpoint
+func (s *BoardService) issuePages(ctx context.Context, apiEndpoint string, options *BoardIssuesOptions, f func(Issue) error) error {
+	opts := BoardIssuesOptions{MaxResults: 50}
+	if options != nil {
+		opts = *options
+		if opts.MaxResults == 0 {
+			opts.MaxResults = 50
+		}
+	}
+
+	for {
+		issues, resp, err := s.getIssues(ctx, apiEndpoint, &opts)
+		if err != nil {
+			return err
+		}
+		for _, issue := range issues {
+			if err := f(issue); err != nil {
+				return err
+			}
+		}
+		if len(issues) == 0 || !resp.HasNextPage() {
+			return nil
+		}
+		opts.StartAt = resp.NextStartAt()
+	}
+}
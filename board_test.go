@@ -249,3 +249,59 @@ func TestBoardService_GetBoardConfigoration(t *testing.T) {
 	}
 
 }
+
+func TestBoardService_GetIssues(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testAPIEndpoint := "/rest/agile/1.0/board/123/issue"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint+"?fields=summary%2Cstatus&jql=assignee+%3D+currentUser%28%29&maxResults=2")
+		fmt.Fprint(w, `{"startAt":0,"maxResults":2,"total":3,"issues":[{"id":"10001","key":"EX-1"},{"id":"10002","key":"EX-2"}]}`)
+	})
+
+	issues, resp, err := testClient.Board.GetIssues(123, &BoardIssuesOptions{
+		JQL:        "assignee = currentUser()",
+		Fields:     []string{"summary", "status"},
+		MaxResults: 2,
+	})
+	if err != nil {
+		t.Fatalf("Got error: %v", err)
+	}
+	if len(issues) != 2 || issues[0].Key != "EX-1" {
+		t.Errorf("Unexpected issues %+v", issues)
+	}
+	if resp.Total != 3 || !resp.HasNextPage() {
+		t.Errorf("Expected a next page, got %+v", resp.PageInfo)
+	}
+}
+
+func TestBoardService_GetBacklogIssuesPages(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/agile/1.0/board/123/backlog", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.URL.Query().Get("startAt") {
+		case "":
+			fmt.Fprint(w, `{"startAt":0,"maxResults":2,"total":3,"issues":[{"id":"10001","key":"EX-1"},{"id":"10002","key":"EX-2"}]}`)
+		case "2":
+			fmt.Fprint(w, `{"startAt":2,"maxResults":2,"total":3,"issues":[{"id":"10003","key":"EX-3"}]}`)
+		default:
+			t.Errorf("Unexpected URL: %v", r.URL)
+		}
+	})
+
+	var keys []string
+	err := testClient.Board.GetBacklogIssuesPages(123, &BoardIssuesOptions{MaxResults: 2}, func(issue Issue) error {
+		keys = append(keys, issue.Key)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Got error: %v", err)
+	}
+	if len(keys) != 3 || keys[2] != "EX-3" {
+		t.Errorf("Unexpected issues %v", keys)
+	}
+}
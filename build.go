@@ -0,0 +1,181 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// BuildService handles the build information of JIRA Software Cloud.
+// Builds are submitted by CI systems and are shown on the issues they reference.
+//
+// Note that this API is only available to Connect apps (JWT authentication) and OAuth 2.0 integrations.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/software/rest/#api-group-Builds
+type BuildService struct {
+	client *Client
+}
+
+const (
+	// BuildStatePending is the state of a build which is queued
+	BuildStatePending = "pending"
+	// BuildStateInProgress is the state of a running build
+	BuildStateInProgress = "in_progress"
+	// BuildStateSuccessful is the state of a build which passed
+	BuildStateSuccessful = "successful"
+	// BuildStateFailed is the state of a build which failed
+	BuildStateFailed = "failed"
+	// BuildStateCancelled is the state of a build which was cancelled
+	BuildStateCancelled = "cancelled"
+	// BuildStateUnknown is the state of a build which is not known
+	BuildStateUnknown = "unknown"
+)
+
+// Build represents a single build (a pipeline run) of a CI system
+type Build struct {
+	SchemaVersion        string           `json:"schemaVersion,omitempty" structs:"schemaVersion,omitempty"`
+	PipelineID           string           `json:"pipelineId" structs:"pipelineId"`
+	BuildNumber          int64            `json:"buildNumber" structs:"buildNumber"`
+	UpdateSequenceNumber int64            `json:"updateSequenceNumber" structs:"updateSequenceNumber"`
+	DisplayName          string           `json:"displayName" structs:"displayName"`
+	Description          string           `json:"description,omitempty" structs:"description,omitempty"`
+	Label                string           `json:"label,omitempty" structs:"label,omitempty"`
+	URL                  string           `json:"url" structs:"url"`
+	State                string           `json:"state" structs:"state"`
+	LastUpdated          string           `json:"lastUpdated" structs:"lastUpdated"`
+	IssueKeys            []string         `json:"issueKeys" structs:"issueKeys"`
+	TestInfo             *BuildTestInfo   `json:"testInfo,omitempty" structs:"testInfo,omitempty"`
+	References           []BuildReference `json:"references,omitempty" structs:"references,omitempty"`
+}
+
+// BuildTestInfo represents a summary of the tests which were executed during a build
+type BuildTestInfo struct {
+	TotalNumber   int `json:"totalNumber" structs:"totalNumber"`
+	NumberPassed  int `json:"numberPassed" structs:"numberPassed"`
+	NumberFailed  int `json:"numberFailed" structs:"numberFailed"`
+	NumberSkipped int `json:"numberSkipped,omitempty" structs:"numberSkipped,omitempty"`
+}
+
+// BuildReference represents the commit and branch a build was executed for
+type BuildReference struct {
+	Commit *BuildCommit `json:"commit,omitempty" structs:"commit,omitempty"`
+	Ref    *BuildRef    `json:"ref,omitempty" structs:"ref,omitempty"`
+}
+
+// BuildCommit represents a commit a build was executed for
+type BuildCommit struct {
+	ID            string `json:"id" structs:"id"`
+	RepositoryURI string `json:"repositoryUri" structs:"repositoryUri"`
+}
+
+// BuildRef represents a branch or tag a build was executed for
+type BuildRef struct {
+	Name string `json:"name" structs:"name"`
+	URI  string `json:"uri" structs:"uri"`
+}
+
+// ProviderMetadata describes the tool which submits build or deployment information
+type ProviderMetadata struct {
+	Product string `json:"product,omitempty" structs:"product,omitempty"`
+}
+
+// SubmitBuildsPayload is the payload of the BuildService.Submit method
+type SubmitBuildsPayload struct {
+	Properties       map[string]string `json:"properties,omitempty" structs:"properties,omitempty"`
+	Builds           []Build           `json:"builds" structs:"builds"`
+	ProviderMetadata *ProviderMetadata `json:"providerMetadata,omitempty" structs:"providerMetadata,omitempty"`
+}
+
+// BuildKey identifies a single build
+type BuildKey struct {
+	PipelineID  string `json:"pipelineId" structs:"pipelineId"`
+	BuildNumber int64  `json:"buildNumber" structs:"buildNumber"`
+}
+
+// SubmitError represents a reason why submitted build or deployment information was rejected
+type SubmitError struct {
+	Message string `json:"message" structs:"message"`
+}
+
+// RejectedBuild represents a build which was rejected by JIRA
+type RejectedBuild struct {
+	Key    BuildKey      `json:"key" structs:"key"`
+	Errors []SubmitError `json:"errors" structs:"errors"`
+}
+
+// SubmitBuildsResult is the result of the BuildService.Submit method
+type SubmitBuildsResult struct {
+	AcceptedBuilds   []BuildKey      `json:"acceptedBuilds" structs:"acceptedBuilds"`
+	RejectedBuilds   []RejectedBuild `json:"rejectedBuilds" structs:"rejectedBuilds"`
+	UnknownIssueKeys []string        `json:"unknownIssueKeys" structs:"unknownIssueKeys"`
+}
+
+// SubmitWithContext submits (creates or updates) build information.
+// Builds are identified by the combination of PipelineID and BuildNumber.
+// An existing build is only updated if UpdateSequenceNumber is higher than the stored one.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/software/rest/#api-builds-0-1-bulk-post
+func (s *BuildService) SubmitWithContext(ctx context.Context, payload *SubmitBuildsPayload) (*SubmitBuildsResult, *Response, error) {
+	apiEndpoint := "rest/builds/0.1/bulk"
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(SubmitBuildsResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result, resp, nil
+}
+
+// Submit wraps SubmitWithContext using the background context.
+func (s *BuildService) Submit(payload *SubmitBuildsPayload) (*SubmitBuildsResult, *Response, error) {
+	return s.SubmitWithContext(context.Background(), payload)
+}
+
+// GetWithContext returns the build with the given pipeline ID and build number.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/software/rest/#api-builds-0-1-pipelines-pipelineId-builds-buildNumber-get
+func (s *BuildService) GetWithContext(ctx context.Context, pipelineID string, buildNumber int64) (*Build, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/builds/0.1/pipelines/%s/builds/%d", url.PathEscape(pipelineID), buildNumber)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	build := new(Build)
+	resp, err := s.client.Do(req, build)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return build, resp, nil
+}
+
+// Get wraps GetWithContext using the background context.
+func (s *BuildService) Get(pipelineID string, buildNumber int64) (*Build, *Response, error) {
+	return s.GetWithContext(context.Background(), pipelineID, buildNumber)
+}
+
+// DeleteWithContext deletes the build with the given pipeline ID and build number.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/software/rest/#api-builds-0-1-pipelines-pipelineId-builds-buildNumber-delete
+func (s *BuildService) DeleteWithContext(ctx context.Context, pipelineID string, buildNumber int64) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/builds/0.1/pipelines/%s/builds/%d", url.PathEscape(pipelineID), buildNumber)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// Delete wraps DeleteWithContext using the background context.
+func (s *BuildService) Delete(pipelineID string, buildNumber int64) (*Response, error) {
+	return s.DeleteWithContext(context.Background(), pipelineID, buildNumber)
+}
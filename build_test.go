@@ -0,0 +1,89 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestBuildService_Submit(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/builds/0.1/bulk"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		payload := new(SubmitBuildsPayload)
+		if err := json.NewDecoder(r.Body).Decode(payload); err != nil {
+			t.Error(err)
+		}
+		if len(payload.Builds) != 1 || payload.Builds[0].State != BuildStateSuccessful {
+			t.Errorf("Unexpected payload: %+v", payload)
+		}
+
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"acceptedBuilds":[{"pipelineId":"my-pipeline","buildNumber":16}],"rejectedBuilds":[],"unknownIssueKeys":["EX-99"]}`)
+	})
+
+	result, _, err := testClient.Build.Submit(&SubmitBuildsPayload{
+		Builds: []Build{{
+			PipelineID:           "my-pipeline",
+			BuildNumber:          16,
+			UpdateSequenceNumber: 1,
+			DisplayName:          "Build #16",
+			URL:                  "https://ci.example.com/my-pipeline/16",
+			State:                BuildStateSuccessful,
+			LastUpdated:          "2020-01-20T10:10:10.000Z",
+			IssueKeys:            []string{"EX-1", "EX-99"},
+			TestInfo:             &BuildTestInfo{TotalNumber: 10, NumberPassed: 10},
+		}},
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+		return
+	}
+	if len(result.AcceptedBuilds) != 1 || result.AcceptedBuilds[0].BuildNumber != 16 {
+		t.Errorf("Unexpected accepted builds: %+v", result.AcceptedBuilds)
+	}
+	if len(result.UnknownIssueKeys) != 1 || result.UnknownIssueKeys[0] != "EX-99" {
+		t.Errorf("Unexpected unknown issue keys: %+v", result.UnknownIssueKeys)
+	}
+}
+
+func TestBuildService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/builds/0.1/pipelines/my-pipeline/builds/16"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"schemaVersion":"1.0","pipelineId":"my-pipeline","buildNumber":16,"updateSequenceNumber":1,"displayName":"Build #16","url":"https://ci.example.com/my-pipeline/16","state":"failed","lastUpdated":"2020-01-20T10:10:10.000Z","issueKeys":["EX-1"]}`)
+	})
+
+	build, _, err := testClient.Build.Get("my-pipeline", 16)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+		return
+	}
+	if build.State != BuildStateFailed {
+		t.Errorf("Expected state failed. Got %s", build.State)
+	}
+}
+
+func TestBuildService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/builds/0.1/pipelines/my-pipeline/builds/16"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, testAPIEndpoint)
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	_, err := testClient.Build.Delete("my-pipeline", 16)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
@@ -0,0 +1,143 @@
+package jira
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// ClientSet holds the clients of multiple JIRA instances, e.g. JIRA Cloud and JIRA Data Center,
+// keyed by alias. Requests can be routed to the instance owning a project,
+// which is common in migration and federation tooling.
+// It is safe for concurrent use.
+type ClientSet struct {
+	mu           sync.RWMutex
+	clients      map[string]*Client
+	projects     map[string]string
+	defaultAlias string
+}
+
+// NewClientSet returns an empty ClientSet
+func NewClientSet() *ClientSet {
+	return &ClientSet{
+		clients:  map[string]*Client{},
+		projects: map[string]string{},
+	}
+}
+
+// Add adds the client under alias, replacing a client with the same alias.
+// Issues of the given project keys are routed to the client.
+// The first added client is the default, see SetDefault.
+func (s *ClientSet) Add(alias string, client *Client, projectKeys ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.clients[alias] = client
+	for _, key := range projectKeys {
+		s.projects[strings.ToUpper(key)] = alias
+	}
+	if s.defaultAlias == "" {
+		s.defaultAlias = alias
+	}
+}
+
+// Remove removes the client with the given alias and its project routes
+func (s *ClientSet) Remove(alias string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.clients, alias)
+	for key, a := range s.projects {
+		if a == alias {
+			delete(s.projects, key)
+		}
+	}
+	if s.defaultAlias == alias {
+		s.defaultAlias = ""
+	}
+}
+
+// SetDefault sets the alias of the client, which handles projects without a route.
+// An empty alias disables the default.
+func (s *ClientSet) SetDefault(alias string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.clients[alias]; alias != "" && !ok {
+		return fmt.Errorf("no client with alias %q", alias)
+	}
+	s.defaultAlias = alias
+	return nil
+}
+
+// AddRoute routes the issues of the project key to the client with the given alias
+func (s *ClientSet) AddRoute(projectKey, alias string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.clients[alias]; !ok {
+		return fmt.Errorf("no client with alias %q", alias)
+	}
+	s.projects[strings.ToUpper(projectKey)] = alias
+	return nil
+}
+
+// Get returns the client with the given alias
+func (s *ClientSet) Get(alias string) (*Client, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	client, ok := s.clients[alias]
+	return client, ok
+}
+
+// Aliases returns the sorted aliases of all clients
+func (s *ClientSet) Aliases() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	aliases := make([]string, 0, len(s.clients))
+	for alias := range s.clients {
+		aliases = append(aliases, alias)
+	}
+	sort.Strings(aliases)
+	return aliases
+}
+
+// Route returns the client and its alias for the given issue key (like "ABC-123") or project key (like "ABC").
+// Keys without a route are handled by the default client.
+func (s *ClientSet) Route(key string) (*Client, string, error) {
+	projectKey := strings.ToUpper(key)
+	if i := strings.LastIndex(projectKey, "-"); i > 0 {
+		projectKey = projectKey[:i]
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	alias, ok := s.projects[projectKey]
+	if !ok {
+		alias = s.defaultAlias
+	}
+	client, ok := s.clients[alias]
+	if !ok {
+		return nil, "", fmt.Errorf("no client for project %s", projectKey)
+	}
+	return client, alias, nil
+}
+
+// Each calls f for every client in the order of their aliases.
+// It stops and returns the error if f returns one.
+func (s *ClientSet) Each(f func(alias string, client *Client) error) error {
+	for _, alias := range s.Aliases() {
+		client, ok := s.Get(alias)
+		if !ok {
+			continue
+		}
+		if err := f(alias, client); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package jira
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestClientSet_Route(t *testing.T) {
+	cloud, _ := NewClient(nil, "https://example.atlassian.net")
+	dc, _ := NewClient(nil, "https://jira.example.com")
+
+	set := NewClientSet()
+	set.Add("cloud", cloud)
+	set.Add("dc", dc, "LEGACY", "old")
+
+	tests := []struct {
+		key   string
+		alias string
+	}{
+		{"LEGACY-123", "dc"},
+		{"OLD", "dc"},
+		{"old-1", "dc"},
+		{"NEW-1", "cloud"},
+		{"MY-TEAM-7", "cloud"},
+	}
+	for _, test := range tests {
+		client, alias, err := set.Route(test.key)
+		if err != nil {
+			t.Fatalf("Error given for %s: %s", test.key, err)
+		}
+		if alias != test.alias {
+			t.Errorf("Expected %s to be routed to %s, got %s", test.key, test.alias, alias)
+		}
+		if expected, _ := set.Get(test.alias); client != expected {
+			t.Errorf("Unexpected client for %s", test.key)
+		}
+	}
+
+	if err := set.AddRoute("NEW", "dc"); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if _, alias, _ := set.Route("NEW-1"); alias != "dc" {
+		t.Errorf("Expected NEW-1 to be routed to dc, got %s", alias)
+	}
+	if err := set.AddRoute("NEW", "unknown"); err == nil {
+		t.Error("Expected error for unknown alias")
+	}
+}
+
+func TestClientSet_NoDefault(t *testing.T) {
+	dc, _ := NewClient(nil, "https://jira.example.com")
+
+	set := NewClientSet()
+	set.Add("dc", dc, "LEGACY")
+	if err := set.SetDefault(""); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if _, _, err := set.Route("NEW-1"); err == nil {
+		t.Error("Expected error for project without route")
+	}
+
+	set.Remove("dc")
+	if _, _, err := set.Route("LEGACY-1"); err == nil {
+		t.Error("Expected error after removing the client")
+	}
+	if err := set.SetDefault("dc"); err == nil {
+		t.Error("Expected error for unknown alias")
+	}
+}
+
+func TestClientSet_Each(t *testing.T) {
+	a, _ := NewClient(nil, "https://a.example.com")
+	b, _ := NewClient(nil, "https://b.example.com")
+
+	set := NewClientSet()
+	set.Add("b", b)
+	set.Add("a", a)
+
+	var visited []string
+	err := set.Each(func(alias string, client *Client) error {
+		visited = append(visited, alias)
+		if alias == "b" {
+			return fmt.Errorf("stop")
+		}
+		return nil
+	})
+	if err == nil {
+		t.Error("Expected error")
+	}
+	if !reflect.DeepEqual(visited, []string{"a", "b"}) {
+		t.Errorf("Unexpected order %v", visited)
+	}
+}
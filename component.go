@@ -1,5 +1,11 @@
 package jira
 
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
 // ComponentService handles components for the JIRA instance / API.
 //
 // JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/component
@@ -13,16 +19,28 @@ type CreateComponentOptions struct {
 	Description  string `json:"description,omitempty" structs:"description,omitempty"`
 	Lead         *User  `json:"lead,omitempty" structs:"lead,omitempty"`
 	LeadUserName string `json:"leadUserName,omitempty" structs:"leadUserName,omitempty"`
-	AssigneeType string `json:"assigneeType,omitempty" structs:"assigneeType,omitempty"`
-	Assignee     *User  `json:"assignee,omitempty" structs:"assignee,omitempty"`
-	Project      string `json:"project,omitempty" structs:"project,omitempty"`
-	ProjectID    int    `json:"projectId,omitempty" structs:"projectId,omitempty"`
+	// LeadAccountID is the account ID of the lead on JIRA Cloud, which replaces LeadUserName
+	LeadAccountID string `json:"leadAccountId,omitempty" structs:"leadAccountId,omitempty"`
+	AssigneeType  string `json:"assigneeType,omitempty" structs:"assigneeType,omitempty"`
+	Assignee      *User  `json:"assignee,omitempty" structs:"assignee,omitempty"`
+	Project       string `json:"project,omitempty" structs:"project,omitempty"`
+	ProjectID     int    `json:"projectId,omitempty" structs:"projectId,omitempty"`
 }
 
-// Create creates a new JIRA component based on the given options.
-func (s *ComponentService) Create(options *CreateComponentOptions) (*ProjectComponent, *Response, error) {
+// UpdateComponentOptions are passed to the ComponentService.Update function to update a JIRA component.
+// Only the given fields are changed. AssigneeType is one of the AssigneeType constants, e.g. AssigneeTypeComponentLead.
+type UpdateComponentOptions struct {
+	Name          string `json:"name,omitempty" structs:"name,omitempty"`
+	Description   string `json:"description,omitempty" structs:"description,omitempty"`
+	LeadUserName  string `json:"leadUserName,omitempty" structs:"leadUserName,omitempty"`
+	LeadAccountID string `json:"leadAccountId,omitempty" structs:"leadAccountId,omitempty"`
+	AssigneeType  string `json:"assigneeType,omitempty" structs:"assigneeType,omitempty"`
+}
+
+// CreateWithContext creates a new JIRA component based on the given options.
+func (s *ComponentService) CreateWithContext(ctx context.Context, options *CreateComponentOptions) (*ProjectComponent, *Response, error) {
 	apiEndpoint := "rest/api/2/component"
-	req, err := s.client.NewRequest("POST", apiEndpoint, options)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, options)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -36,3 +54,105 @@ func (s *ComponentService) Create(options *CreateComponentOptions) (*ProjectComp
 
 	return component, resp, nil
 }
+
+// Create wraps CreateWithContext using the background context.
+func (s *ComponentService) Create(options *CreateComponentOptions) (*ProjectComponent, *Response, error) {
+	return s.CreateWithContext(context.Background(), options)
+}
+
+// GetWithContext returns the component with the given ID.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-project-components/#api-rest-api-2-component-id-get
+func (s *ComponentService) GetWithContext(ctx context.Context, componentID string) (*ProjectComponent, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/component/%s", componentID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	component := new(ProjectComponent)
+	resp, err := s.client.Do(req, component)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return component, resp, nil
+}
+
+// Get wraps GetWithContext using the background context.
+func (s *ComponentService) Get(componentID string) (*ProjectComponent, *Response, error) {
+	return s.GetWithContext(context.Background(), componentID)
+}
+
+// UpdateWithContext updates the component with the given ID, e.g. its lead or assignee type, and returns the updated component.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-project-components/#api-rest-api-2-component-id-put
+func (s *ComponentService) UpdateWithContext(ctx context.Context, componentID string, options *UpdateComponentOptions) (*ProjectComponent, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/component/%s", componentID)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	component := new(ProjectComponent)
+	resp, err := s.client.Do(req, component)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return component, resp, nil
+}
+
+// Update wraps UpdateWithContext using the background context.
+func (s *ComponentService) Update(componentID string, options *UpdateComponentOptions) (*ProjectComponent, *Response, error) {
+	return s.UpdateWithContext(context.Background(), componentID, options)
+}
+
+// DeleteWithContext deletes the component with the given ID.
+// The issues of the component are moved to the component moveIssuesTo, or keep no component if it is empty.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-project-components/#api-rest-api-2-component-id-delete
+func (s *ComponentService) DeleteWithContext(ctx context.Context, componentID, moveIssuesTo string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/component/%s", componentID)
+	if moveIssuesTo != "" {
+		apiEndpoint += "?moveIssuesTo=" + url.QueryEscape(moveIssuesTo)
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// Delete wraps DeleteWithContext using the background context.
+func (s *ComponentService) Delete(componentID, moveIssuesTo string) (*Response, error) {
+	return s.DeleteWithContext(context.Background(), componentID, moveIssuesTo)
+}
+
+// GetRelatedIssueCountWithContext returns the number of issues of the component with the given ID.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-project-components/#api-rest-api-2-component-id-relatedissuecounts-get
+func (s *ComponentService) GetRelatedIssueCountWithContext(ctx context.Context, componentID string) (int, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/component/%s/relatedIssueCounts", componentID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	counts := new(struct {
+		IssueCount int `json:"issueCount"`
+	})
+	resp, err := s.client.Do(req, counts)
+	if err != nil {
+		return 0, resp, NewJiraError(resp, err)
+	}
+	return counts.IssueCount, resp, nil
+}
+
+// GetRelatedIssueCount wraps GetRelatedIssueCountWithContext using the background context.
+func (s *ComponentService) GetRelatedIssueCount(componentID string) (int, *Response, error) {
+	return s.GetRelatedIssueCountWithContext(context.Background(), componentID)
+}
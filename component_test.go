@@ -2,6 +2,7 @@ package jira
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"testing"
 )
@@ -27,3 +28,78 @@ func TestComponentService_Create_Success(t *testing.T) {
 		t.Errorf("Error given: %s", err)
 	}
 }
+
+func TestComponentService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/component/10000", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/component/10000")
+		fmt.Fprint(w, `{"id":"10000","name":"Component 1","lead":{"accountId":"5b10a2844c20165700ede21g"},"assigneeType":"COMPONENT_LEAD","project":"HSP","projectId":10000}`)
+	})
+
+	component, _, err := testClient.Component.Get("10000")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if component.Name != "Component 1" || component.Lead.AccountID != "5b10a2844c20165700ede21g" || component.AssigneeType != AssigneeTypeComponentLead {
+		t.Errorf("Unexpected component %+v", component)
+	}
+}
+
+func TestComponentService_Update(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/component/10000", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, "/rest/api/2/component/10000")
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"leadAccountId":"5b10a2844c20165700ede21g","assigneeType":"COMPONENT_LEAD"}`+"\n" {
+			t.Errorf("Unexpected body %s", body)
+		}
+		fmt.Fprint(w, `{"id":"10000","name":"Component 1","lead":{"accountId":"5b10a2844c20165700ede21g"},"assigneeType":"COMPONENT_LEAD"}`)
+	})
+
+	component, _, err := testClient.Component.Update("10000", &UpdateComponentOptions{
+		LeadAccountID: "5b10a2844c20165700ede21g",
+		AssigneeType:  AssigneeTypeComponentLead,
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if component.Lead.AccountID != "5b10a2844c20165700ede21g" {
+		t.Errorf("Unexpected component %+v", component)
+	}
+}
+
+func TestComponentService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/component/10000", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, "/rest/api/2/component/10000?moveIssuesTo=10001")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Component.Delete("10000", "10001"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestComponentService_GetRelatedIssueCount(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/component/10000/relatedIssueCounts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/component/10000/relatedIssueCounts")
+		fmt.Fprint(w, `{"self":"https://your-domain.atlassian.net/rest/api/2/component/10000","issueCount":23}`)
+	})
+
+	count, _, err := testClient.Component.GetRelatedIssueCount("10000")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if count != 23 {
+		t.Errorf("Expected 23 issues. Got %d", count)
+	}
+}
@@ -0,0 +1,111 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrConflict is the cause of a *ConflictError, use errors.Cause(err) == ErrConflict to check for a conflict
+var ErrConflict = errors.New("the entity was changed since it was read")
+
+// ConflictError is returned by the IfUnchanged methods if the entity was updated
+// by someone else since it was read, so the update would overwrite the other change.
+// The caller should read the entity again, reapply its change and retry.
+type ConflictError struct {
+	// Entity is the kind of the entity, "issue" or "comment"
+	Entity string
+	ID     string
+	// Expected is the update timestamp of the entity when it was read, Actual the current one
+	Expected string
+	Actual   string
+}
+
+// Error returns the description of the conflict
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("%s: %s %s was updated at %s, expected %s", ErrConflict, e.Entity, e.ID, e.Actual, e.Expected)
+}
+
+// Cause returns ErrConflict
+func (e *ConflictError) Cause() error {
+	return ErrConflict
+}
+
+// Unwrap returns ErrConflict
+func (e *ConflictError) Unwrap() error {
+	return ErrConflict
+}
+
+// UpdateIfUnchangedWithContext updates the issue like UpdateWithOptions, unless it was updated since it was read.
+// issue.Fields.Updated must contain the "updated" field of the issue as it was read.
+// If the issue was updated since, a *ConflictError is returned and the issue is not changed.
+//
+// JIRA has no conditional updates, the check is done with a request right before the update.
+// Changes in between these two requests are not detected.
+func (s *IssueService) UpdateIfUnchangedWithContext(ctx context.Context, issue *Issue, opts *UpdateQueryOptions) (*Issue, *Response, error) {
+	if issue == nil || issue.Fields == nil || time.Time(issue.Fields.Updated).IsZero() {
+		return nil, nil, fmt.Errorf("the updated field of the issue is required")
+	}
+
+	id := issue.Key
+	if id == "" {
+		id = issue.ID
+	}
+	current, resp, err := s.GetWithContext(ctx, id, &GetQueryOptions{Fields: "updated"})
+	if err != nil {
+		return nil, resp, err
+	}
+	if current.Fields == nil || !current.Fields.Updated.Equal(issue.Fields.Updated) {
+		actual := ""
+		if current.Fields != nil {
+			actual = time.Time(current.Fields.Updated).Format(time.RFC3339)
+		}
+		return nil, resp, &ConflictError{
+			Entity:   "issue",
+			ID:       id,
+			Expected: time.Time(issue.Fields.Updated).Format(time.RFC3339),
+			Actual:   actual,
+		}
+	}
+
+	return s.UpdateWithOptionsWithContext(ctx, issue, opts)
+}
+
+// UpdateIfUnchanged wraps UpdateIfUnchangedWithContext using the background context.
+func (s *IssueService) UpdateIfUnchanged(issue *Issue, opts *UpdateQueryOptions) (*Issue, *Response, error) {
+	return s.UpdateIfUnchangedWithContext(context.Background(), issue, opts)
+}
+
+// UpdateCommentIfUnchangedWithContext updates the comment like UpdateComment, unless it was updated since it was read.
+// comment.Updated must contain the "updated" field of the comment as it was read.
+// If the comment was updated since, a *ConflictError is returned and the comment is not changed.
+//
+// JIRA has no conditional updates, the check is done with a request right before the update.
+// Changes in between these two requests are not detected.
+func (s *IssueService) UpdateCommentIfUnchangedWithContext(ctx context.Context, issueID string, comment *Comment) (*Comment, *Response, error) {
+	if comment == nil || comment.ID == "" || comment.Updated == "" {
+		return nil, nil, fmt.Errorf("the ID and the updated field of the comment are required")
+	}
+
+	current, resp, err := s.GetCommentWithContext(ctx, issueID, comment.ID)
+	if err != nil {
+		return nil, resp, err
+	}
+	if current.Updated != comment.Updated {
+		return nil, resp, &ConflictError{
+			Entity:   "comment",
+			ID:       comment.ID,
+			Expected: comment.Updated,
+			Actual:   current.Updated,
+		}
+	}
+
+	return s.UpdateCommentWithContext(ctx, issueID, comment)
+}
+
+// UpdateCommentIfUnchanged wraps UpdateCommentIfUnchangedWithContext using the background context.
+func (s *IssueService) UpdateCommentIfUnchanged(issueID string, comment *Comment) (*Comment, *Response, error) {
+	return s.UpdateCommentIfUnchangedWithContext(context.Background(), issueID, comment)
+}
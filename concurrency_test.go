@@ -0,0 +1,88 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+func TestIssueService_UpdateIfUnchanged(t *testing.T) {
+	setup()
+	defer teardown()
+
+	updated := "2020-05-17T10:00:00.000+0000"
+	puts := 0
+	testMux.HandleFunc("/rest/api/2/issue/EX-1", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			testRequestURL(t, r, "/rest/api/2/issue/EX-1?fields=updated")
+			fmt.Fprintf(w, `{"id":"10001","key":"EX-1","fields":{"updated":"%s"}}`, updated)
+		case "PUT":
+			puts++
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	read, _ := time.Parse("2006-01-02T15:04:05.000-0700", "2020-05-17T10:00:00.000+0000")
+	issue := &Issue{Key: "EX-1", Fields: &IssueFields{Summary: "New", Updated: Time(read)}}
+	if _, _, err := testClient.Issue.UpdateIfUnchanged(issue, nil); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if puts != 1 {
+		t.Errorf("Expected the issue to be updated")
+	}
+
+	updated = "2020-05-17T11:00:00.000+0000"
+	_, _, err := testClient.Issue.UpdateIfUnchanged(issue, nil)
+	if errors.Cause(err) != ErrConflict {
+		t.Fatalf("Expected ErrConflict, got %v", err)
+	}
+	if conflict := err.(*ConflictError); conflict.Entity != "issue" || conflict.ID != "EX-1" {
+		t.Errorf("Unexpected conflict %+v", conflict)
+	}
+	if puts != 1 {
+		t.Errorf("Expected the conflicting issue not to be updated")
+	}
+}
+
+func TestIssueService_UpdateCommentIfUnchanged(t *testing.T) {
+	setup()
+	defer teardown()
+
+	updated := "2020-05-17T10:00:00.000+0000"
+	puts := 0
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/comment/10000", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprintf(w, `{"id":"10000","body":"Old","updated":"%s"}`, updated)
+		case "PUT":
+			puts++
+			fmt.Fprint(w, `{"id":"10000","body":"New","updated":"2020-05-17T12:00:00.000+0000"}`)
+		}
+	})
+
+	comment := &Comment{ID: "10000", Body: "New", Updated: "2020-05-17T10:00:00.000+0000"}
+	result, _, err := testClient.Issue.UpdateCommentIfUnchanged("EX-1", comment)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if result.Body != "New" || puts != 1 {
+		t.Errorf("Expected the comment to be updated, got %+v", result)
+	}
+
+	updated = "2020-05-17T11:00:00.000+0000"
+	_, _, err = testClient.Issue.UpdateCommentIfUnchanged("EX-1", comment)
+	conflict, ok := err.(*ConflictError)
+	if !ok || errors.Cause(err) != ErrConflict {
+		t.Fatalf("Expected a ConflictError, got %v", err)
+	}
+	if conflict.Expected != comment.Updated || conflict.Actual != updated {
+		t.Errorf("Unexpected conflict %+v", conflict)
+	}
+	if puts != 1 {
+		t.Errorf("Expected the conflicting comment not to be updated")
+	}
+}
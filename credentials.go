@@ -0,0 +1,240 @@
+package jira
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// These constants are the authentication methods of Credentials
+const (
+	// CredentialAuthBasic authenticates with username and password (HTTP Basic)
+	CredentialAuthBasic = "basic"
+	// CredentialAuthAPIToken authenticates with email address and API token (HTTP Basic, JIRA Cloud)
+	CredentialAuthAPIToken = "api-token"
+	// CredentialAuthBearerToken authenticates with a Personal Access Token (JIRA Server / Data Center)
+	CredentialAuthBearerToken = "bearer-token"
+)
+
+// These constants are the environment variables read by CredentialStore.Load.
+// They take precedence over the values of the config file.
+const (
+	EnvBaseURL    = "JIRA_URL"
+	EnvAuthMethod = "JIRA_AUTH_METHOD"
+	EnvUser       = "JIRA_USER"
+	EnvPassword   = "JIRA_PASSWORD"
+	EnvAPIToken   = "JIRA_API_TOKEN"
+)
+
+// DefaultCredentialsFile is the config file read by CredentialStore.Load, relative to the home directory of the user
+var DefaultCredentialsFile = filepath.Join(".jira.d", "config.yml")
+
+// DefaultKeyringService is the service name the secrets are stored under in the keyring
+const DefaultKeyringService = "go-jira"
+
+// Credentials holds the configuration to connect to a JIRA instance
+type Credentials struct {
+	BaseURL    string
+	AuthMethod string
+	User       string
+	// Secret is the password, the API token or the Personal Access Token, depending on AuthMethod
+	Secret string
+}
+
+// HTTPClient returns an *http.Client, which authenticates all requests with the credentials
+func (c *Credentials) HTTPClient() (*http.Client, error) {
+	switch c.AuthMethod {
+	case "", CredentialAuthBasic, CredentialAuthAPIToken:
+		return (&BasicAuthTransport{Username: c.User, Password: c.Secret}).Client(), nil
+	case CredentialAuthBearerToken:
+		return (&PATAuthTransport{Token: c.Secret}).Client(), nil
+	default:
+		return nil, fmt.Errorf("unsupported authentication method %q", c.AuthMethod)
+	}
+}
+
+// NewClient returns a new JIRA API client for the instance of the credentials
+func (c *Credentials) NewClient() (*Client, error) {
+	if c.BaseURL == "" {
+		return nil, fmt.Errorf("no base URL configured")
+	}
+	httpClient, err := c.HTTPClient()
+	if err != nil {
+		return nil, err
+	}
+	return NewClient(httpClient, c.BaseURL)
+}
+
+// Keyring looks up secrets in the keyring of the operating system
+type Keyring interface {
+	Get(service, user string) (string, error)
+}
+
+// CommandKeyring is a Keyring using the command line tools of the operating system:
+// security (Keychain) on macOS and secret-tool (Secret Service, e.g. GNOME Keyring) on Linux.
+type CommandKeyring struct{}
+
+// Get returns the secret of user stored for service
+func (CommandKeyring) Get(service, user string) (string, error) {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("security", "find-generic-password", "-s", service, "-a", user, "-w")
+	case "linux", "freebsd", "openbsd":
+		cmd = exec.Command("secret-tool", "lookup", "service", service, "username", user)
+	default:
+		return "", fmt.Errorf("keyring is not supported on %s", runtime.GOOS)
+	}
+
+	var stdout bytes.Buffer
+	cmd.Stdout = &stdout
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("looking up %s in the keyring: %v", user, err)
+	}
+	return strings.TrimRight(stdout.String(), "\r\n"), nil
+}
+
+// CredentialStore loads Credentials from a config file, the environment and the keyring,
+// so multiple tools can share one configuration.
+//
+// The config file contains "key: value" lines (a flat subset of YAML, compatible with the go-jira CLI):
+//
+//	endpoint: https://example.atlassian.net
+//	user: fred@example.com
+//	authentication-method: api-token
+//
+// "password" or "token" can hold the secret, but the secret is preferably provided by the
+// JIRA_API_TOKEN or JIRA_PASSWORD environment variable, or by the keyring.
+// The environment variables (see Env* constants) take precedence over the config file.
+//
+// Load reads the sources again on every call, so a retrying tool picks up rotated secrets.
+type CredentialStore struct {
+	// Path of the config file. If empty, DefaultCredentialsFile in the home directory is used,
+	// which is optional.
+	Path string
+	// Keyring is used to look up the secret, if neither the config file nor the environment contain it.
+	// If nil, the keyring is not used.
+	Keyring Keyring
+	// KeyringService is the service name of the secret in the keyring, DefaultKeyringService if empty
+	KeyringService string
+	// Getenv looks up environment variables, os.Getenv if nil
+	Getenv func(string) string
+}
+
+// LoadCredentials loads the credentials from the default config file, the environment and the keyring
+func LoadCredentials() (*Credentials, error) {
+	store := &CredentialStore{Keyring: CommandKeyring{}}
+	return store.Load()
+}
+
+// Load returns the credentials of the store
+func (s *CredentialStore) Load() (*Credentials, error) {
+	getenv := s.Getenv
+	if getenv == nil {
+		getenv = os.Getenv
+	}
+
+	values, err := s.readFile()
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Credentials{
+		BaseURL:    values["endpoint"],
+		AuthMethod: values["authentication-method"],
+		User:       values["user"],
+		Secret:     values["password"],
+	}
+	if c.User == "" {
+		c.User = values["login"]
+	}
+	if c.Secret == "" {
+		c.Secret = values["token"]
+	}
+
+	if v := getenv(EnvBaseURL); v != "" {
+		c.BaseURL = v
+	}
+	if v := getenv(EnvAuthMethod); v != "" {
+		c.AuthMethod = v
+	}
+	if v := getenv(EnvUser); v != "" {
+		c.User = v
+	}
+	if v := getenv(EnvAPIToken); v != "" {
+		c.Secret = v
+	} else if v := getenv(EnvPassword); v != "" {
+		c.Secret = v
+	}
+
+	if c.Secret == "" && s.Keyring != nil && c.User != "" {
+		service := s.KeyringService
+		if service == "" {
+			service = DefaultKeyringService
+		}
+		secret, err := s.Keyring.Get(service, c.User)
+		if err != nil {
+			return nil, err
+		}
+		c.Secret = secret
+	}
+
+	if c.BaseURL == "" {
+		return nil, fmt.Errorf("no base URL configured, set endpoint in the config file or %s", EnvBaseURL)
+	}
+	return c, nil
+}
+
+// readFile parses the config file into its key value pairs
+func (s *CredentialStore) readFile() (map[string]string, error) {
+	path := s.Path
+	optional := false
+	if path == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return map[string]string{}, nil
+		}
+		path = filepath.Join(home, DefaultCredentialsFile)
+		optional = true
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		if optional && os.IsNotExist(err) {
+			return map[string]string{}, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	values := map[string]string{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+		// skip comments, documents separators and nested values
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") || trimmed == "---" || line[0] == ' ' || line[0] == '\t' {
+			continue
+		}
+		parts := strings.SplitN(trimmed, ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		values[strings.TrimSpace(parts[0])] = unquote(strings.TrimSpace(parts[1]))
+	}
+	return values, scanner.Err()
+}
+
+// unquote removes the quotes around a YAML string value
+func unquote(s string) string {
+	if len(s) >= 2 && (s[0] == '"' || s[0] == '\'') && s[len(s)-1] == s[0] {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
@@ -0,0 +1,116 @@
+package jira
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+type testKeyring map[string]string
+
+func (k testKeyring) Get(service, user string) (string, error) {
+	secret, ok := k[service+"/"+user]
+	if !ok {
+		return "", fmt.Errorf("no secret for %s", user)
+	}
+	return secret, nil
+}
+
+func writeCredentialsFile(t *testing.T, content string) (string, func()) {
+	dir, err := ioutil.TempDir("", "go-jira")
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "config.yml")
+	if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path, func() { os.RemoveAll(dir) }
+}
+
+func TestCredentialStore_Load_File(t *testing.T) {
+	path, cleanup := writeCredentialsFile(t, `---
+# shared configuration
+endpoint: "https://example.atlassian.net"
+user: fred@example.com
+authentication-method: api-token
+custom-commands:
+  - name: mine
+`)
+	defer cleanup()
+
+	store := &CredentialStore{
+		Path:    path,
+		Keyring: testKeyring{"go-jira/fred@example.com": "keyring-token"},
+		Getenv:  func(string) string { return "" },
+	}
+	c, err := store.Load()
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	expected := Credentials{BaseURL: "https://example.atlassian.net", AuthMethod: CredentialAuthAPIToken, User: "fred@example.com", Secret: "keyring-token"}
+	if *c != expected {
+		t.Errorf("Expected %+v, got %+v", expected, *c)
+	}
+}
+
+func TestCredentialStore_Load_EnvOverridesFile(t *testing.T) {
+	path, cleanup := writeCredentialsFile(t, "endpoint: https://jira.example.com\nuser: fred\npassword: from-file\n")
+	defer cleanup()
+
+	env := map[string]string{
+		EnvAuthMethod: CredentialAuthBearerToken,
+		EnvAPIToken:   "from-env",
+	}
+	store := &CredentialStore{
+		Path:    path,
+		Keyring: testKeyring{},
+		Getenv:  func(key string) string { return env[key] },
+	}
+	c, err := store.Load()
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if c.BaseURL != "https://jira.example.com" || c.AuthMethod != CredentialAuthBearerToken || c.Secret != "from-env" {
+		t.Errorf("Unexpected credentials %+v", c)
+	}
+}
+
+func TestCredentialStore_Load_MissingBaseURL(t *testing.T) {
+	store := &CredentialStore{
+		Path:   filepath.Join(os.TempDir(), "go-jira-does-not-exist.yml"),
+		Getenv: func(string) string { return "" },
+	}
+	if _, err := store.Load(); err == nil {
+		t.Error("Expected error for missing config file")
+	}
+}
+
+func TestCredentials_NewClient(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/myself", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer secret" {
+			t.Errorf("Expected bearer token, got %q", got)
+		}
+		fmt.Fprint(w, `{"name":"fred"}`)
+	})
+
+	c := &Credentials{BaseURL: testServer.URL, AuthMethod: CredentialAuthBearerToken, Secret: "secret"}
+	client, err := c.NewClient()
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if _, _, err := client.User.GetSelf(); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+
+	c.AuthMethod = "kerberos"
+	if _, err := c.NewClient(); err == nil {
+		t.Error("Expected error for unsupported authentication method")
+	}
+}
@@ -0,0 +1,113 @@
+package jira
+
+import (
+	"encoding/json"
+
+	"github.com/trivago/tgo/tcontainer"
+)
+
+// CustomFieldOption is the value of a select list custom field.
+// The value of a cascading select list has the selected option of the second level as Child.
+// To set an option, either the ID or the Value is enough.
+type CustomFieldOption struct {
+	Self  string             `json:"self,omitempty" structs:"self,omitempty"`
+	ID    string             `json:"id,omitempty" structs:"id,omitempty"`
+	Value string             `json:"value,omitempty" structs:"value,omitempty"`
+	Child *CustomFieldOption `json:"child,omitempty" structs:"child,omitempty"`
+}
+
+// SetCustomField sets the value of the custom field with the given ID, e.g. "customfield_10001",
+// in Unknowns. The value is sent as is with the fields of the issue on Create and Update,
+// e.g. a string, a number, a CustomFieldOption or a *User.
+func (i *IssueFields) SetCustomField(id string, value interface{}) {
+	if i.Unknowns == nil {
+		i.Unknowns = tcontainer.NewMarshalMap()
+	}
+	i.Unknowns[id] = value
+}
+
+// GetString returns the value of a text custom field.
+// It returns false if the field is not set or no string.
+func (i *IssueFields) GetString(id string) (string, bool) {
+	var value string
+	ok := i.decodeCustomField(id, &value)
+	return value, ok
+}
+
+// GetFloat returns the value of a number custom field.
+// It returns false if the field is not set or no number.
+func (i *IssueFields) GetFloat(id string) (float64, bool) {
+	var value float64
+	ok := i.decodeCustomField(id, &value)
+	return value, ok
+}
+
+// GetStrings returns the values of a custom field with a list of strings, e.g. a labels custom field.
+// It returns false if the field is not set or no list of strings.
+func (i *IssueFields) GetStrings(id string) ([]string, bool) {
+	var value []string
+	ok := i.decodeCustomField(id, &value)
+	return value, ok
+}
+
+// GetOption returns the selected option of a select list or radio button custom field.
+// It returns false if the field is not set or no option.
+func (i *IssueFields) GetOption(id string) (*CustomFieldOption, bool) {
+	value := new(CustomFieldOption)
+	if !i.decodeCustomField(id, value) {
+		return nil, false
+	}
+	return value, true
+}
+
+// GetOptions returns the selected options of a multi select list or checkbox custom field.
+// It returns false if the field is not set or no list of options.
+func (i *IssueFields) GetOptions(id string) ([]CustomFieldOption, bool) {
+	var value []CustomFieldOption
+	ok := i.decodeCustomField(id, &value)
+	return value, ok
+}
+
+// GetCascadingSelect returns the selected options of a cascading select list custom field.
+// child is nil if only the parent option is selected.
+// It returns false if the field is not set or no option.
+func (i *IssueFields) GetCascadingSelect(id string) (parent, child *CustomFieldOption, ok bool) {
+	parent, ok = i.GetOption(id)
+	if !ok {
+		return nil, nil, false
+	}
+	child, parent.Child = parent.Child, nil
+	return parent, child, true
+}
+
+// GetUserPicker returns the user of a single user picker custom field.
+// It returns false if the field is not set or no user.
+func (i *IssueFields) GetUserPicker(id string) (*User, bool) {
+	value := new(User)
+	if !i.decodeCustomField(id, value) {
+		return nil, false
+	}
+	return value, true
+}
+
+// GetUsers returns the users of a multi user picker custom field.
+// It returns false if the field is not set or no list of users.
+func (i *IssueFields) GetUsers(id string) ([]User, bool) {
+	var value []User
+	ok := i.decodeCustomField(id, &value)
+	return value, ok
+}
+
+// decodeCustomField converts the value of the custom field with the given ID to v by its JSON representation.
+// It returns false if the field is not set, null or can not be converted.
+func (i *IssueFields) decodeCustomField(id string, v interface{}) bool {
+	raw, ok := i.Unknowns[id]
+	if !ok || raw == nil {
+		return false
+	}
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return false
+	}
+	return json.Unmarshal(data, v) == nil
+}
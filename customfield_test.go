@@ -0,0 +1,107 @@
+package jira
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+const customFieldsJSON = `{
+	"summary": "Custom fields",
+	"customfield_10001": "Some text",
+	"customfield_10002": 42.5,
+	"customfield_10003": ["alpha", "beta"],
+	"customfield_10004": {"self": "https://example.atlassian.net/rest/api/2/customFieldOption/10100", "id": "10100", "value": "High"},
+	"customfield_10005": [{"id": "10200", "value": "Red"}, {"id": "10201", "value": "Blue"}],
+	"customfield_10006": {"id": "10300", "value": "Europe", "child": {"id": "10301", "value": "Germany"}},
+	"customfield_10007": {"accountId": "5b10ac8d82e05b22cc7d4ef5", "displayName": "Fred F. User"},
+	"customfield_10008": [{"accountId": "5b10ac8d82e05b22cc7d4ef5"}, {"accountId": "5b109f2e9729b51b54dc274d"}],
+	"customfield_10009": null
+}`
+
+func TestIssueFields_CustomFieldGetters(t *testing.T) {
+	fields := new(IssueFields)
+	if err := json.Unmarshal([]byte(customFieldsJSON), fields); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	if s, ok := fields.GetString("customfield_10001"); !ok || s != "Some text" {
+		t.Errorf("Unexpected string %q, %t", s, ok)
+	}
+	if f, ok := fields.GetFloat("customfield_10002"); !ok || f != 42.5 {
+		t.Errorf("Unexpected number %f, %t", f, ok)
+	}
+	if s, ok := fields.GetStrings("customfield_10003"); !ok || !reflect.DeepEqual(s, []string{"alpha", "beta"}) {
+		t.Errorf("Unexpected strings %v, %t", s, ok)
+	}
+	if o, ok := fields.GetOption("customfield_10004"); !ok || o.ID != "10100" || o.Value != "High" {
+		t.Errorf("Unexpected option %+v, %t", o, ok)
+	}
+	if o, ok := fields.GetOptions("customfield_10005"); !ok || len(o) != 2 || o[1].Value != "Blue" {
+		t.Errorf("Unexpected options %+v, %t", o, ok)
+	}
+	parent, child, ok := fields.GetCascadingSelect("customfield_10006")
+	if !ok || parent.Value != "Europe" || parent.Child != nil || child == nil || child.Value != "Germany" {
+		t.Errorf("Unexpected cascading select %+v, %+v, %t", parent, child, ok)
+	}
+	if u, ok := fields.GetUserPicker("customfield_10007"); !ok || u.AccountID != "5b10ac8d82e05b22cc7d4ef5" {
+		t.Errorf("Unexpected user %+v, %t", u, ok)
+	}
+	if u, ok := fields.GetUsers("customfield_10008"); !ok || len(u) != 2 || u[1].AccountID != "5b109f2e9729b51b54dc274d" {
+		t.Errorf("Unexpected users %+v, %t", u, ok)
+	}
+}
+
+func TestIssueFields_CustomFieldGetters_Missing(t *testing.T) {
+	fields := new(IssueFields)
+	if err := json.Unmarshal([]byte(customFieldsJSON), fields); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	if _, ok := fields.GetString("customfield_10009"); ok {
+		t.Error("Expected no value for a null field")
+	}
+	if _, ok := fields.GetString("customfield_99999"); ok {
+		t.Error("Expected no value for an unknown field")
+	}
+	if _, ok := fields.GetFloat("customfield_10001"); ok {
+		t.Error("Expected no number for a text field")
+	}
+	if _, ok := fields.GetOption("customfield_10003"); ok {
+		t.Error("Expected no option for a list of strings")
+	}
+	if _, ok := new(IssueFields).GetUserPicker("customfield_10007"); ok {
+		t.Error("Expected no user without custom fields")
+	}
+}
+
+func TestIssueFields_SetCustomField(t *testing.T) {
+	fields := &IssueFields{Summary: "Custom fields"}
+	fields.SetCustomField("customfield_10001", "Some text")
+	fields.SetCustomField("customfield_10002", 42.5)
+	fields.SetCustomField("customfield_10006", &CustomFieldOption{Value: "Europe", Child: &CustomFieldOption{ID: "10301"}})
+	fields.SetCustomField("customfield_10007", &User{AccountID: "5b10ac8d82e05b22cc7d4ef5"})
+
+	data, err := json.Marshal(fields)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	roundTrip := new(IssueFields)
+	if err := json.Unmarshal(data, roundTrip); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if s, ok := roundTrip.GetString("customfield_10001"); !ok || s != "Some text" {
+		t.Errorf("Unexpected string %q in %s", s, data)
+	}
+	if f, ok := roundTrip.GetFloat("customfield_10002"); !ok || f != 42.5 {
+		t.Errorf("Unexpected number %f in %s", f, data)
+	}
+	parent, child, ok := roundTrip.GetCascadingSelect("customfield_10006")
+	if !ok || parent.Value != "Europe" || parent.ID != "" || child == nil || child.ID != "10301" {
+		t.Errorf("Unexpected cascading select in %s", data)
+	}
+	if u, ok := roundTrip.GetUserPicker("customfield_10007"); !ok || u.AccountID != "5b10ac8d82e05b22cc7d4ef5" {
+		t.Errorf("Unexpected user in %s", data)
+	}
+}
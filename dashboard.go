@@ -0,0 +1,267 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+)
+
+// DashboardService handles dashboards for the JIRA instance / API.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-dashboards/
+type DashboardService struct {
+	client *Client
+}
+
+// These constants are the filters of DashboardListOptions
+const (
+	// DashboardFilterMy lists the dashboards owned by the user
+	DashboardFilterMy = "my"
+	// DashboardFilterFavourite lists the favourite dashboards of the user
+	DashboardFilterFavourite = "favourite"
+)
+
+// SystemDashboardID is the ID of the system dashboard, the default dashboard of all users
+const SystemDashboardID = "10000"
+
+// Dashboard represents a dashboard in JIRA
+type Dashboard struct {
+	ID                 string        `json:"id" structs:"id"`
+	Self               string        `json:"self,omitempty" structs:"self,omitempty"`
+	Name               string        `json:"name" structs:"name"`
+	Description        string        `json:"description,omitempty" structs:"description,omitempty"`
+	Owner              *User         `json:"owner,omitempty" structs:"owner,omitempty"`
+	View               string        `json:"view,omitempty" structs:"view,omitempty"`
+	IsFavourite        bool          `json:"isFavourite" structs:"isFavourite"`
+	Popularity         int           `json:"popularity" structs:"popularity"`
+	Rank               int           `json:"rank,omitempty" structs:"rank,omitempty"`
+	SharePermissions   []interface{} `json:"sharePermissions,omitempty" structs:"sharePermissions,omitempty"`
+	EditPermissions    []interface{} `json:"editPermissions,omitempty" structs:"editPermissions,omitempty"`
+	AutomaticRefreshMs int           `json:"automaticRefreshMs,omitempty" structs:"automaticRefreshMs,omitempty"`
+	IsWritable         bool          `json:"isWritable,omitempty" structs:"isWritable,omitempty"`
+	SystemDashboard    bool          `json:"systemDashboard,omitempty" structs:"systemDashboard,omitempty"`
+}
+
+// AvailableGadget is a gadget which can be added to dashboards.
+// Gadgets are added either by ModuleKey or, for legacy gadgets, by URI.
+type AvailableGadget struct {
+	ModuleKey   string `json:"moduleKey,omitempty" structs:"moduleKey,omitempty"`
+	URI         string `json:"uri,omitempty" structs:"uri,omitempty"`
+	Title       string `json:"title" structs:"title"`
+	Description string `json:"description,omitempty" structs:"description,omitempty"`
+}
+
+// DashboardListOptions specifies the optional parameters for the GetList method
+type DashboardListOptions struct {
+	// Filter restricts the dashboards to DashboardFilterMy or DashboardFilterFavourite, all dashboards are listed if empty
+	Filter     string `url:"filter,omitempty"`
+	StartAt    int    `url:"startAt,omitempty"`
+	MaxResults int    `url:"maxResults,omitempty"`
+}
+
+// EntityPropertyKey represents the key of a property of an entity, like a dashboard item
+type EntityPropertyKey struct {
+	Self string `json:"self,omitempty" structs:"self,omitempty"`
+	Key  string `json:"key,omitempty" structs:"key,omitempty"`
+}
+
+// EntityPropertyKeys represents the list of property keys of an entity
+type EntityPropertyKeys struct {
+	Keys []EntityPropertyKey `json:"keys" structs:"keys"`
+}
+
+// GetListWithContext returns a page of the dashboards the user can see. The paging is described by the PageInfo of the Response.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-dashboards/#api-rest-api-2-dashboard-get
+func (s *DashboardService) GetListWithContext(ctx context.Context, options *DashboardListOptions) ([]Dashboard, *Response, error) {
+	apiEndpoint, err := addOptions("rest/api/2/dashboard", options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(struct {
+		pageValues
+		Dashboards []Dashboard `json:"dashboards"`
+	})
+	resp, err := s.client.Do(req, page)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return page.Dashboards, resp, nil
+}
+
+// GetList wraps GetListWithContext using the background context.
+func (s *DashboardService) GetList(options *DashboardListOptions) ([]Dashboard, *Response, error) {
+	return s.GetListWithContext(context.Background(), options)
+}
+
+// GetAllWithContext returns all dashboards the user can see, requesting all pages.
+// Only options.Filter and options.MaxResults are used.
+func (s *DashboardService) GetAllWithContext(ctx context.Context, options *DashboardListOptions) ([]Dashboard, error) {
+	opts := DashboardListOptions{}
+	if options != nil {
+		opts = *options
+		opts.StartAt = 0
+	}
+
+	var dashboards []Dashboard
+	for {
+		page, resp, err := s.GetListWithContext(ctx, &opts)
+		if err != nil {
+			return nil, err
+		}
+		dashboards = append(dashboards, page...)
+		if len(page) == 0 || !resp.HasNextPage() {
+			return dashboards, nil
+		}
+		opts.StartAt = resp.NextStartAt()
+	}
+}
+
+// GetAll wraps GetAllWithContext using the background context.
+func (s *DashboardService) GetAll(options *DashboardListOptions) ([]Dashboard, error) {
+	return s.GetAllWithContext(context.Background(), options)
+}
+
+// GetWithContext returns the dashboard with the given ID. Use SystemDashboardID for the system dashboard.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-dashboards/#api-rest-api-2-dashboard-id-get
+func (s *DashboardService) GetWithContext(ctx context.Context, dashboardID string) (*Dashboard, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/dashboard/%s", dashboardID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dashboard := new(Dashboard)
+	resp, err := s.client.Do(req, dashboard)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return dashboard, resp, nil
+}
+
+// Get wraps GetWithContext using the background context.
+func (s *DashboardService) Get(dashboardID string) (*Dashboard, *Response, error) {
+	return s.GetWithContext(context.Background(), dashboardID)
+}
+
+// GetAvailableGadgetsWithContext returns the gadgets which can be added to dashboards.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-dashboards/#api-rest-api-2-dashboard-gadgets-get
+func (s *DashboardService) GetAvailableGadgetsWithContext(ctx context.Context) ([]AvailableGadget, *Response, error) {
+	apiEndpoint := "rest/api/2/dashboard/gadgets"
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(struct {
+		Gadgets []AvailableGadget `json:"gadgets"`
+	})
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result.Gadgets, resp, nil
+}
+
+// GetAvailableGadgets wraps GetAvailableGadgetsWithContext using the background context.
+func (s *DashboardService) GetAvailableGadgets() ([]AvailableGadget, *Response, error) {
+	return s.GetAvailableGadgetsWithContext(context.Background())
+}
+
+// GetItemPropertyKeysWithContext returns the keys of all properties of the dashboard item.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-dashboards/#api-rest-api-2-dashboard-dashboardid-items-itemid-properties-get
+func (s *DashboardService) GetItemPropertyKeysWithContext(ctx context.Context, dashboardID, itemID string) (*EntityPropertyKeys, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/dashboard/%s/items/%s/properties", dashboardID, itemID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keys := new(EntityPropertyKeys)
+	resp, err := s.client.Do(req, keys)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return keys, resp, nil
+}
+
+// GetItemPropertyKeys wraps GetItemPropertyKeysWithContext using the background context.
+func (s *DashboardService) GetItemPropertyKeys(dashboardID, itemID string) (*EntityPropertyKeys, *Response, error) {
+	return s.GetItemPropertyKeysWithContext(context.Background(), dashboardID, itemID)
+}
+
+// GetItemPropertyWithContext returns the property with the given key of the dashboard item.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-dashboards/#api-rest-api-2-dashboard-dashboardid-items-itemid-properties-propertykey-get
+func (s *DashboardService) GetItemPropertyWithContext(ctx context.Context, dashboardID, itemID, propertyKey string) (*EntityProperty, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/dashboard/%s/items/%s/properties/%s", dashboardID, itemID, propertyKey)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	property := new(EntityProperty)
+	resp, err := s.client.Do(req, property)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return property, resp, nil
+}
+
+// GetItemProperty wraps GetItemPropertyWithContext using the background context.
+func (s *DashboardService) GetItemProperty(dashboardID, itemID, propertyKey string) (*EntityProperty, *Response, error) {
+	return s.GetItemPropertyWithContext(context.Background(), dashboardID, itemID, propertyKey)
+}
+
+// SetItemPropertyWithContext sets the value of the property with the given key of the dashboard item.
+// value is marshalled to JSON, e.g. a struct or map holding the gadget configuration.
+// The property is created if it does not exist yet.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-dashboards/#api-rest-api-2-dashboard-dashboardid-items-itemid-properties-propertykey-put
+func (s *DashboardService) SetItemPropertyWithContext(ctx context.Context, dashboardID, itemID, propertyKey string, value interface{}) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/dashboard/%s/items/%s/properties/%s", dashboardID, itemID, propertyKey)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, value)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// SetItemProperty wraps SetItemPropertyWithContext using the background context.
+func (s *DashboardService) SetItemProperty(dashboardID, itemID, propertyKey string, value interface{}) (*Response, error) {
+	return s.SetItemPropertyWithContext(context.Background(), dashboardID, itemID, propertyKey, value)
+}
+
+// DeleteItemPropertyWithContext deletes the property with the given key of the dashboard item.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-dashboards/#api-rest-api-2-dashboard-dashboardid-items-itemid-properties-propertykey-delete
+func (s *DashboardService) DeleteItemPropertyWithContext(ctx context.Context, dashboardID, itemID, propertyKey string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/dashboard/%s/items/%s/properties/%s", dashboardID, itemID, propertyKey)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// DeleteItemProperty wraps DeleteItemPropertyWithContext using the background context.
+func (s *DashboardService) DeleteItemProperty(dashboardID, itemID, propertyKey string) (*Response, error) {
+	return s.DeleteItemPropertyWithContext(context.Background(), dashboardID, itemID, propertyKey)
+}
@@ -0,0 +1,174 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestDashboardService_GetList(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/dashboard", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/dashboard?filter=favourite&maxResults=1")
+		fmt.Fprint(w, `{"startAt":0,"maxResults":1,"total":2,"dashboards":[{"id":"10000","isFavourite":true,"name":"System Dashboard",
+			"popularity":1,"self":"https://example.atlassian.net/rest/api/2/dashboard/10000","sharePermissions":[{"type":"global"}],
+			"view":"https://example.atlassian.net/secure/Dashboard.jspa?selectPageId=10000","systemDashboard":true}]}`)
+	})
+
+	dashboards, resp, err := testClient.Dashboard.GetList(&DashboardListOptions{Filter: DashboardFilterFavourite, MaxResults: 1})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(dashboards) != 1 || !dashboards[0].SystemDashboard || len(dashboards[0].SharePermissions) != 1 {
+		t.Errorf("Unexpected dashboards %+v", dashboards)
+	}
+	if !resp.HasNextPage() {
+		t.Error("Expected another page")
+	}
+}
+
+func TestDashboardService_GetAll(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/dashboard", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if r.URL.Query().Get("startAt") == "" {
+			testRequestURL(t, r, "/rest/api/2/dashboard?filter=my&maxResults=1")
+			fmt.Fprint(w, `{"startAt":0,"maxResults":1,"total":2,"dashboards":[{"id":"10001","name":"Team"}]}`)
+			return
+		}
+		testRequestURL(t, r, "/rest/api/2/dashboard?filter=my&maxResults=1&startAt=1")
+		fmt.Fprint(w, `{"startAt":1,"maxResults":1,"total":2,"dashboards":[{"id":"10002","name":"Releases"}]}`)
+	})
+
+	dashboards, err := testClient.Dashboard.GetAll(&DashboardListOptions{Filter: DashboardFilterMy, MaxResults: 1})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(dashboards) != 2 || dashboards[0].ID != "10001" || dashboards[1].ID != "10002" {
+		t.Errorf("Unexpected dashboards %+v", dashboards)
+	}
+}
+
+func TestDashboardService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/dashboard/10000", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/dashboard/10000")
+		fmt.Fprint(w, `{"id":"10000","name":"System Dashboard","popularity":1,"systemDashboard":true}`)
+	})
+
+	dashboard, _, err := testClient.Dashboard.Get(SystemDashboardID)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if dashboard.Name != "System Dashboard" || !dashboard.SystemDashboard {
+		t.Errorf("Unexpected dashboard %+v", dashboard)
+	}
+}
+
+func TestDashboardService_GetAvailableGadgets(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/dashboard/gadgets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/dashboard/gadgets")
+		fmt.Fprint(w, `{"gadgets":[{"moduleKey":"com.atlassian.plugins.atlassian-connect-plugin:com.atlassian.connect.node.sample-addon__sample-dashboard-item",
+			"title":"Issue statistics"},{"uri":"rest/gadgets/1.0/g/com.atlassian.jira.gadgets:filter-results-gadget/gadgets/filter-results-gadget.xml",
+			"title":"Filter results","description":"Shows the results of a filter."}]}`)
+	})
+
+	gadgets, _, err := testClient.Dashboard.GetAvailableGadgets()
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(gadgets) != 2 || gadgets[0].ModuleKey == "" || gadgets[1].URI == "" || gadgets[1].Title != "Filter results" {
+		t.Errorf("Unexpected gadgets %+v", gadgets)
+	}
+}
+
+func TestDashboardService_GetItemPropertyKeys(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/dashboard/10000/items/10001/properties"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"keys":[{"self":"https://example.atlassian.net/rest/api/2/dashboard/10000/items/10001/properties/config","key":"config"}]}`)
+	})
+
+	keys, _, err := testClient.Dashboard.GetItemPropertyKeys("10000", "10001")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if keys == nil || len(keys.Keys) != 1 || keys.Keys[0].Key != "config" {
+		t.Errorf("Unexpected keys %+v", keys)
+	}
+}
+
+func TestDashboardService_GetItemProperty(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/dashboard/10000/items/10001/properties/config"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"key":"config","value":{"jql":"project = TEST","num":10}}`)
+	})
+
+	property, _, err := testClient.Dashboard.GetItemProperty("10000", "10001", "config")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if property == nil || property.Key != "config" {
+		t.Fatalf("Unexpected property %+v", property)
+	}
+	value, ok := property.Value.(map[string]interface{})
+	if !ok || value["jql"] != "project = TEST" {
+		t.Errorf("Unexpected property value %+v", property.Value)
+	}
+}
+
+func TestDashboardService_SetItemProperty(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/dashboard/10000/items/10001/properties/config"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var value map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&value); err != nil {
+			t.Error(err)
+		}
+		if value["jql"] != "project = TEST" {
+			t.Errorf("Unexpected value %+v", value)
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	_, err := testClient.Dashboard.SetItemProperty("10000", "10001", "config", map[string]interface{}{"jql": "project = TEST"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestDashboardService_DeleteItemProperty(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/dashboard/10000/items/10001/properties/config"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, testAPIEndpoint)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.Dashboard.DeleteItemProperty("10000", "10001", "config")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
@@ -0,0 +1,128 @@
+package jira
+
+import (
+	"context"
+	"sort"
+)
+
+// These constants are the assignee types of projects and components
+const (
+	// AssigneeTypeProjectDefault is only used by components, the assignee type of the project applies
+	AssigneeTypeProjectDefault = "PROJECT_DEFAULT"
+	AssigneeTypeComponentLead  = "COMPONENT_LEAD"
+	AssigneeTypeProjectLead    = "PROJECT_LEAD"
+	AssigneeTypeUnassigned     = "UNASSIGNED"
+)
+
+// DefaultAssignee is the assignee JIRA chooses for a new issue without an explicit assignee
+type DefaultAssignee struct {
+	// AssigneeType is either AssigneeTypeComponentLead, AssigneeTypeProjectLead or AssigneeTypeUnassigned
+	AssigneeType string
+	// User is nil if the issue stays unassigned
+	User *User
+	// Component is the component which determined the assignee, nil if the project default applies
+	Component *ProjectComponent
+}
+
+// GetDefaultAssigneeWithContext determines the default assignee of a new issue in the project with the given components.
+// componentIDs may be empty, unknown components are ignored.
+//
+// The assignee is resolved like JIRA does on issue creation:
+// If any of the components assigns to its component lead, the lead of the first of these components (ordered by name) is used.
+// Otherwise, if any component assigns to the project lead, the project lead is used.
+// Otherwise, if any component leaves issues unassigned, the issue is unassigned.
+// Otherwise the assignee type of the project applies.
+// The real assignee type of the components is used, which is corrected by JIRA if e.g. the lead can't be assigned.
+func (s *ProjectService) GetDefaultAssigneeWithContext(ctx context.Context, projectID string, componentIDs []string) (*DefaultAssignee, error) {
+	project, _, err := s.GetWithContext(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var components []ProjectComponent
+	if len(componentIDs) > 0 {
+		all, _, err := s.GetComponentsWithContext(ctx, projectID)
+		if err != nil {
+			return nil, err
+		}
+		wanted := make(map[string]bool, len(componentIDs))
+		for _, id := range componentIDs {
+			wanted[id] = true
+		}
+		for _, component := range all {
+			if wanted[component.ID] {
+				components = append(components, component)
+			}
+		}
+	}
+
+	return ResolveDefaultAssignee(project, components), nil
+}
+
+// GetDefaultAssignee wraps GetDefaultAssigneeWithContext using the background context.
+func (s *ProjectService) GetDefaultAssignee(projectID string, componentIDs []string) (*DefaultAssignee, error) {
+	return s.GetDefaultAssigneeWithContext(context.Background(), projectID, componentIDs)
+}
+
+// ResolveDefaultAssignee determines the default assignee of a new issue in project with the given components,
+// see ProjectService.GetDefaultAssignee.
+// The components need the (real) assignee types and leads, as returned by ProjectService.GetComponents.
+func ResolveDefaultAssignee(project *Project, components []ProjectComponent) *DefaultAssignee {
+	sorted := make([]ProjectComponent, len(components))
+	copy(sorted, components)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+
+	byType := map[string]*ProjectComponent{}
+	for i := range sorted {
+		t := componentAssigneeType(&sorted[i])
+		if _, ok := byType[t]; !ok {
+			byType[t] = &sorted[i]
+		}
+	}
+
+	if c, ok := byType[AssigneeTypeComponentLead]; ok {
+		lead := componentAssignee(c)
+		return &DefaultAssignee{AssigneeType: AssigneeTypeComponentLead, User: lead, Component: c}
+	}
+	if c, ok := byType[AssigneeTypeProjectLead]; ok {
+		return &DefaultAssignee{AssigneeType: AssigneeTypeProjectLead, User: projectLead(project), Component: c}
+	}
+	if c, ok := byType[AssigneeTypeUnassigned]; ok {
+		return &DefaultAssignee{AssigneeType: AssigneeTypeUnassigned, Component: c}
+	}
+
+	if project.AssigneeType == AssigneeTypeUnassigned {
+		return &DefaultAssignee{AssigneeType: AssigneeTypeUnassigned}
+	}
+	return &DefaultAssignee{AssigneeType: AssigneeTypeProjectLead, User: projectLead(project)}
+}
+
+// componentAssigneeType returns the effective assignee type of the component
+func componentAssigneeType(c *ProjectComponent) string {
+	if c.RealAssigneeType != "" {
+		return c.RealAssigneeType
+	}
+	if c.AssigneeType != "" {
+		return c.AssigneeType
+	}
+	return AssigneeTypeProjectDefault
+}
+
+// componentAssignee returns the user a component assigns its issues to
+func componentAssignee(c *ProjectComponent) *User {
+	for _, u := range []User{c.RealAssignee, c.Assignee, c.Lead} {
+		if u.AccountID != "" || u.Name != "" || u.Key != "" {
+			user := u
+			return &user
+		}
+	}
+	return nil
+}
+
+func projectLead(p *Project) *User {
+	if p.Lead.AccountID == "" && p.Lead.Name == "" && p.Lead.Key == "" {
+		return nil
+	}
+	lead := p.Lead
+	return &lead
+}
@@ -0,0 +1,85 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestResolveDefaultAssignee(t *testing.T) {
+	project := &Project{Key: "TEST", AssigneeType: AssigneeTypeProjectLead, Lead: User{Name: "boss"}}
+	backend := ProjectComponent{ID: "1", Name: "Backend", RealAssigneeType: AssigneeTypeComponentLead, RealAssignee: User{Name: "fred"}}
+	api := ProjectComponent{ID: "2", Name: "API", RealAssigneeType: AssigneeTypeComponentLead, RealAssignee: User{Name: "wilma"}}
+	docs := ProjectComponent{ID: "3", Name: "Docs", RealAssigneeType: AssigneeTypeUnassigned}
+	ui := ProjectComponent{ID: "4", Name: "UI", RealAssigneeType: AssigneeTypeProjectLead}
+	other := ProjectComponent{ID: "5", Name: "Other", AssigneeType: AssigneeTypeProjectDefault}
+
+	tests := []struct {
+		name       string
+		project    *Project
+		components []ProjectComponent
+		typ        string
+		user       string
+		component  string
+	}{
+		{"no components", project, nil, AssigneeTypeProjectLead, "boss", ""},
+		{"unassigned project", &Project{AssigneeType: AssigneeTypeUnassigned, Lead: User{Name: "boss"}}, nil, AssigneeTypeUnassigned, "", ""},
+		{"project default component", project, []ProjectComponent{other}, AssigneeTypeProjectLead, "boss", ""},
+		{"component lead", project, []ProjectComponent{docs, backend}, AssigneeTypeComponentLead, "fred", "Backend"},
+		{"first component lead by name", project, []ProjectComponent{backend, api}, AssigneeTypeComponentLead, "wilma", "API"},
+		{"project lead before unassigned", project, []ProjectComponent{docs, ui}, AssigneeTypeProjectLead, "boss", "UI"},
+		{"unassigned component", project, []ProjectComponent{other, docs}, AssigneeTypeUnassigned, "", "Docs"},
+	}
+
+	for _, test := range tests {
+		got := ResolveDefaultAssignee(test.project, test.components)
+		if got.AssigneeType != test.typ {
+			t.Errorf("%s: AssigneeType = %q, want %q", test.name, got.AssigneeType, test.typ)
+		}
+		user := ""
+		if got.User != nil {
+			user = got.User.Name
+		}
+		if user != test.user {
+			t.Errorf("%s: User = %q, want %q", test.name, user, test.user)
+		}
+		component := ""
+		if got.Component != nil {
+			component = got.Component.Name
+		}
+		if component != test.component {
+			t.Errorf("%s: Component = %q, want %q", test.name, component, test.component)
+		}
+	}
+}
+
+func TestProjectService_GetDefaultAssignee(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/project/TEST", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":"10000","key":"TEST","assigneeType":"PROJECT_LEAD","lead":{"name":"boss"}}`)
+	})
+	testMux.HandleFunc("/rest/api/2/project/TEST/components", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[
+			{"id":"10000","name":"Backend","assigneeType":"COMPONENT_LEAD","realAssigneeType":"COMPONENT_LEAD","realAssignee":{"name":"fred"}},
+			{"id":"10001","name":"Docs","assigneeType":"UNASSIGNED","realAssigneeType":"UNASSIGNED"}]`)
+	})
+
+	assignee, err := testClient.Project.GetDefaultAssignee("TEST", []string{"10001"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if assignee.AssigneeType != AssigneeTypeUnassigned || assignee.User != nil {
+		t.Errorf("Unexpected assignee %+v", assignee)
+	}
+
+	assignee, err = testClient.Project.GetDefaultAssignee("TEST", nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if assignee.AssigneeType != AssigneeTypeProjectLead || assignee.User.Name != "boss" {
+		t.Errorf("Unexpected assignee %+v", assignee)
+	}
+}
@@ -0,0 +1,173 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// DeploymentService handles the deployment information of JIRA Software Cloud.
+// Deployments are submitted by CD systems and are shown on the issues they reference.
+//
+// Note that this API is only available to Connect apps (JWT authentication) and OAuth 2.0 integrations.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/software/rest/#api-group-Deployments
+type DeploymentService struct {
+	client *Client
+}
+
+const (
+	// DeploymentStatePending is the state of a deployment which is queued
+	DeploymentStatePending = "pending"
+	// DeploymentStateInProgress is the state of a running deployment
+	DeploymentStateInProgress = "in_progress"
+	// DeploymentStateSuccessful is the state of a deployment which succeeded
+	DeploymentStateSuccessful = "successful"
+	// DeploymentStateFailed is the state of a deployment which failed
+	DeploymentStateFailed = "failed"
+	// DeploymentStateRolledBack is the state of a deployment which was rolled back
+	DeploymentStateRolledBack = "rolled_back"
+	// DeploymentStateCancelled is the state of a deployment which was cancelled
+	DeploymentStateCancelled = "cancelled"
+	// DeploymentStateUnknown is the state of a deployment which is not known
+	DeploymentStateUnknown = "unknown"
+
+	// EnvironmentTypeUnmapped is the type of an environment which is not mapped to a known type
+	EnvironmentTypeUnmapped = "unmapped"
+	// EnvironmentTypeDevelopment is the type of a development environment
+	EnvironmentTypeDevelopment = "development"
+	// EnvironmentTypeTesting is the type of a testing environment
+	EnvironmentTypeTesting = "testing"
+	// EnvironmentTypeStaging is the type of a staging environment
+	EnvironmentTypeStaging = "staging"
+	// EnvironmentTypeProduction is the type of a production environment
+	EnvironmentTypeProduction = "production"
+)
+
+// Deployment represents a single deployment of a pipeline to an environment
+type Deployment struct {
+	SchemaVersion            string                `json:"schemaVersion,omitempty" structs:"schemaVersion,omitempty"`
+	DeploymentSequenceNumber int64                 `json:"deploymentSequenceNumber" structs:"deploymentSequenceNumber"`
+	UpdateSequenceNumber     int64                 `json:"updateSequenceNumber" structs:"updateSequenceNumber"`
+	IssueKeys                []string              `json:"issueKeys" structs:"issueKeys"`
+	DisplayName              string                `json:"displayName" structs:"displayName"`
+	URL                      string                `json:"url" structs:"url"`
+	Description              string                `json:"description" structs:"description"`
+	LastUpdated              string                `json:"lastUpdated" structs:"lastUpdated"`
+	Label                    string                `json:"label,omitempty" structs:"label,omitempty"`
+	State                    string                `json:"state" structs:"state"`
+	Pipeline                 DeploymentPipeline    `json:"pipeline" structs:"pipeline"`
+	Environment              DeploymentEnvironment `json:"environment" structs:"environment"`
+}
+
+// DeploymentPipeline represents the pipeline a deployment belongs to
+type DeploymentPipeline struct {
+	ID          string `json:"id" structs:"id"`
+	DisplayName string `json:"displayName" structs:"displayName"`
+	URL         string `json:"url" structs:"url"`
+}
+
+// DeploymentEnvironment represents the environment a deployment was made to
+type DeploymentEnvironment struct {
+	ID          string `json:"id" structs:"id"`
+	DisplayName string `json:"displayName" structs:"displayName"`
+	// Type is one of the EnvironmentType* constants
+	Type string `json:"type" structs:"type"`
+}
+
+// SubmitDeploymentsPayload is the payload of the DeploymentService.Submit method
+type SubmitDeploymentsPayload struct {
+	Properties       map[string]string `json:"properties,omitempty" structs:"properties,omitempty"`
+	Deployments      []Deployment      `json:"deployments" structs:"deployments"`
+	ProviderMetadata *ProviderMetadata `json:"providerMetadata,omitempty" structs:"providerMetadata,omitempty"`
+}
+
+// DeploymentKey identifies a single deployment
+type DeploymentKey struct {
+	PipelineID               string `json:"pipelineId" structs:"pipelineId"`
+	EnvironmentID            string `json:"environmentId" structs:"environmentId"`
+	DeploymentSequenceNumber int64  `json:"deploymentSequenceNumber" structs:"deploymentSequenceNumber"`
+}
+
+// RejectedDeployment represents a deployment which was rejected by JIRA
+type RejectedDeployment struct {
+	Key    DeploymentKey `json:"key" structs:"key"`
+	Errors []SubmitError `json:"errors" structs:"errors"`
+}
+
+// SubmitDeploymentsResult is the result of the DeploymentService.Submit method
+type SubmitDeploymentsResult struct {
+	AcceptedDeployments []DeploymentKey      `json:"acceptedDeployments" structs:"acceptedDeployments"`
+	RejectedDeployments []RejectedDeployment `json:"rejectedDeployments" structs:"rejectedDeployments"`
+	UnknownIssueKeys    []string             `json:"unknownIssueKeys" structs:"unknownIssueKeys"`
+}
+
+// SubmitWithContext submits (creates or updates) deployment information.
+// Deployments are identified by the combination of pipeline ID, environment ID and DeploymentSequenceNumber.
+// An existing deployment is only updated if UpdateSequenceNumber is higher than the stored one.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/software/rest/#api-deployments-0-1-bulk-post
+func (s *DeploymentService) SubmitWithContext(ctx context.Context, payload *SubmitDeploymentsPayload) (*SubmitDeploymentsResult, *Response, error) {
+	apiEndpoint := "rest/deployments/0.1/bulk"
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(SubmitDeploymentsResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result, resp, nil
+}
+
+// Submit wraps SubmitWithContext using the background context.
+func (s *DeploymentService) Submit(payload *SubmitDeploymentsPayload) (*SubmitDeploymentsResult, *Response, error) {
+	return s.SubmitWithContext(context.Background(), payload)
+}
+
+// GetWithContext returns the deployment identified by the given key.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/software/rest/#api-deployments-0-1-pipelines-pipelineId-environments-environmentId-deployments-deploymentSequenceNumber-get
+func (s *DeploymentService) GetWithContext(ctx context.Context, key DeploymentKey) (*Deployment, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/deployments/0.1/pipelines/%s/environments/%s/deployments/%d", url.PathEscape(key.PipelineID), url.PathEscape(key.EnvironmentID), key.DeploymentSequenceNumber)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	deployment := new(Deployment)
+	resp, err := s.client.Do(req, deployment)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return deployment, resp, nil
+}
+
+// Get wraps GetWithContext using the background context.
+func (s *DeploymentService) Get(key DeploymentKey) (*Deployment, *Response, error) {
+	return s.GetWithContext(context.Background(), key)
+}
+
+// DeleteWithContext deletes the deployment identified by the given key.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/software/rest/#api-deployments-0-1-pipelines-pipelineId-environments-environmentId-deployments-deploymentSequenceNumber-delete
+func (s *DeploymentService) DeleteWithContext(ctx context.Context, key DeploymentKey) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/deployments/0.1/pipelines/%s/environments/%s/deployments/%d", url.PathEscape(key.PipelineID), url.PathEscape(key.EnvironmentID), key.DeploymentSequenceNumber)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// Delete wraps DeleteWithContext using the background context.
+func (s *DeploymentService) Delete(key DeploymentKey) (*Response, error) {
+	return s.DeleteWithContext(context.Background(), key)
+}
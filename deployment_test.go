@@ -0,0 +1,85 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestDeploymentService_Submit(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/deployments/0.1/bulk"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		payload := new(SubmitDeploymentsPayload)
+		if err := json.NewDecoder(r.Body).Decode(payload); err != nil {
+			t.Error(err)
+		}
+		if len(payload.Deployments) != 1 || payload.Deployments[0].Environment.Type != EnvironmentTypeProduction {
+			t.Errorf("Unexpected payload: %+v", payload)
+		}
+
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"acceptedDeployments":[],"rejectedDeployments":[{"key":{"pipelineId":"deploy","environmentId":"prod","deploymentSequenceNumber":100},"errors":[{"message":"lastUpdated is invalid"}]}],"unknownIssueKeys":[]}`)
+	})
+
+	result, _, err := testClient.Deployment.Submit(&SubmitDeploymentsPayload{
+		Deployments: []Deployment{{
+			DeploymentSequenceNumber: 100,
+			UpdateSequenceNumber:     1,
+			IssueKeys:                []string{"EX-1"},
+			DisplayName:              "Deployment #100",
+			URL:                      "https://cd.example.com/deploy/100",
+			State:                    DeploymentStateSuccessful,
+			Pipeline:                 DeploymentPipeline{ID: "deploy", DisplayName: "Deploy", URL: "https://cd.example.com/deploy"},
+			Environment:              DeploymentEnvironment{ID: "prod", DisplayName: "Production", Type: EnvironmentTypeProduction},
+		}},
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+		return
+	}
+	if len(result.RejectedDeployments) != 1 || result.RejectedDeployments[0].Errors[0].Message != "lastUpdated is invalid" {
+		t.Errorf("Unexpected rejected deployments: %+v", result.RejectedDeployments)
+	}
+}
+
+func TestDeploymentService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/deployments/0.1/pipelines/deploy/environments/prod/deployments/100"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"deploymentSequenceNumber":100,"updateSequenceNumber":1,"issueKeys":["EX-1"],"displayName":"Deployment #100","url":"https://cd.example.com/deploy/100","description":"","lastUpdated":"2020-01-20T10:10:10.000Z","state":"rolled_back","pipeline":{"id":"deploy","displayName":"Deploy","url":"https://cd.example.com/deploy"},"environment":{"id":"prod","displayName":"Production","type":"production"}}`)
+	})
+
+	deployment, _, err := testClient.Deployment.Get(DeploymentKey{PipelineID: "deploy", EnvironmentID: "prod", DeploymentSequenceNumber: 100})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+		return
+	}
+	if deployment.State != DeploymentStateRolledBack {
+		t.Errorf("Expected state rolled_back. Got %s", deployment.State)
+	}
+}
+
+func TestDeploymentService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/deployments/0.1/pipelines/deploy/environments/prod/deployments/100"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, testAPIEndpoint)
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	_, err := testClient.Deployment.Delete(DeploymentKey{PipelineID: "deploy", EnvironmentID: "prod", DeploymentSequenceNumber: 100})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
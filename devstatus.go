@@ -0,0 +1,238 @@
+package jira
+
+import "context"
+
+// DevStatusService handles the development information (branches, commits, pull requests)
+// which is linked to issues by development tools like Bitbucket, GitHub or GitLab.
+//
+// Note that the dev-status API is not officially documented by Atlassian and may change without notice.
+type DevStatusService struct {
+	client *Client
+}
+
+const (
+	// DevStatusDataTypeBranch requests the branches which are linked to an issue
+	DevStatusDataTypeBranch = "branch"
+	// DevStatusDataTypePullRequest requests the pull requests which are linked to an issue
+	DevStatusDataTypePullRequest = "pullrequest"
+	// DevStatusDataTypeRepository requests the repositories (and their commits) which are linked to an issue
+	DevStatusDataTypeRepository = "repository"
+
+	// PullRequestStatusOpen is the status of a pull request which is neither merged nor declined
+	PullRequestStatusOpen = "OPEN"
+	// PullRequestStatusMerged is the status of a merged pull request
+	PullRequestStatusMerged = "MERGED"
+	// PullRequestStatusDeclined is the status of a declined pull request
+	PullRequestStatusDeclined = "DECLINED"
+)
+
+// DevStatusSummary represents the summary of the development information of an issue
+type DevStatusSummary struct {
+	Errors  []interface{} `json:"errors,omitempty" structs:"errors,omitempty"`
+	Summary struct {
+		PullRequest DevStatusSummaryItem `json:"pullrequest" structs:"pullrequest"`
+		Repository  DevStatusSummaryItem `json:"repository" structs:"repository"`
+		Branch      DevStatusSummaryItem `json:"branch" structs:"branch"`
+		Build       DevStatusSummaryItem `json:"build" structs:"build"`
+		Review      DevStatusSummaryItem `json:"review" structs:"review"`
+	} `json:"summary" structs:"summary"`
+}
+
+// DevStatusSummaryItem represents the summary of one type of development information
+type DevStatusSummaryItem struct {
+	Overall struct {
+		Count       int    `json:"count" structs:"count"`
+		LastUpdated string `json:"lastUpdated,omitempty" structs:"lastUpdated,omitempty"`
+		StateCount  int    `json:"stateCount,omitempty" structs:"stateCount,omitempty"`
+		State       string `json:"state,omitempty" structs:"state,omitempty"`
+		Open        bool   `json:"open,omitempty" structs:"open,omitempty"`
+	} `json:"overall" structs:"overall"`
+	ByInstanceType map[string]DevStatusInstanceType `json:"byInstanceType,omitempty" structs:"byInstanceType,omitempty"`
+}
+
+// DevStatusInstanceType represents the development tool a piece of development information comes from
+type DevStatusInstanceType struct {
+	Count int    `json:"count" structs:"count"`
+	Name  string `json:"name" structs:"name"`
+}
+
+// DevStatusDetail represents the detailed development information of an issue
+type DevStatusDetail struct {
+	Errors []interface{}         `json:"errors,omitempty" structs:"errors,omitempty"`
+	Detail []DevStatusDetailItem `json:"detail" structs:"detail"`
+}
+
+// DevStatusDetailItem represents the development information of one development tool instance
+type DevStatusDetailItem struct {
+	Branches     []DevStatusBranch      `json:"branches,omitempty" structs:"branches,omitempty"`
+	PullRequests []DevStatusPullRequest `json:"pullRequests,omitempty" structs:"pullRequests,omitempty"`
+	Repositories []DevStatusRepository  `json:"repositories,omitempty" structs:"repositories,omitempty"`
+	Instance     *DevStatusInstance     `json:"_instance,omitempty" structs:"_instance,omitempty"`
+}
+
+// DevStatusInstance represents a development tool instance, e.g. a GitHub organisation
+type DevStatusInstance struct {
+	ID       string `json:"id,omitempty" structs:"id,omitempty"`
+	Name     string `json:"name,omitempty" structs:"name,omitempty"`
+	Type     string `json:"type,omitempty" structs:"type,omitempty"`
+	TypeName string `json:"typeName,omitempty" structs:"typeName,omitempty"`
+	BaseURL  string `json:"baseUrl,omitempty" structs:"baseUrl,omitempty"`
+}
+
+// DevStatusAuthor represents the author of a commit or pull request, or a reviewer
+type DevStatusAuthor struct {
+	Name     string `json:"name,omitempty" structs:"name,omitempty"`
+	Avatar   string `json:"avatar,omitempty" structs:"avatar,omitempty"`
+	Approved bool   `json:"approved,omitempty" structs:"approved,omitempty"`
+}
+
+// DevStatusRepositoryRef is a short reference to a repository
+type DevStatusRepositoryRef struct {
+	Name string `json:"name,omitempty" structs:"name,omitempty"`
+	URL  string `json:"url,omitempty" structs:"url,omitempty"`
+}
+
+// DevStatusCommit represents a commit which is linked to an issue
+type DevStatusCommit struct {
+	ID              string           `json:"id,omitempty" structs:"id,omitempty"`
+	DisplayID       string           `json:"displayId,omitempty" structs:"displayId,omitempty"`
+	Message         string           `json:"message,omitempty" structs:"message,omitempty"`
+	Author          *DevStatusAuthor `json:"author,omitempty" structs:"author,omitempty"`
+	AuthorTimestamp string           `json:"authorTimestamp,omitempty" structs:"authorTimestamp,omitempty"`
+	URL             string           `json:"url,omitempty" structs:"url,omitempty"`
+	FileCount       int              `json:"fileCount,omitempty" structs:"fileCount,omitempty"`
+	Merge           bool             `json:"merge,omitempty" structs:"merge,omitempty"`
+}
+
+// DevStatusBranch represents a branch which is linked to an issue
+type DevStatusBranch struct {
+	Name                 string                  `json:"name,omitempty" structs:"name,omitempty"`
+	URL                  string                  `json:"url,omitempty" structs:"url,omitempty"`
+	CreatePullRequestURL string                  `json:"createPullRequestUrl,omitempty" structs:"createPullRequestUrl,omitempty"`
+	Repository           *DevStatusRepositoryRef `json:"repository,omitempty" structs:"repository,omitempty"`
+	LastCommit           *DevStatusCommit        `json:"lastCommit,omitempty" structs:"lastCommit,omitempty"`
+}
+
+// DevStatusPullRequestRef represents the source or destination branch of a pull request
+type DevStatusPullRequestRef struct {
+	Branch string `json:"branch,omitempty" structs:"branch,omitempty"`
+	URL    string `json:"url,omitempty" structs:"url,omitempty"`
+}
+
+// DevStatusPullRequest represents a pull request which is linked to an issue
+type DevStatusPullRequest struct {
+	ID             string                   `json:"id,omitempty" structs:"id,omitempty"`
+	Name           string                   `json:"name,omitempty" structs:"name,omitempty"`
+	URL            string                   `json:"url,omitempty" structs:"url,omitempty"`
+	Status         string                   `json:"status,omitempty" structs:"status,omitempty"`
+	Author         *DevStatusAuthor         `json:"author,omitempty" structs:"author,omitempty"`
+	Reviewers      []DevStatusAuthor        `json:"reviewers,omitempty" structs:"reviewers,omitempty"`
+	CommentCount   int                      `json:"commentCount,omitempty" structs:"commentCount,omitempty"`
+	Source         *DevStatusPullRequestRef `json:"source,omitempty" structs:"source,omitempty"`
+	Destination    *DevStatusPullRequestRef `json:"destination,omitempty" structs:"destination,omitempty"`
+	LastUpdate     string                   `json:"lastUpdate,omitempty" structs:"lastUpdate,omitempty"`
+	RepositoryName string                   `json:"repositoryName,omitempty" structs:"repositoryName,omitempty"`
+	RepositoryURL  string                   `json:"repositoryUrl,omitempty" structs:"repositoryUrl,omitempty"`
+}
+
+// DevStatusRepository represents a repository with the commits which are linked to an issue
+type DevStatusRepository struct {
+	Name    string            `json:"name,omitempty" structs:"name,omitempty"`
+	URL     string            `json:"url,omitempty" structs:"url,omitempty"`
+	Avatar  string            `json:"avatar,omitempty" structs:"avatar,omitempty"`
+	Commits []DevStatusCommit `json:"commits,omitempty" structs:"commits,omitempty"`
+}
+
+// DevStatusDetailOptions specifies the parameters for the GetDetail method
+type DevStatusDetailOptions struct {
+	// ApplicationType is the development tool, e.g. "github", "bitbucket", "stash" or "GitLab"
+	ApplicationType string `url:"applicationType"`
+	// DataType is one of DevStatusDataTypeBranch, DevStatusDataTypePullRequest or DevStatusDataTypeRepository
+	DataType string `url:"dataType"`
+}
+
+// PullRequests returns the pull requests of all development tool instances
+func (d *DevStatusDetail) PullRequests() []DevStatusPullRequest {
+	pullRequests := []DevStatusPullRequest{}
+	for _, item := range d.Detail {
+		pullRequests = append(pullRequests, item.PullRequests...)
+	}
+	return pullRequests
+}
+
+// AllPullRequestsMerged reports if there is at least one pull request and all
+// pull requests which are not declined are merged.
+func (d *DevStatusDetail) AllPullRequestsMerged() bool {
+	merged := 0
+	for _, pr := range d.PullRequests() {
+		switch pr.Status {
+		case PullRequestStatusMerged:
+			merged++
+		case PullRequestStatusDeclined:
+		default:
+			return false
+		}
+	}
+	return merged > 0
+}
+
+// GetSummaryWithContext returns the summary of the development information of the issue with the given numeric issue ID.
+// Note that the issue key is not accepted by this API.
+func (s *DevStatusService) GetSummaryWithContext(ctx context.Context, issueID string) (*DevStatusSummary, *Response, error) {
+	apiEndpoint, err := addOptions("rest/dev-status/1.0/issue/summary", &struct {
+		IssueID string `url:"issueId"`
+	}{issueID})
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	summary := new(DevStatusSummary)
+	resp, err := s.client.Do(req, summary)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return summary, resp, nil
+}
+
+// GetSummary wraps GetSummaryWithContext using the background context.
+func (s *DevStatusService) GetSummary(issueID string) (*DevStatusSummary, *Response, error) {
+	return s.GetSummaryWithContext(context.Background(), issueID)
+}
+
+// GetDetailWithContext returns the detailed development information (branches, pull requests or repositories with commits)
+// of the issue with the given numeric issue ID for one development tool.
+// Note that the issue key is not accepted by this API.
+func (s *DevStatusService) GetDetailWithContext(ctx context.Context, issueID string, options *DevStatusDetailOptions) (*DevStatusDetail, *Response, error) {
+	apiEndpoint, err := addOptions("rest/dev-status/1.0/issue/detail", &struct {
+		IssueID string `url:"issueId"`
+	}{issueID})
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	if options != nil {
+		q := req.URL.Query()
+		q.Set("applicationType", options.ApplicationType)
+		q.Set("dataType", options.DataType)
+		req.URL.RawQuery = q.Encode()
+	}
+
+	detail := new(DevStatusDetail)
+	resp, err := s.client.Do(req, detail)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return detail, resp, nil
+}
+
+// GetDetail wraps GetDetailWithContext using the background context.
+func (s *DevStatusService) GetDetail(issueID string, options *DevStatusDetailOptions) (*DevStatusDetail, *Response, error) {
+	return s.GetDetailWithContext(context.Background(), issueID, options)
+}
@@ -0,0 +1,90 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestDevStatusService_GetSummary(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/dev-status/1.0/issue/summary"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		testRequestParams(t, r, map[string]string{"issueId": "10002"})
+		fmt.Fprint(w, `{"errors":[],"configErrors":[],"summary":{"pullrequest":{"overall":{"count":2,"lastUpdated":"2020-01-20T10:10:10.000+0000","stateCount":1,"state":"OPEN","open":true},"byInstanceType":{"GitHub":{"count":2,"name":"GitHub"}}},"repository":{"overall":{"count":1}},"branch":{"overall":{"count":3}}}}`)
+	})
+
+	summary, _, err := testClient.DevStatus.GetSummary("10002")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+		return
+	}
+	if summary.Summary.PullRequest.Overall.Count != 2 || !summary.Summary.PullRequest.Overall.Open {
+		t.Errorf("Unexpected pull request summary: %+v", summary.Summary.PullRequest)
+	}
+	if summary.Summary.Branch.Overall.Count != 3 {
+		t.Errorf("Expected 3 branches. Got %d", summary.Summary.Branch.Overall.Count)
+	}
+	if summary.Summary.PullRequest.ByInstanceType["GitHub"].Count != 2 {
+		t.Errorf("Unexpected instance types: %+v", summary.Summary.PullRequest.ByInstanceType)
+	}
+}
+
+func TestDevStatusService_GetDetail(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/dev-status/1.0/issue/detail"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		testRequestParams(t, r, map[string]string{"issueId": "10002", "applicationType": "github", "dataType": "pullrequest"})
+		fmt.Fprint(w, `{"errors":[],"detail":[{"branches":[],"pullRequests":[{"author":{"name":"octocat","avatar":"https://avatars.example.com/octocat"},"id":"#42","name":"EX-1 Fix the bug","commentCount":3,"source":{"branch":"EX-1-fix","url":"https://github.com/example/repo/tree/EX-1-fix"},"destination":{"branch":"master","url":"https://github.com/example/repo/tree/master"},"reviewers":[{"name":"hubot","approved":true}],"status":"MERGED","url":"https://github.com/example/repo/pull/42","lastUpdate":"2020-01-20T10:10:10.000+0000","repositoryName":"example/repo"},{"id":"#43","name":"EX-1 Try something else","status":"DECLINED"}],"_instance":{"id":"1","name":"GitHub","type":"github","typeName":"GitHub","baseUrl":"https://github.com"}}]}`)
+	})
+
+	detail, _, err := testClient.DevStatus.GetDetail("10002", &DevStatusDetailOptions{ApplicationType: "github", DataType: DevStatusDataTypePullRequest})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+		return
+	}
+	prs := detail.PullRequests()
+	if len(prs) != 2 {
+		t.Errorf("Expected 2 pull requests. Got %d", len(prs))
+		return
+	}
+	if prs[0].Source.Branch != "EX-1-fix" || len(prs[0].Reviewers) != 1 || !prs[0].Reviewers[0].Approved {
+		t.Errorf("Unexpected pull request: %+v", prs[0])
+	}
+	if detail.Detail[0].Instance.Type != "github" {
+		t.Errorf("Unexpected instance: %+v", detail.Detail[0].Instance)
+	}
+	if !detail.AllPullRequestsMerged() {
+		t.Error("Expected all pull requests to be merged")
+	}
+}
+
+func TestDevStatusDetail_AllPullRequestsMerged(t *testing.T) {
+	tests := []struct {
+		statuses []string
+		want     bool
+	}{
+		{nil, false},
+		{[]string{PullRequestStatusMerged}, true},
+		{[]string{PullRequestStatusMerged, PullRequestStatusOpen}, false},
+		{[]string{PullRequestStatusDeclined}, false},
+		{[]string{PullRequestStatusDeclined, PullRequestStatusMerged}, true},
+	}
+
+	for _, test := range tests {
+		item := DevStatusDetailItem{}
+		for _, status := range test.statuses {
+			item.PullRequests = append(item.PullRequests, DevStatusPullRequest{Status: status})
+		}
+		detail := &DevStatusDetail{Detail: []DevStatusDetailItem{item}}
+		if got := detail.AllPullRequestsMerged(); got != test.want {
+			t.Errorf("AllPullRequestsMerged() for %v = %t, want %t", test.statuses, got, test.want)
+		}
+	}
+}
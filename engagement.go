@@ -0,0 +1,138 @@
+package jira
+
+import (
+	"context"
+	"sync"
+)
+
+// DefaultEngagementExportConcurrency is the number of issues whose watchers and voters are fetched in parallel
+// by ExportWatchersAndVoters if no concurrency is given.
+const DefaultEngagementExportConcurrency = 8
+
+// IssueEngagement contains the watchers and voters of a single issue
+type IssueEngagement struct {
+	ID       string
+	Key      string
+	Summary  string
+	Watchers []*Watcher
+	// Voters is only populated if the user has the permission to view voters of the issue,
+	// VoteCount is always set.
+	Voters    []*User
+	VoteCount int
+}
+
+// EngagementReport is the result of ExportWatchersAndVoters.
+// The issues are in the order of the search results.
+type EngagementReport struct {
+	JQL    string
+	Issues []IssueEngagement
+}
+
+// ExportWatchersAndVotersWithContext collects the watchers and voters of all issues matching jql.
+// The watchers and voters of at most concurrency issues are fetched in parallel (DefaultEngagementExportConcurrency if <= 0).
+// Issues without watchers or votes are not requested again, their lists are empty.
+// The first error stops the export and is returned.
+func (s *IssueService) ExportWatchersAndVotersWithContext(ctx context.Context, jql string, concurrency int) (*EngagementReport, error) {
+	if concurrency <= 0 {
+		concurrency = DefaultEngagementExportConcurrency
+	}
+
+	report := &EngagementReport{JQL: jql}
+	var issues []Issue
+	options := &SearchOptions{MaxResults: 100, Fields: []string{"summary", "watches", "votes"}}
+	err := s.SearchPagesWithContext(ctx, jql, options, func(issue Issue) error {
+		issues = append(issues, issue)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	report.Issues = make([]IssueEngagement, len(issues))
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+	failed := func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return firstErr != nil
+	}
+
+	sem := make(chan struct{}, concurrency)
+	for i, issue := range issues {
+		sem <- struct{}{}
+		if failed() {
+			<-sem
+			break
+		}
+
+		wg.Add(1)
+		go func(i int, issue Issue) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			engagement, err := s.issueEngagement(ctx, issue)
+			if err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				mu.Unlock()
+				return
+			}
+			report.Issues[i] = *engagement
+		}(i, issue)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return report, nil
+}
+
+// ExportWatchersAndVoters wraps ExportWatchersAndVotersWithContext using the background context.
+func (s *IssueService) ExportWatchersAndVoters(jql string, concurrency int) (*EngagementReport, error) {
+	return s.ExportWatchersAndVotersWithContext(context.Background(), jql, concurrency)
+}
+
+// issueEngagement fetches the watchers and voters of a single issue
+func (s *IssueService) issueEngagement(ctx context.Context, issue Issue) (*IssueEngagement, error) {
+	engagement := &IssueEngagement{
+		ID:       issue.ID,
+		Key:      issue.Key,
+		Watchers: []*Watcher{},
+		Voters:   []*User{},
+	}
+	if issue.Fields == nil {
+		return engagement, nil
+	}
+	engagement.Summary = issue.Fields.Summary
+
+	if issue.Fields.Watches == nil || issue.Fields.Watches.WatchCount > 0 {
+		watches, _, err := s.GetWatchersListWithContext(ctx, issue.ID)
+		if err != nil {
+			return nil, err
+		}
+		if watches.Watchers != nil {
+			engagement.Watchers = watches.Watchers
+		}
+	}
+
+	if issue.Fields.Votes == nil || issue.Fields.Votes.Votes > 0 {
+		votes, _, err := s.GetVotesWithContext(ctx, issue.ID)
+		if err != nil {
+			return nil, err
+		}
+		engagement.VoteCount = votes.Votes
+		if votes.Voters != nil {
+			engagement.Voters = votes.Voters
+		}
+	}
+
+	return engagement, nil
+}
@@ -0,0 +1,75 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+)
+
+func TestIssueService_ExportWatchersAndVoters(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/search?jql=project+%3D+TEST&maxResults=100&fields=summary,watches,votes")
+
+		fmt.Fprint(w, `{"startAt":0,"maxResults":100,"total":2,"issues":[
+			{"id":"10001","key":"TEST-1","fields":{"summary":"First","watches":{"watchCount":2},"votes":{"votes":1}}},
+			{"id":"10002","key":"TEST-2","fields":{"summary":"Second","watches":{"watchCount":0},"votes":{"votes":0}}}]}`)
+	})
+	testMux.HandleFunc("/rest/api/2/issue/10001/watchers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"watchCount":2,"watchers":[{"accountId":"a1","displayName":"Fred"},{"accountId":"a2","displayName":"Wilma"}]}`)
+	})
+	testMux.HandleFunc("/rest/api/2/issue/10001/votes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"votes":1,"hasVoted":false,"voters":[{"accountId":"a3","displayName":"Barney"}]}`)
+	})
+	var unexpected int32
+	testMux.HandleFunc("/rest/api/2/issue/10002/", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&unexpected, 1)
+	})
+
+	report, err := testClient.Issue.ExportWatchersAndVoters("project = TEST", 2)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(report.Issues) != 2 {
+		t.Fatalf("Expected 2 issues, got %d", len(report.Issues))
+	}
+
+	first := report.Issues[0]
+	if first.Key != "TEST-1" || first.Summary != "First" {
+		t.Errorf("Unexpected first issue %+v", first)
+	}
+	if len(first.Watchers) != 2 || first.Watchers[1].DisplayName != "Wilma" {
+		t.Errorf("Unexpected watchers %+v", first.Watchers)
+	}
+	if first.VoteCount != 1 || len(first.Voters) != 1 || first.Voters[0].AccountID != "a3" {
+		t.Errorf("Unexpected voters %+v", first.Voters)
+	}
+
+	second := report.Issues[1]
+	if second.Key != "TEST-2" || len(second.Watchers) != 0 || len(second.Voters) != 0 {
+		t.Errorf("Unexpected second issue %+v", second)
+	}
+	if unexpected != 0 {
+		t.Error("Expected the watchers and voters of TEST-2 not to be requested")
+	}
+}
+
+func TestIssueService_ExportWatchersAndVoters_Error(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"startAt":0,"maxResults":100,"total":1,"issues":[{"id":"10001","key":"TEST-1","fields":{"watches":{"watchCount":1},"votes":{"votes":0}}}]}`)
+	})
+	testMux.HandleFunc("/rest/api/2/issue/10001/watchers", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	if _, err := testClient.Issue.ExportWatchersAndVoters("project = TEST", 0); err == nil {
+		t.Error("Expected an error")
+	}
+}
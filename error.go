@@ -5,17 +5,27 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 )
 
 // Error message from JIRA
 // See https://docs.atlassian.com/jira/REST/cloud/#error-responses
+//
+// ErrorMessages contains the general messages, Errors the validation messages by field.
+// HTTPError is the error returned by Client.Do, e.g. an *AuthError for 401 and 403 responses.
 type Error struct {
 	HTTPError     error
 	ErrorMessages []string          `json:"errorMessages"`
 	Errors        map[string]string `json:"errors"`
+	// StatusCode is the HTTP status code of the response
+	StatusCode int `json:"-"`
+	// RetryAfter is the delay requested by the Retry-After header, e.g. of a 429 or 503 response, 0 if there is none
+	RetryAfter time.Duration `json:"-"`
 }
 
 // NewJiraError creates a new jira Error
@@ -29,7 +39,11 @@ func NewJiraError(resp *Response, httpError error) error {
 	if err != nil {
 		return errors.Wrap(err, httpError.Error())
 	}
-	jerr := Error{HTTPError: httpError}
+	jerr := Error{
+		HTTPError:  httpError,
+		StatusCode: resp.StatusCode,
+		RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After")),
+	}
 	contentType := resp.Header.Get("Content-Type")
 	if strings.HasPrefix(contentType, "application/json") {
 		err = json.Unmarshal(body, &jerr)
@@ -38,15 +52,61 @@ func NewJiraError(resp *Response, httpError error) error {
 			return errors.Wrap(err, httpError.Error())
 		}
 	} else {
-        if httpError == nil {
-            return fmt.Errorf("Got Response Status %s:%s", resp.Status, string(body))
-        }
-		return errors.Wrap(httpError, fmt.Sprintf("%s: %s", resp.Status, string(body)))
+		if httpError == nil {
+			jerr.HTTPError = fmt.Errorf("Got Response Status %s:%s", resp.Status, string(body))
+		} else {
+			jerr.HTTPError = errors.Wrap(httpError, fmt.Sprintf("%s: %s", resp.Status, string(body)))
+		}
 	}
 
 	return &jerr
 }
 
+// AsError returns the *Error contained in err, e.g. an error returned by a service method
+func AsError(err error) (*Error, bool) {
+	for err != nil {
+		if e, ok := err.(*Error); ok {
+			return e, true
+		}
+		cause := errors.Cause(err)
+		if cause == err {
+			return nil, false
+		}
+		err = cause
+	}
+	return nil, false
+}
+
+// IsValidationError reports if JIRA rejected the request because of invalid field values, see Errors
+func (e *Error) IsValidationError() bool {
+	return e.StatusCode == http.StatusBadRequest && len(e.Errors) > 0
+}
+
+// IsRateLimited reports if the request was rejected because of too many requests.
+// RetryAfter is the delay to wait before sending the request again.
+func (e *Error) IsRateLimited() bool {
+	return e.StatusCode == http.StatusTooManyRequests
+}
+
+// parseRetryAfter parses the value of a Retry-After header, which is either a number of seconds or an HTTP date
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		if d := time.Until(date); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
+
 // Error is a short string representing the error
 func (e *Error) Error() string {
 	if len(e.ErrorMessages) > 0 {
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestError_NewJiraError(t *testing.T) {
@@ -203,3 +204,67 @@ func TestError_LongMessage(t *testing.T) {
 		t.Errorf("Expected the error map: Got\n%s\n", msg)
 	}
 }
+
+func TestError_StatusCodeAndValidation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"errorMessages":[],"errors":{"summary":"You must specify a summary of the issue."}}`)
+	})
+
+	_, _, err := testClient.Issue.Create(&Issue{Fields: &IssueFields{}})
+	jerr, ok := AsError(err)
+	if !ok {
+		t.Fatalf("Expected jira Error. Got %v", err)
+	}
+	if jerr.StatusCode != http.StatusBadRequest {
+		t.Errorf("Expected status code 400, got %d", jerr.StatusCode)
+	}
+	if !jerr.IsValidationError() || jerr.IsRateLimited() {
+		t.Errorf("Expected a validation error, got %+v", jerr)
+	}
+	if jerr.Errors["summary"] == "" {
+		t.Errorf("Expected the field error, got %v", jerr.Errors)
+	}
+}
+
+func TestError_RetryAfter(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusTooManyRequests)
+		fmt.Fprint(w, `Rate limit exceeded`)
+	})
+
+	req, _ := testClient.NewRequest("GET", "/", nil)
+	resp, err := testClient.Do(req, nil)
+
+	jerr, ok := AsError(NewJiraError(resp, err))
+	if !ok {
+		t.Fatalf("Expected jira Error. Got %v", err)
+	}
+	if !jerr.IsRateLimited() || jerr.RetryAfter != 30*time.Second {
+		t.Errorf("Expected a rate limit with a delay of 30s, got %+v", jerr)
+	}
+	if !strings.Contains(jerr.Error(), "Rate limit exceeded") {
+		t.Errorf("Expected the response body in the message, got %s", jerr.Error())
+	}
+}
+
+func TestError_ParseRetryAfter(t *testing.T) {
+	if d := parseRetryAfter(""); d != 0 {
+		t.Errorf("Expected no delay, got %s", d)
+	}
+	if d := parseRetryAfter("120"); d != 2*time.Minute {
+		t.Errorf("Expected 2m, got %s", d)
+	}
+	date := time.Now().Add(time.Hour).UTC().Format(http.TimeFormat)
+	if d := parseRetryAfter(date); d < 59*time.Minute || d > time.Hour {
+		t.Errorf("Expected about 1h, got %s", d)
+	}
+}
@@ -50,7 +50,7 @@ func main() {
 
 	fmt.Printf("Targetting %s for issue %s\n", strings.TrimSpace(jiraURL), key)
 
-	options := &jira.GetQueryOptions{Expand: "renderedFields"}
+	options := &jira.GetQueryOptions{Expand: jira.ExpandRenderedFields}
 	u, _, err := client.Issue.Get(key, options)
 
 	if err != nil {
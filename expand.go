@@ -0,0 +1,85 @@
+package jira
+
+import (
+	"fmt"
+	"strings"
+)
+
+// These constants are the expansions of issues, used by IssueService.Get, IssueService.Search and others
+const (
+	ExpandRenderedFields           = "renderedFields"
+	ExpandNames                    = "names"
+	ExpandSchema                   = "schema"
+	ExpandTransitions              = "transitions"
+	ExpandEditMeta                 = "editmeta"
+	ExpandChangelog                = "changelog"
+	ExpandOperations               = "operations"
+	ExpandVersionedRepresentations = "versionedRepresentations"
+)
+
+// These constants are the expansions of projects, used by ProjectService.ListWithOptions and ProjectService.Get
+const (
+	ExpandProjectDescription        = "description"
+	ExpandProjectIssueTypes         = "issueTypes"
+	ExpandProjectLead               = "lead"
+	ExpandProjectKeys               = "projectKeys"
+	ExpandProjectURL                = "url"
+	ExpandProjectIssueTypeHierarchy = "issueTypeHierarchy"
+)
+
+// These constants are the expansions of the create meta information, used by IssueService.GetCreateMetaWithOptions
+const (
+	ExpandCreateMetaFields = "projects.issuetypes.fields"
+)
+
+// ExpandSet is the set of expansions supported by an endpoint
+type ExpandSet []string
+
+// These are the expansions supported by the endpoints
+var (
+	// IssueExpand is supported by IssueService.Get, IssueService.Search and IssueService.SearchJQL
+	IssueExpand = ExpandSet{
+		ExpandRenderedFields, ExpandNames, ExpandSchema, ExpandTransitions,
+		ExpandEditMeta, ExpandChangelog, ExpandOperations, ExpandVersionedRepresentations,
+	}
+	// ProjectExpand is supported by ProjectService.ListWithOptions
+	ProjectExpand = ExpandSet{
+		ExpandProjectDescription, ExpandProjectIssueTypes, ExpandProjectLead,
+		ExpandProjectKeys, ExpandProjectURL, ExpandProjectIssueTypeHierarchy,
+	}
+	// CreateMetaExpand is supported by IssueService.GetCreateMetaWithOptions
+	CreateMetaExpand = ExpandSet{ExpandCreateMetaFields}
+)
+
+// Expand validates that the endpoint supports the given expansions
+// and combines them into the value of an Expand option, e.g.
+//
+//	expand, err := jira.IssueExpand.Expand(jira.ExpandChangelog, jira.ExpandRenderedFields)
+//	issue, _, err := client.Issue.Get("EX-1", &jira.GetQueryOptions{Expand: expand})
+func (s ExpandSet) Expand(expands ...string) (string, error) {
+	for _, expand := range expands {
+		if !s.contains(expand) {
+			return "", fmt.Errorf("expand %q is not supported, supported are %s", expand, strings.Join(s, ", "))
+		}
+	}
+	return strings.Join(expands, ","), nil
+}
+
+// MustExpand is like Expand, but panics if an expansion is not supported.
+// It simplifies the initialization of options with constant expansions.
+func (s ExpandSet) MustExpand(expands ...string) string {
+	expand, err := s.Expand(expands...)
+	if err != nil {
+		panic(err)
+	}
+	return expand
+}
+
+func (s ExpandSet) contains(expand string) bool {
+	for _, supported := range s {
+		if supported == expand {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,30 @@
+package jira
+
+import "testing"
+
+func TestExpandSet_Expand(t *testing.T) {
+	expand, err := IssueExpand.Expand(ExpandChangelog, ExpandRenderedFields)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if expand != "changelog,renderedFields" {
+		t.Errorf("Expected changelog,renderedFields, got %s", expand)
+	}
+
+	if _, err := IssueExpand.Expand(ExpandProjectLead); err == nil {
+		t.Error("Expected error for unsupported expand")
+	}
+}
+
+func TestExpandSet_MustExpand(t *testing.T) {
+	if expand := ProjectExpand.MustExpand(ExpandProjectIssueTypes); expand != "issueTypes" {
+		t.Errorf("Expected issueTypes, got %s", expand)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected panic for unsupported expand")
+		}
+	}()
+	CreateMetaExpand.MustExpand(ExpandNames)
+}
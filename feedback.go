@@ -0,0 +1,97 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+)
+
+// RequestFeedbackTypeCSAT is the type of customer satisfaction feedback
+const RequestFeedbackTypeCSAT = "csat"
+
+// RequestFeedbackComment is the optional comment of a RequestFeedback
+type RequestFeedbackComment struct {
+	Body string `json:"body" structs:"body"`
+}
+
+// RequestFeedback is the satisfaction feedback a customer gave on a request.
+// Rating is between 1 and 5.
+type RequestFeedback struct {
+	Type    string                  `json:"type,omitempty" structs:"type,omitempty"`
+	Rating  int                     `json:"rating" structs:"rating"`
+	Comment *RequestFeedbackComment `json:"comment,omitempty" structs:"comment,omitempty"`
+}
+
+// GetRequestFeedbackWithContext returns the feedback of the customer request issueIDOrKey.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-request/#api-rest-servicedeskapi-request-requestidorkey-feedback-get
+func (s *ServiceDeskService) GetRequestFeedbackWithContext(ctx context.Context, issueIDOrKey string) (*RequestFeedback, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/servicedeskapi/request/%s/feedback", issueIDOrKey)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	feedback := new(RequestFeedback)
+	resp, err := s.client.Do(req, feedback)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return feedback, resp, nil
+}
+
+// GetRequestFeedback wraps GetRequestFeedbackWithContext using the background context.
+func (s *ServiceDeskService) GetRequestFeedback(issueIDOrKey string) (*RequestFeedback, *Response, error) {
+	return s.GetRequestFeedbackWithContext(context.Background(), issueIDOrKey)
+}
+
+// PostRequestFeedbackWithContext adds feedback to the customer request issueIDOrKey.
+// Only the reporter of the request can give feedback. Type defaults to RequestFeedbackTypeCSAT.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-request/#api-rest-servicedeskapi-request-requestidorkey-feedback-post
+func (s *ServiceDeskService) PostRequestFeedbackWithContext(ctx context.Context, issueIDOrKey string, feedback *RequestFeedback) (*RequestFeedback, *Response, error) {
+	if feedback.Type == "" {
+		f := *feedback
+		f.Type = RequestFeedbackTypeCSAT
+		feedback = &f
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/servicedeskapi/request/%s/feedback", issueIDOrKey)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, feedback)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(RequestFeedback)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result, resp, nil
+}
+
+// PostRequestFeedback wraps PostRequestFeedbackWithContext using the background context.
+func (s *ServiceDeskService) PostRequestFeedback(issueIDOrKey string, feedback *RequestFeedback) (*RequestFeedback, *Response, error) {
+	return s.PostRequestFeedbackWithContext(context.Background(), issueIDOrKey, feedback)
+}
+
+// DeleteRequestFeedbackWithContext deletes the feedback of the customer request issueIDOrKey.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-request/#api-rest-servicedeskapi-request-requestidorkey-feedback-delete
+func (s *ServiceDeskService) DeleteRequestFeedbackWithContext(ctx context.Context, issueIDOrKey string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/servicedeskapi/request/%s/feedback", issueIDOrKey)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// DeleteRequestFeedback wraps DeleteRequestFeedbackWithContext using the background context.
+func (s *ServiceDeskService) DeleteRequestFeedback(issueIDOrKey string) (*Response, error) {
+	return s.DeleteRequestFeedbackWithContext(context.Background(), issueIDOrKey)
+}
@@ -0,0 +1,66 @@
+package jira
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestServiceDeskService_GetRequestFeedback(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/servicedeskapi/request/SD-1/feedback", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/servicedeskapi/request/SD-1/feedback")
+		fmt.Fprint(w, `{"type":"csat","rating":4,"comment":{"body":"Great work!"}}`)
+	})
+
+	feedback, _, err := testClient.ServiceDesk.GetRequestFeedback("SD-1")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if feedback.Type != RequestFeedbackTypeCSAT || feedback.Rating != 4 || feedback.Comment.Body != "Great work!" {
+		t.Errorf("Unexpected feedback %+v", feedback)
+	}
+}
+
+func TestServiceDeskService_PostRequestFeedback(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/servicedeskapi/request/SD-1/feedback", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, "/rest/servicedeskapi/request/SD-1/feedback")
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"type":"csat","rating":5}`+"\n" {
+			t.Errorf("Unexpected body %s", body)
+		}
+		fmt.Fprint(w, `{"type":"csat","rating":5}`)
+	})
+
+	input := &RequestFeedback{Rating: 5}
+	feedback, _, err := testClient.ServiceDesk.PostRequestFeedback("SD-1", input)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if feedback.Rating != 5 {
+		t.Errorf("Unexpected feedback %+v", feedback)
+	}
+	if input.Type != "" {
+		t.Error("Expected the input to be left unchanged")
+	}
+}
+
+func TestServiceDeskService_DeleteRequestFeedback(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/servicedeskapi/request/SD-1/feedback", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, "/rest/servicedeskapi/request/SD-1/feedback")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.ServiceDesk.DeleteRequestFeedback("SD-1"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
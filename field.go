@@ -1,5 +1,10 @@
 package jira
 
+import (
+	"context"
+	"fmt"
+)
+
 // FieldService handles fields for the JIRA instance / API.
 //
 // JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/#api-Field
@@ -12,24 +17,63 @@ type Field struct {
 	ID          string      `json:"id,omitempty" structs:"id,omitempty"`
 	Key         string      `json:"key,omitempty" structs:"key,omitempty"`
 	Name        string      `json:"name,omitempty" structs:"name,omitempty"`
+	Description string      `json:"description,omitempty" structs:"description,omitempty"`
 	Custom      bool        `json:"custom,omitempty" structs:"custom,omitempty"`
 	Navigable   bool        `json:"navigable,omitempty" structs:"navigable,omitempty"`
 	Searchable  bool        `json:"searchable,omitempty" structs:"searchable,omitempty"`
 	ClauseNames []string    `json:"clauseNames,omitempty" structs:"clauseNames,omitempty"`
 	Schema      FieldSchema `json:"schema,omitempty" structs:"schema,omitempty"`
+	IsLocked    bool        `json:"isLocked,omitempty" structs:"isLocked,omitempty"`
+	// TrashedDate and TrashedBy are only set for fields which are in the trash
+	TrashedDate string `json:"trashedDate,omitempty" structs:"trashedDate,omitempty"`
+	TrashedBy   *User  `json:"trashedBy,omitempty" structs:"trashedBy,omitempty"`
 }
 
 type FieldSchema struct {
-	Type   string `json:"type,omitempty" structs:"type,omitempty"`
+	Type string `json:"type,omitempty" structs:"type,omitempty"`
+	// Items is the type of the values of an array field, e.g. "option"
+	Items  string `json:"items,omitempty" structs:"items,omitempty"`
 	System string `json:"system,omitempty" structs:"system,omitempty"`
+	// Custom is the type of a custom field, e.g. "com.atlassian.jira.plugin.system.customfieldtypes:select"
+	Custom   string `json:"custom,omitempty" structs:"custom,omitempty"`
+	CustomID int64  `json:"customId,omitempty" structs:"customId,omitempty"`
+}
+
+// FieldsList reflects a paginated list of fields
+type FieldsList struct {
+	MaxResults int     `json:"maxResults" structs:"maxResults"`
+	StartAt    int     `json:"startAt" structs:"startAt"`
+	Total      int     `json:"total" structs:"total"`
+	IsLast     bool    `json:"isLast" structs:"isLast"`
+	Values     []Field `json:"values" structs:"values"`
+}
+
+func (l *FieldsList) pageInfo() PageInfo {
+	return PageInfo{StartAt: l.StartAt, MaxResults: l.MaxResults, Total: l.Total, IsLast: l.IsLast}
 }
 
-// GetList gets all fields from JIRA
+// FieldSearchOptions specifies the optional parameters for the SearchTrashed method
+type FieldSearchOptions struct {
+	// StartAt: The index of the first item to return in a page of results (page offset).
+	StartAt int `url:"startAt,omitempty"`
+	// MaxResults: The maximum number of items to return per page. Default: 50.
+	MaxResults int `url:"maxResults,omitempty"`
+	// ID: The IDs of the fields to return, comma separated.
+	ID string `url:"id,omitempty"`
+	// Query: String used to perform a case-insensitive partial match with field names or descriptions.
+	Query string `url:"query,omitempty"`
+	// OrderBy: Order the results by a field. Valid values: name, -name, +name, trashDate, -trashDate, +trashDate, plannedDeletionDate, ...
+	OrderBy string `url:"orderBy,omitempty"`
+	// Expand: Use expand to include additional information in the response, e.g. "name,description"
+	Expand string `url:"expand,omitempty"`
+}
+
+// GetListWithContext gets all fields from JIRA
 //
 // JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/#api-api-2-field-get
-func (s *FieldService) GetList() ([]Field, *Response, error) {
+func (s *FieldService) GetListWithContext(ctx context.Context) ([]Field, *Response, error) {
 	apiEndpoint := "rest/api/2/field"
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -41,3 +85,312 @@ func (s *FieldService) GetList() ([]Field, *Response, error) {
 	}
 	return fieldList, resp, nil
 }
+
+// GetList wraps GetListWithContext using the background context.
+func (s *FieldService) GetList() ([]Field, *Response, error) {
+	return s.GetListWithContext(context.Background())
+}
+
+// SearchTrashedWithContext returns a paginated list of the custom fields which are in the trash.
+// Only available on JIRA Cloud.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/#api-rest-api-2-field-search-trashed-get
+func (s *FieldService) SearchTrashedWithContext(ctx context.Context, options *FieldSearchOptions) (*FieldsList, *Response, error) {
+	apiEndpoint := "rest/api/2/field/search/trashed"
+	url, err := addOptions(apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fields := new(FieldsList)
+	resp, err := s.client.Do(req, fields)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return fields, resp, nil
+}
+
+// SearchTrashed wraps SearchTrashedWithContext using the background context.
+func (s *FieldService) SearchTrashed(options *FieldSearchOptions) (*FieldsList, *Response, error) {
+	return s.SearchTrashedWithContext(context.Background(), options)
+}
+
+// TrashWithContext moves the custom field with the given ID to the trash.
+// Only available on JIRA Cloud.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/#api-rest-api-2-field-id-trash-post
+func (s *FieldService) TrashWithContext(ctx context.Context, fieldID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/field/%s/trash", fieldID)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// Trash wraps TrashWithContext using the background context.
+func (s *FieldService) Trash(fieldID string) (*Response, error) {
+	return s.TrashWithContext(context.Background(), fieldID)
+}
+
+// RestoreWithContext restores the custom field with the given ID from the trash.
+// Only available on JIRA Cloud.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/#api-rest-api-2-field-id-restore-post
+func (s *FieldService) RestoreWithContext(ctx context.Context, fieldID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/field/%s/restore", fieldID)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// Restore wraps RestoreWithContext using the background context.
+func (s *FieldService) Restore(fieldID string) (*Response, error) {
+	return s.RestoreWithContext(context.Background(), fieldID)
+}
+
+// DeleteWithContext permanently deletes the custom field with the given ID.
+// The field has to be in the trash. The deletion is done asynchronously by JIRA,
+// the location of the task can be found in the Location header of the response.
+// Only available on JIRA Cloud.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/#api-rest-api-2-field-id-delete
+func (s *FieldService) DeleteWithContext(ctx context.Context, fieldID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/field/%s", fieldID)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// Delete wraps DeleteWithContext using the background context.
+func (s *FieldService) Delete(fieldID string) (*Response, error) {
+	return s.DeleteWithContext(context.Background(), fieldID)
+}
+
+// FieldContext represents a context of a custom field.
+// A context defines to which projects and issue types a custom field applies.
+type FieldContext struct {
+	ID              string `json:"id,omitempty" structs:"id,omitempty"`
+	Name            string `json:"name,omitempty" structs:"name,omitempty"`
+	Description     string `json:"description,omitempty" structs:"description,omitempty"`
+	IsGlobalContext bool   `json:"isGlobalContext,omitempty" structs:"isGlobalContext,omitempty"`
+	IsAnyIssueType  bool   `json:"isAnyIssueType,omitempty" structs:"isAnyIssueType,omitempty"`
+}
+
+// FieldContextsList reflects a paginated list of custom field contexts
+type FieldContextsList struct {
+	MaxResults int            `json:"maxResults" structs:"maxResults"`
+	StartAt    int            `json:"startAt" structs:"startAt"`
+	Total      int            `json:"total" structs:"total"`
+	IsLast     bool           `json:"isLast" structs:"isLast"`
+	Values     []FieldContext `json:"values" structs:"values"`
+}
+
+func (l *FieldContextsList) pageInfo() PageInfo {
+	return PageInfo{StartAt: l.StartAt, MaxResults: l.MaxResults, Total: l.Total, IsLast: l.IsLast}
+}
+
+// FieldContextProjectMapping represents the mapping of a custom field context to a project.
+// If the context is global, ProjectID is empty.
+type FieldContextProjectMapping struct {
+	ContextID       string `json:"contextId,omitempty" structs:"contextId,omitempty"`
+	ProjectID       string `json:"projectId,omitempty" structs:"projectId,omitempty"`
+	IsGlobalContext bool   `json:"isGlobalContext,omitempty" structs:"isGlobalContext,omitempty"`
+}
+
+// FieldContextProjectMappingsList reflects a paginated list of custom field context to project mappings
+type FieldContextProjectMappingsList struct {
+	MaxResults int                          `json:"maxResults" structs:"maxResults"`
+	StartAt    int                          `json:"startAt" structs:"startAt"`
+	Total      int                          `json:"total" structs:"total"`
+	IsLast     bool                         `json:"isLast" structs:"isLast"`
+	Values     []FieldContextProjectMapping `json:"values" structs:"values"`
+}
+
+func (l *FieldContextProjectMappingsList) pageInfo() PageInfo {
+	return PageInfo{StartAt: l.StartAt, MaxResults: l.MaxResults, Total: l.Total, IsLast: l.IsLast}
+}
+
+// FieldScreen represents a screen a field is used on, including the tab the field is placed on.
+type FieldScreen struct {
+	ID          int             `json:"id,omitempty" structs:"id,omitempty"`
+	Name        string          `json:"name,omitempty" structs:"name,omitempty"`
+	Description string          `json:"description,omitempty" structs:"description,omitempty"`
+	Tab         *FieldScreenTab `json:"tab,omitempty" structs:"tab,omitempty"`
+}
+
+// FieldScreenTab represents a tab of a screen
+type FieldScreenTab struct {
+	ID   int    `json:"id,omitempty" structs:"id,omitempty"`
+	Name string `json:"name,omitempty" structs:"name,omitempty"`
+}
+
+// FieldScreensList reflects a paginated list of screens
+type FieldScreensList struct {
+	MaxResults int           `json:"maxResults" structs:"maxResults"`
+	StartAt    int           `json:"startAt" structs:"startAt"`
+	Total      int           `json:"total" structs:"total"`
+	IsLast     bool          `json:"isLast" structs:"isLast"`
+	Values     []FieldScreen `json:"values" structs:"values"`
+}
+
+func (l *FieldScreensList) pageInfo() PageInfo {
+	return PageInfo{StartAt: l.StartAt, MaxResults: l.MaxResults, Total: l.Total, IsLast: l.IsLast}
+}
+
+// FieldContextOptions specifies the optional parameters for the GetContexts and GetContextProjectMappings methods
+type FieldContextOptions struct {
+	// ContextID: The IDs of the contexts to return, comma separated.
+	ContextID string `url:"contextId,omitempty"`
+	// IsAnyIssueType: Whether to return contexts that apply to all issue types.
+	IsAnyIssueType *bool `url:"isAnyIssueType,omitempty"`
+	// IsGlobalContext: Whether to return contexts that apply to all projects.
+	IsGlobalContext *bool `url:"isGlobalContext,omitempty"`
+	// StartAt: The index of the first item to return in a page of results (page offset).
+	StartAt int `url:"startAt,omitempty"`
+	// MaxResults: The maximum number of items to return per page. Default: 50.
+	MaxResults int `url:"maxResults,omitempty"`
+}
+
+// FieldScreensOptions specifies the optional parameters for the GetScreens method
+type FieldScreensOptions struct {
+	// StartAt: The index of the first item to return in a page of results (page offset).
+	StartAt int `url:"startAt,omitempty"`
+	// MaxResults: The maximum number of items to return per page. Default: 100.
+	MaxResults int `url:"maxResults,omitempty"`
+	// Expand: Use "tab" to include the tab the field is placed on.
+	Expand string `url:"expand,omitempty"`
+}
+
+// GetContextsWithContext returns a paginated list of the contexts of a custom field.
+// Only available on JIRA Cloud.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/#api-rest-api-2-field-fieldId-context-get
+func (s *FieldService) GetContextsWithContext(ctx context.Context, fieldID string, options *FieldContextOptions) (*FieldContextsList, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/field/%s/context", fieldID)
+	url, err := addOptions(apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	contexts := new(FieldContextsList)
+	resp, err := s.client.Do(req, contexts)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return contexts, resp, nil
+}
+
+// GetContexts wraps GetContextsWithContext using the background context.
+func (s *FieldService) GetContexts(fieldID string, options *FieldContextOptions) (*FieldContextsList, *Response, error) {
+	return s.GetContextsWithContext(context.Background(), fieldID, options)
+}
+
+// GetContextProjectMappingsWithContext returns a paginated list of the projects the contexts of a custom field are mapped to.
+// Only available on JIRA Cloud.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/#api-rest-api-2-field-fieldId-context-projectmapping-get
+func (s *FieldService) GetContextProjectMappingsWithContext(ctx context.Context, fieldID string, options *FieldContextOptions) (*FieldContextProjectMappingsList, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/field/%s/context/projectmapping", fieldID)
+	url, err := addOptions(apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mappings := new(FieldContextProjectMappingsList)
+	resp, err := s.client.Do(req, mappings)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return mappings, resp, nil
+}
+
+// GetContextProjectMappings wraps GetContextProjectMappingsWithContext using the background context.
+func (s *FieldService) GetContextProjectMappings(fieldID string, options *FieldContextOptions) (*FieldContextProjectMappingsList, *Response, error) {
+	return s.GetContextProjectMappingsWithContext(context.Background(), fieldID, options)
+}
+
+// GetScreensWithContext returns a paginated list of the screens a field is used on.
+// Only available on JIRA Cloud.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/#api-rest-api-2-field-fieldId-screens-get
+func (s *FieldService) GetScreensWithContext(ctx context.Context, fieldID string, options *FieldScreensOptions) (*FieldScreensList, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/field/%s/screens", fieldID)
+	url, err := addOptions(apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	screens := new(FieldScreensList)
+	resp, err := s.client.Do(req, screens)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return screens, resp, nil
+}
+
+// GetScreens wraps GetScreensWithContext using the background context.
+func (s *FieldService) GetScreens(fieldID string, options *FieldScreensOptions) (*FieldScreensList, *Response, error) {
+	return s.GetScreensWithContext(context.Background(), fieldID, options)
+}
+
+// GetAllScreensWithContext returns all screens a field is used on, by requesting all pages of GetScreens.
+// expand is passed to each page, use "tab" to include the tab the field is placed on.
+// Only available on JIRA Cloud.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/#api-rest-api-2-field-fieldId-screens-get
+func (s *FieldService) GetAllScreensWithContext(ctx context.Context, fieldID, expand string) ([]FieldScreen, error) {
+	options := &FieldScreensOptions{Expand: expand}
+	screens := []FieldScreen{}
+	for {
+		page, resp, err := s.GetScreensWithContext(ctx, fieldID, options)
+		if err != nil {
+			return nil, err
+		}
+		screens = append(screens, page.Values...)
+		if len(page.Values) == 0 || !resp.HasNextPage() {
+			return screens, nil
+		}
+		options.StartAt = resp.NextStartAt()
+	}
+}
+
+// GetAllScreens wraps GetAllScreensWithContext using the background context.
+func (s *FieldService) GetAllScreens(fieldID, expand string) ([]FieldScreen, error) {
+	return s.GetAllScreensWithContext(context.Background(), fieldID, expand)
+}
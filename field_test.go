@@ -30,3 +30,186 @@ func TestFieldService_GetList(t *testing.T) {
 		t.Errorf("Error given: %s", err)
 	}
 }
+
+func TestFieldService_SearchTrashed(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/field/search/trashed"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		testRequestParams(t, r, map[string]string{"startAt": "10", "maxResults": "5", "query": "points"})
+		fmt.Fprint(w, `{"maxResults":5,"startAt":10,"total":11,"isLast":true,"values":[{"id":"customfield_10016","name":"Story Points","schema":{"type":"number","custom":"com.atlassian.jira.plugin.system.customfieldtypes:float","customId":10016},"description":"Estimation","trashedDate":"2020-01-20T10:10:10.000+0000","trashedBy":{"accountId":"5b10a2844c20165700ede21g","displayName":"Mia Krystof","active":true}}]}`)
+	})
+
+	fields, _, err := testClient.Field.SearchTrashed(&FieldSearchOptions{StartAt: 10, MaxResults: 5, Query: "points"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+		return
+	}
+	if len(fields.Values) != 1 {
+		t.Errorf("Expected 1 field. Got %d", len(fields.Values))
+		return
+	}
+	if fields.Values[0].TrashedBy == nil || fields.Values[0].TrashedBy.AccountID != "5b10a2844c20165700ede21g" {
+		t.Errorf("Expected trashedBy to be set. Got %+v", fields.Values[0])
+	}
+}
+
+func TestFieldService_Trash(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/field/customfield_10016/trash"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{}`)
+	})
+
+	_, err := testClient.Field.Trash("customfield_10016")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestFieldService_Restore(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/field/customfield_10016/restore"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{}`)
+	})
+
+	_, err := testClient.Field.Restore("customfield_10016")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestFieldService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/field/customfield_10016"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, testAPIEndpoint)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	resp, err := testClient.Field.Delete("customfield_10016")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("Expected status 204. Got %d", resp.StatusCode)
+	}
+}
+
+func TestFieldService_Delete_NotInTrash(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/field/customfield_10016"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"errorMessages":["The custom field has to be in the trash before it can be deleted."],"errors":{}}`)
+	})
+
+	_, err := testClient.Field.Delete("customfield_10016")
+	if err == nil {
+		t.Error("Expected an error. Got none")
+	}
+}
+
+func TestFieldService_GetContexts(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/field/customfield_10016/context"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		testRequestParams(t, r, map[string]string{"isGlobalContext": "false"})
+		fmt.Fprint(w, `{"maxResults":50,"startAt":0,"total":1,"isLast":true,"values":[{"id":"10025","name":"Bug fields context","description":"A context used to define the custom field options for bugs.","isGlobalContext":false,"isAnyIssueType":false}]}`)
+	})
+
+	global := false
+	contexts, _, err := testClient.Field.GetContexts("customfield_10016", &FieldContextOptions{IsGlobalContext: &global})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+		return
+	}
+	if len(contexts.Values) != 1 || contexts.Values[0].ID != "10025" {
+		t.Errorf("Unexpected contexts: %+v", contexts.Values)
+	}
+}
+
+func TestFieldService_GetContextProjectMappings(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/field/customfield_10016/context/projectmapping"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"maxResults":50,"startAt":0,"total":2,"isLast":true,"values":[{"contextId":"10025","projectId":"10001"},{"contextId":"10026","isGlobalContext":true}]}`)
+	})
+
+	mappings, _, err := testClient.Field.GetContextProjectMappings("customfield_10016", nil)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+		return
+	}
+	if len(mappings.Values) != 2 {
+		t.Errorf("Expected 2 mappings. Got %d", len(mappings.Values))
+		return
+	}
+	if mappings.Values[0].ProjectID != "10001" || !mappings.Values[1].IsGlobalContext {
+		t.Errorf("Unexpected mappings: %+v", mappings.Values)
+	}
+}
+
+func TestFieldService_GetScreens(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/field/customfield_10016/screens"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		testRequestParams(t, r, map[string]string{"expand": "tab"})
+		fmt.Fprint(w, `{"maxResults":100,"startAt":0,"total":1,"isLast":true,"values":[{"id":10001,"name":"Default Screen","description":"Provides for the update of all system fields.","tab":{"id":10000,"name":"Fields Tab"}}]}`)
+	})
+
+	screens, _, err := testClient.Field.GetScreens("customfield_10016", &FieldScreensOptions{Expand: "tab"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+		return
+	}
+	if len(screens.Values) != 1 || screens.Values[0].Tab == nil || screens.Values[0].Tab.Name != "Fields Tab" {
+		t.Errorf("Unexpected screens: %+v", screens.Values)
+	}
+}
+
+func TestFieldService_GetAllScreens(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/field/customfield_10016/screens"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if r.URL.Query().Get("startAt") == "1" {
+			fmt.Fprint(w, `{"maxResults":1,"startAt":1,"total":2,"isLast":true,"values":[{"id":10002,"name":"Bug Screen"}]}`)
+			return
+		}
+		testRequestParams(t, r, map[string]string{"expand": "tab"})
+		fmt.Fprint(w, `{"maxResults":1,"startAt":0,"total":2,"isLast":false,"values":[{"id":10001,"name":"Default Screen"}]}`)
+	})
+
+	screens, err := testClient.Field.GetAllScreens("customfield_10016", "tab")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(screens) != 2 || screens[1].Name != "Bug Screen" {
+		t.Errorf("Unexpected screens: %+v", screens)
+	}
+}
@@ -0,0 +1,83 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// FieldChange is a single change of a field value, taken from the changelog of an issue.
+// From and To are the raw values (e.g. the status ID or the account ID), FromString and ToString their display values.
+// They are empty if the field had no value before or after the change.
+type FieldChange struct {
+	Field      string
+	From       string
+	FromString string
+	To         string
+	ToString   string
+	At         time.Time
+	By         User
+}
+
+// FieldHistory returns all changes of field in the changelog of issue, ordered by time, oldest first.
+// The field is matched case-insensitively against the field name of the changelog items, e.g. "status" or "assignee".
+// The issue has to include its changelog (expand=changelog), histories with an invalid created time are skipped.
+func FieldHistory(issue *Issue, field string) []FieldChange {
+	changes := []FieldChange{}
+	if issue == nil || issue.Changelog == nil {
+		return changes
+	}
+
+	for _, history := range issue.Changelog.Histories {
+		at, err := history.CreatedTime()
+		if err != nil {
+			continue
+		}
+		for _, item := range history.Items {
+			if !strings.EqualFold(item.Field, field) {
+				continue
+			}
+			changes = append(changes, FieldChange{
+				Field:      item.Field,
+				From:       changelogValue(item.From),
+				FromString: item.FromString,
+				To:         changelogValue(item.To),
+				ToString:   item.ToString,
+				At:         at,
+				By:         history.Author,
+			})
+		}
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].At.Before(changes[j].At)
+	})
+	return changes
+}
+
+// GetFieldHistoryWithContext requests the issue with its changelog and returns the changes of field, see FieldHistory.
+// Note that JIRA Cloud only includes the latest 100 histories in the changelog of an issue.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue-getIssue
+func (s *IssueService) GetFieldHistoryWithContext(ctx context.Context, issueID, field string) ([]FieldChange, *Response, error) {
+	issue, resp, err := s.GetWithContext(ctx, issueID, &GetQueryOptions{Fields: "created", Expand: ExpandChangelog})
+	if err != nil {
+		return nil, resp, err
+	}
+	return FieldHistory(issue, field), resp, nil
+}
+
+// GetFieldHistory wraps GetFieldHistoryWithContext using the background context.
+func (s *IssueService) GetFieldHistory(issueID, field string) ([]FieldChange, *Response, error) {
+	return s.GetFieldHistoryWithContext(context.Background(), issueID, field)
+}
+
+// changelogValue returns the raw value of a changelog item as string, "" for null
+func changelogValue(v interface{}) string {
+	if v == nil {
+		return ""
+	}
+	return fmt.Sprint(v)
+}
@@ -0,0 +1,99 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func fieldHistoryIssue() *Issue {
+	alice := User{AccountID: "alice"}
+	bob := User{AccountID: "bob"}
+	return &Issue{
+		Key: "EX-1",
+		Changelog: &Changelog{Histories: []ChangelogHistory{
+			{
+				Author:  bob,
+				Created: "2020-01-03T10:00:00.000+0000",
+				Items: []ChangelogItems{
+					{Field: "status", From: "3", FromString: "In Progress", To: "10001", ToString: "Done"},
+					{Field: "resolution", To: "1", ToString: "Fixed"},
+				},
+			},
+			{
+				Author:  alice,
+				Created: "2020-01-02T09:30:00.000+0000",
+				Items: []ChangelogItems{
+					{Field: "assignee", To: "alice", ToString: "Alice"},
+					{Field: "Status", From: "1", FromString: "Open", To: "3", ToString: "In Progress"},
+				},
+			},
+			{
+				Author:  alice,
+				Created: "invalid",
+				Items:   []ChangelogItems{{Field: "status", From: "1", To: "3"}},
+			},
+		}},
+	}
+}
+
+func TestFieldHistory(t *testing.T) {
+	expected := []FieldChange{
+		{
+			Field: "Status", From: "1", FromString: "Open", To: "3", ToString: "In Progress",
+			At: time.Date(2020, 1, 2, 9, 30, 0, 0, time.UTC), By: User{AccountID: "alice"},
+		},
+		{
+			Field: "status", From: "3", FromString: "In Progress", To: "10001", ToString: "Done",
+			At: time.Date(2020, 1, 3, 10, 0, 0, 0, time.UTC), By: User{AccountID: "bob"},
+		},
+	}
+
+	changes := FieldHistory(fieldHistoryIssue(), "status")
+	if len(changes) != len(expected) {
+		t.Fatalf("Expected %d changes, got %+v", len(expected), changes)
+	}
+	for i := range changes {
+		if !changes[i].At.Equal(expected[i].At) {
+			t.Errorf("Change %d: expected the time %s, got %s", i, expected[i].At, changes[i].At)
+		}
+		changes[i].At = expected[i].At
+	}
+	if !reflect.DeepEqual(changes, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, changes)
+	}
+}
+
+func TestFieldHistory_NullValues(t *testing.T) {
+	changes := FieldHistory(fieldHistoryIssue(), "assignee")
+	if len(changes) != 1 || changes[0].From != "" || changes[0].To != "alice" {
+		t.Errorf("Unexpected changes %+v", changes)
+	}
+}
+
+func TestFieldHistory_NoChangelog(t *testing.T) {
+	if changes := FieldHistory(&Issue{Key: "EX-1"}, "status"); len(changes) != 0 {
+		t.Errorf("Expected no changes, got %+v", changes)
+	}
+}
+
+func TestIssueService_GetFieldHistory(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/issue/EX-1?expand=changelog&fields=created")
+		fmt.Fprint(w, `{"key":"EX-1","changelog":{"histories":[{"id":"1","author":{"accountId":"alice"},"created":"2020-01-02T09:30:00.000+0000",
+			"items":[{"field":"assignee","fieldtype":"jira","from":null,"fromString":null,"to":"alice","toString":"Alice"}]}]}}`)
+	})
+
+	changes, _, err := testClient.Issue.GetFieldHistory("EX-1", "assignee")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(changes) != 1 || changes[0].ToString != "Alice" || changes[0].By.AccountID != "alice" {
+		t.Errorf("Unexpected changes %+v", changes)
+	}
+}
@@ -1,7 +1,12 @@
 package jira
 
-import "github.com/google/go-querystring/query"
+import (
+	"context"
+	"github.com/google/go-querystring/query"
+)
 import "fmt"
+import "net/url"
+import "strings"
 
 // FilterService handles fields for the JIRA instance / API.
 //
@@ -32,6 +37,25 @@ type Filter struct {
 	} `json:"subscriptions"`
 }
 
+// FilterSubscription is a scheduled email subscription of a user or a group to the results of a filter
+type FilterSubscription struct {
+	ID    int64  `json:"id" structs:"id"`
+	User  *User  `json:"user,omitempty" structs:"user,omitempty"`
+	Group *Group `json:"group,omitempty" structs:"group,omitempty"`
+}
+
+// These constants are the possible default share scopes of filters
+const (
+	ShareScopeGlobal        = "GLOBAL"
+	ShareScopeAuthenticated = "AUTHENTICATED"
+	ShareScopePrivate       = "PRIVATE"
+)
+
+// DefaultShareScope represents the scope new filters and dashboards are shared with by default
+type DefaultShareScope struct {
+	Scope string `json:"scope" structs:"scope"`
+}
+
 // GetMyFiltersQueryOptions specifies the optional parameters for the Get My Filters method
 type GetMyFiltersQueryOptions struct {
 	IncludeFavourites bool   `url:"includeFavourites,omitempty"`
@@ -47,6 +71,10 @@ type FiltersList struct {
 	Values     []FiltersListItem `json:"values" structs:"values"`
 }
 
+func (l *FiltersList) pageInfo() PageInfo {
+	return PageInfo{StartAt: l.StartAt, MaxResults: l.MaxResults, Total: l.Total, IsLast: l.IsLast}
+}
+
 // FiltersListItem represents a Filter of FiltersList in Jira
 type FiltersListItem struct {
 	Self             string        `json:"self"`
@@ -116,12 +144,12 @@ type FilterSearchOptions struct {
 	Expand string `url:"expand,omitempty"`
 }
 
-// GetList retrieves all filters from Jira
-func (fs *FilterService) GetList() ([]*Filter, *Response, error) {
+// GetListWithContext retrieves all filters from Jira
+func (fs *FilterService) GetListWithContext(ctx context.Context) ([]*Filter, *Response, error) {
 
 	options := &GetQueryOptions{}
 	apiEndpoint := "rest/api/2/filter"
-	req, err := fs.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := fs.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -143,10 +171,15 @@ func (fs *FilterService) GetList() ([]*Filter, *Response, error) {
 	return filters, resp, err
 }
 
-// GetFavouriteList retrieves the user's favourited filters from Jira
-func (fs *FilterService) GetFavouriteList() ([]*Filter, *Response, error) {
+// GetList wraps GetListWithContext using the background context.
+func (fs *FilterService) GetList() ([]*Filter, *Response, error) {
+	return fs.GetListWithContext(context.Background())
+}
+
+// GetFavouriteListWithContext retrieves the user's favourited filters from Jira
+func (fs *FilterService) GetFavouriteListWithContext(ctx context.Context) ([]*Filter, *Response, error) {
 	apiEndpoint := "rest/api/2/filter/favourite"
-	req, err := fs.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := fs.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -159,10 +192,15 @@ func (fs *FilterService) GetFavouriteList() ([]*Filter, *Response, error) {
 	return filters, resp, err
 }
 
-// Get retrieves a single Filter from Jira
-func (fs *FilterService) Get(filterID int) (*Filter, *Response, error) {
+// GetFavouriteList wraps GetFavouriteListWithContext using the background context.
+func (fs *FilterService) GetFavouriteList() ([]*Filter, *Response, error) {
+	return fs.GetFavouriteListWithContext(context.Background())
+}
+
+// GetWithContext retrieves a single Filter from Jira
+func (fs *FilterService) GetWithContext(ctx context.Context, filterID int) (*Filter, *Response, error) {
 	apiEndpoint := fmt.Sprintf("rest/api/2/filter/%d", filterID)
-	req, err := fs.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := fs.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -176,16 +214,21 @@ func (fs *FilterService) Get(filterID int) (*Filter, *Response, error) {
 	return filter, resp, err
 }
 
-// GetMyFilters retrieves the my Filters.
+// Get wraps GetWithContext using the background context.
+func (fs *FilterService) Get(filterID int) (*Filter, *Response, error) {
+	return fs.GetWithContext(context.Background(), filterID)
+}
+
+// GetMyFiltersWithContext retrieves the my Filters.
 //
 // https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-rest-api-3-filter-my-get
-func (fs *FilterService) GetMyFilters(opts *GetMyFiltersQueryOptions) ([]*Filter, *Response, error) {
+func (fs *FilterService) GetMyFiltersWithContext(ctx context.Context, opts *GetMyFiltersQueryOptions) ([]*Filter, *Response, error) {
 	apiEndpoint := "rest/api/3/filter/my"
 	url, err := addOptions(apiEndpoint, opts)
 	if err != nil {
 		return nil, nil, err
 	}
-	req, err := fs.client.NewRequest("GET", url, nil)
+	req, err := fs.client.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -199,16 +242,21 @@ func (fs *FilterService) GetMyFilters(opts *GetMyFiltersQueryOptions) ([]*Filter
 	return filters, resp, nil
 }
 
-// Search will search for filter according to the search options
+// GetMyFilters wraps GetMyFiltersWithContext using the background context.
+func (fs *FilterService) GetMyFilters(opts *GetMyFiltersQueryOptions) ([]*Filter, *Response, error) {
+	return fs.GetMyFiltersWithContext(context.Background(), opts)
+}
+
+// SearchWithContext will search for filter according to the search options
 //
 // JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-rest-api-3-filter-search-get
-func (fs *FilterService) Search(opt *FilterSearchOptions) (*FiltersList, *Response, error) {
+func (fs *FilterService) SearchWithContext(ctx context.Context, opt *FilterSearchOptions) (*FiltersList, *Response, error) {
 	apiEndpoint := "rest/api/3/filter/search"
 	url, err := addOptions(apiEndpoint, opt)
 	if err != nil {
 		return nil, nil, err
 	}
-	req, err := fs.client.NewRequest("GET", url, nil)
+	req, err := fs.client.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -222,3 +270,182 @@ func (fs *FilterService) Search(opt *FilterSearchOptions) (*FiltersList, *Respon
 
 	return filters, resp, err
 }
+
+// Search wraps SearchWithContext using the background context.
+func (fs *FilterService) Search(opt *FilterSearchOptions) (*FiltersList, *Response, error) {
+	return fs.SearchWithContext(context.Background(), opt)
+}
+
+// GetColumnsWithContext returns the columns configured for the filter.
+// These columns are used when the filter is viewed in the issue navigator.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-filters/#api-rest-api-2-filter-id-columns-get
+func (fs *FilterService) GetColumnsWithContext(ctx context.Context, filterID int) ([]ColumnItem, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/filter/%d/columns", filterID)
+	req, err := fs.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	columns := []ColumnItem{}
+	resp, err := fs.client.Do(req, &columns)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return nil, resp, jerr
+	}
+	return columns, resp, nil
+}
+
+// GetColumns wraps GetColumnsWithContext using the background context.
+func (fs *FilterService) GetColumns(filterID int) ([]ColumnItem, *Response, error) {
+	return fs.GetColumnsWithContext(context.Background(), filterID)
+}
+
+// SetColumnsWithContext sets the columns of the filter.
+// columns are the IDs of the fields, e.g. "issuetype", "summary" or "customfield_10000".
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-filters/#api-rest-api-2-filter-id-columns-put
+func (fs *FilterService) SetColumnsWithContext(ctx context.Context, filterID int, columns []string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/filter/%d/columns", filterID)
+	req, err := fs.client.NewRawRequestWithContext(ctx, "PUT", apiEndpoint, strings.NewReader(url.Values{"columns": columns}.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := fs.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// SetColumns wraps SetColumnsWithContext using the background context.
+func (fs *FilterService) SetColumns(filterID int, columns []string) (*Response, error) {
+	return fs.SetColumnsWithContext(context.Background(), filterID, columns)
+}
+
+// ResetColumnsWithContext resets the columns of the filter to the default columns of the user.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-filters/#api-rest-api-2-filter-id-columns-delete
+func (fs *FilterService) ResetColumnsWithContext(ctx context.Context, filterID int) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/filter/%d/columns", filterID)
+	req, err := fs.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := fs.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// ResetColumns wraps ResetColumnsWithContext using the background context.
+func (fs *FilterService) ResetColumns(filterID int) (*Response, error) {
+	return fs.ResetColumnsWithContext(context.Background(), filterID)
+}
+
+// GetDefaultShareScopeWithContext returns the default sharing settings for new filters and dashboards of the user.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-filter-sharing/#api-rest-api-2-filter-defaultsharescope-get
+func (fs *FilterService) GetDefaultShareScopeWithContext(ctx context.Context) (*DefaultShareScope, *Response, error) {
+	apiEndpoint := "rest/api/2/filter/defaultShareScope"
+	req, err := fs.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scope := new(DefaultShareScope)
+	resp, err := fs.client.Do(req, scope)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return nil, resp, jerr
+	}
+	return scope, resp, nil
+}
+
+// GetDefaultShareScope wraps GetDefaultShareScopeWithContext using the background context.
+func (fs *FilterService) GetDefaultShareScope() (*DefaultShareScope, *Response, error) {
+	return fs.GetDefaultShareScopeWithContext(context.Background())
+}
+
+// SetDefaultShareScopeWithContext sets the default sharing for new filters and dashboards of the user.
+// scope is one of ShareScopeGlobal, ShareScopeAuthenticated or ShareScopePrivate.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-filter-sharing/#api-rest-api-2-filter-defaultsharescope-put
+func (fs *FilterService) SetDefaultShareScopeWithContext(ctx context.Context, scope string) (*DefaultShareScope, *Response, error) {
+	apiEndpoint := "rest/api/2/filter/defaultShareScope"
+	req, err := fs.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, &DefaultShareScope{Scope: scope})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(DefaultShareScope)
+	resp, err := fs.client.Do(req, result)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return nil, resp, jerr
+	}
+	return result, resp, nil
+}
+
+// SetDefaultShareScope wraps SetDefaultShareScopeWithContext using the background context.
+func (fs *FilterService) SetDefaultShareScope(scope string) (*DefaultShareScope, *Response, error) {
+	return fs.SetDefaultShareScopeWithContext(context.Background(), scope)
+}
+
+// GetSubscriptionsWithContext returns the email subscriptions of the filter.
+// JIRA has no endpoints to create or delete subscriptions, they are managed in the UI.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-filters/#api-rest-api-2-filter-id-get
+func (fs *FilterService) GetSubscriptionsWithContext(ctx context.Context, filterID int) ([]FilterSubscription, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/filter/%d?expand=subscriptions", filterID)
+	req, err := fs.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	filter := new(struct {
+		Subscriptions struct {
+			Items []FilterSubscription `json:"items"`
+		} `json:"subscriptions"`
+	})
+	resp, err := fs.client.Do(req, filter)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return filter.Subscriptions.Items, resp, nil
+}
+
+// GetSubscriptions wraps GetSubscriptionsWithContext using the background context.
+func (fs *FilterService) GetSubscriptions(filterID int) ([]FilterSubscription, *Response, error) {
+	return fs.GetSubscriptionsWithContext(context.Background(), filterID)
+}
+
+// ChangeOwnerWithContext changes the owner of the filter to the user with the given account ID,
+// e.g. to keep the filter and its subscriptions working when the owner leaves.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-filters/#api-rest-api-2-filter-id-owner-put
+func (fs *FilterService) ChangeOwnerWithContext(ctx context.Context, filterID int, accountID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/filter/%d/owner", filterID)
+	body := struct {
+		AccountID string `json:"accountId"`
+	}{accountID}
+	req, err := fs.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := fs.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// ChangeOwner wraps ChangeOwnerWithContext using the background context.
+func (fs *FilterService) ChangeOwner(filterID int, accountID string) (*Response, error) {
+	return fs.ChangeOwnerWithContext(context.Background(), filterID, accountID)
+}
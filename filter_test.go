@@ -124,3 +124,140 @@ func TestFilterService_Search(t *testing.T) {
 		t.Errorf("Expected Filters, got nil")
 	}
 }
+
+func TestFilterService_GetColumns(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/filter/10000/columns"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `[{"label":"Key","value":"issuekey"},{"label":"Status","value":"status"}]`)
+	})
+
+	columns, _, err := testClient.Filter.GetColumns(10000)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(columns) != 2 || columns[1].Label != "Status" {
+		t.Errorf("Unexpected columns %+v", columns)
+	}
+}
+
+func TestFilterService_SetColumns(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/filter/10000/columns"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, testAPIEndpoint)
+		if got := r.Header.Get("Content-Type"); got != "application/x-www-form-urlencoded" {
+			t.Errorf("Unexpected Content-Type %s", got)
+		}
+		if err := r.ParseForm(); err != nil {
+			t.Error(err)
+		}
+		if got := r.PostForm["columns"]; len(got) != 2 || got[0] != "issuekey" || got[1] != "status" {
+			t.Errorf("Unexpected columns %v", got)
+		}
+	})
+
+	if _, err := testClient.Filter.SetColumns(10000, []string{"issuekey", "status"}); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestFilterService_ResetColumns(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/filter/10000/columns"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, testAPIEndpoint)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Filter.ResetColumns(10000); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestFilterService_GetDefaultShareScope(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/filter/defaultShareScope"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"scope":"GLOBAL"}`)
+	})
+
+	scope, _, err := testClient.Filter.GetDefaultShareScope()
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if scope == nil || scope.Scope != ShareScopeGlobal {
+		t.Errorf("Expected scope GLOBAL. Got %+v", scope)
+	}
+}
+
+func TestFilterService_SetDefaultShareScope(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/filter/defaultShareScope"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, testAPIEndpoint)
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != "{\"scope\":\"AUTHENTICATED\"}\n" {
+			t.Errorf("Unexpected body %q", body)
+		}
+		fmt.Fprint(w, `{"scope":"AUTHENTICATED"}`)
+	})
+
+	scope, _, err := testClient.Filter.SetDefaultShareScope(ShareScopeAuthenticated)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if scope == nil || scope.Scope != ShareScopeAuthenticated {
+		t.Errorf("Expected scope AUTHENTICATED. Got %+v", scope)
+	}
+}
+
+func TestFilterService_GetSubscriptions(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/filter/10000", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/filter/10000?expand=subscriptions")
+		fmt.Fprint(w, `{"id":"10000","name":"All Open Bugs","subscriptions":{"size":2,"items":[
+			{"id":1,"user":{"accountId":"5b10a2844c20165700ede21g","displayName":"Mia Krystof"}},
+			{"id":2,"group":{"name":"jira-administrators"}}],"max-results":1000,"start-index":0,"end-index":1}}`)
+	})
+
+	subscriptions, _, err := testClient.Filter.GetSubscriptions(10000)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(subscriptions) != 2 || subscriptions[0].User.AccountID != "5b10a2844c20165700ede21g" || subscriptions[1].Group.Name != "jira-administrators" {
+		t.Errorf("Unexpected subscriptions %+v", subscriptions)
+	}
+}
+
+func TestFilterService_ChangeOwner(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/filter/10000/owner", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, "/rest/api/2/filter/10000/owner")
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"accountId":"0000-0000-0000-0000"}`+"\n" {
+			t.Errorf("Unexpected body %s", body)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Filter.ChangeOwner(10000, "0000-0000-0000-0000"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
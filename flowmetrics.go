@@ -0,0 +1,144 @@
+package jira
+
+import (
+	"context"
+	"time"
+)
+
+// FlowMetricsOptions configures the computation of the flow metrics of GetFlowMetrics
+type FlowMetricsOptions struct {
+	// StatusCategories maps the status IDs (or names) to the keys of their status category,
+	// e.g. StatusCategoryInProgress. Statuses can be mapped to another category than in JIRA,
+	// e.g. "In Review" to StatusCategoryInProgress although it is in the "new" category of the workflow.
+	// If nil, the categories of all statuses are requested from JIRA.
+	StatusCategories map[string]string
+	// MaxResults is the number of issues requested per page of the search, 50 if 0
+	MaxResults int
+}
+
+// IssueFlowMetrics contains the flow metrics of a single issue
+type IssueFlowMetrics struct {
+	Key     string
+	Created time.Time
+	// Started is the first time the issue entered an in progress status, nil if it was never started
+	Started *time.Time
+	// Done is the last time the issue entered a done status, nil if the issue is not done
+	Done *time.Time
+	// TimeInStatus is the time the issue spent in each status, by status name.
+	// The time in the current status is counted until now, unless the issue is done.
+	TimeInStatus map[string]time.Duration
+	// CycleTime is the time from Started to Done, 0 if the issue is not started or not done
+	CycleTime time.Duration
+	// LeadTime is the time from Created to Done, 0 if the issue is not done
+	LeadTime time.Duration
+}
+
+// GetFlowMetricsWithContext computes the IssueFlowMetrics of all issues matching jql.
+// The issues are searched including their changelog, see ComputeFlowMetrics for details of the computation.
+func (s *IssueService) GetFlowMetricsWithContext(ctx context.Context, jql string, options *FlowMetricsOptions) ([]IssueFlowMetrics, error) {
+	if options == nil {
+		options = &FlowMetricsOptions{}
+	}
+
+	categories := options.StatusCategories
+	if categories == nil {
+		statuses, _, err := s.client.Status.GetAllStatusesWithContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		categories = map[string]string{}
+		for _, status := range statuses {
+			categories[status.ID] = status.StatusCategory.Key
+		}
+	}
+
+	metrics := []IssueFlowMetrics{}
+	search := &SearchOptions{
+		MaxResults: options.MaxResults,
+		Expand:     ExpandChangelog,
+		Fields:     []string{"status", "created"},
+	}
+	now := time.Now()
+	err := s.SearchPagesWithContext(ctx, jql, search, func(issue Issue) error {
+		metrics = append(metrics, ComputeFlowMetrics(&issue, categories, now))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return metrics, nil
+}
+
+// GetFlowMetrics wraps GetFlowMetricsWithContext using the background context.
+func (s *IssueService) GetFlowMetrics(jql string, options *FlowMetricsOptions) ([]IssueFlowMetrics, error) {
+	return s.GetFlowMetricsWithContext(context.Background(), jql, options)
+}
+
+// ComputeFlowMetrics computes the IssueFlowMetrics of issue at the time now.
+// The issue has to include its changelog (expand=changelog) and the status and created fields.
+// categories maps the status IDs (or names, if the ID is not mapped) to the keys of their status category.
+//
+// The status history is reconstructed from the changelog with FieldHistory.
+// An issue without status changes spent all its time in its current status.
+func ComputeFlowMetrics(issue *Issue, categories map[string]string, now time.Time) IssueFlowMetrics {
+	metrics := IssueFlowMetrics{Key: issue.Key, TimeInStatus: map[string]time.Duration{}}
+
+	var current *Status
+	if issue.Fields != nil {
+		metrics.Created = time.Time(issue.Fields.Created)
+		current = issue.Fields.Status
+	}
+	category := func(id, name string) string {
+		if c, ok := categories[id]; ok {
+			return c
+		}
+		return categories[name]
+	}
+
+	changes := FieldHistory(issue, "status")
+	since := metrics.Created
+	status := ""
+	if len(changes) > 0 {
+		status = changes[0].FromString
+		if category(changes[0].From, changes[0].FromString) == StatusCategoryInProgress {
+			metrics.Started = timePtr(metrics.Created)
+		}
+	} else if current != nil {
+		status = current.Name
+		if category(current.ID, current.Name) == StatusCategoryInProgress {
+			metrics.Started = timePtr(metrics.Created)
+		}
+	}
+
+	for _, change := range changes {
+		metrics.TimeInStatus[status] += change.At.Sub(since)
+		since, status = change.At, change.ToString
+
+		switch category(change.To, change.ToString) {
+		case StatusCategoryInProgress:
+			if metrics.Started == nil {
+				metrics.Started = timePtr(change.At)
+			}
+			metrics.Done = nil
+		case StatusCategoryComplete:
+			metrics.Done = timePtr(change.At)
+		default:
+			metrics.Done = nil
+		}
+	}
+
+	if metrics.Done == nil {
+		metrics.TimeInStatus[status] += now.Sub(since)
+		return metrics
+	}
+
+	metrics.LeadTime = metrics.Done.Sub(metrics.Created)
+	if metrics.Started != nil {
+		metrics.CycleTime = metrics.Done.Sub(*metrics.Started)
+	}
+	return metrics
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
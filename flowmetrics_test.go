@@ -0,0 +1,122 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+var flowMetricsCategories = map[string]string{
+	"1":         StatusCategoryToDo,
+	"3":         StatusCategoryInProgress,
+	"In Review": StatusCategoryInProgress,
+	"10001":     StatusCategoryComplete,
+}
+
+func flowMetricsIssue(status *Status, histories ...ChangelogHistory) *Issue {
+	return &Issue{
+		Key: "EX-1",
+		Fields: &IssueFields{
+			Created: Time(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)),
+			Status:  status,
+		},
+		Changelog: &Changelog{Histories: histories},
+	}
+}
+
+func flowMetricsChange(created, from, fromString, to, toString string) ChangelogHistory {
+	return ChangelogHistory{
+		Created: created,
+		Items:   []ChangelogItems{{Field: "status", From: from, FromString: fromString, To: to, ToString: toString}},
+	}
+}
+
+func TestComputeFlowMetrics_Done(t *testing.T) {
+	issue := flowMetricsIssue(&Status{ID: "10001", Name: "Done"},
+		flowMetricsChange("2020-01-02T00:00:00.000+0000", "1", "Open", "3", "In Progress"),
+		flowMetricsChange("2020-01-04T00:00:00.000+0000", "3", "In Progress", "4", "In Review"),
+		flowMetricsChange("2020-01-05T00:00:00.000+0000", "4", "In Review", "10001", "Done"),
+	)
+
+	metrics := ComputeFlowMetrics(issue, flowMetricsCategories, time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC))
+	day := 24 * time.Hour
+	if metrics.LeadTime != 4*day {
+		t.Errorf("Expected a lead time of 4 days, got %s", metrics.LeadTime)
+	}
+	if metrics.CycleTime != 3*day {
+		t.Errorf("Expected a cycle time of 3 days, got %s", metrics.CycleTime)
+	}
+	expected := map[string]time.Duration{"Open": day, "In Progress": 2 * day, "In Review": day}
+	if len(metrics.TimeInStatus) != len(expected) {
+		t.Errorf("Expected %v, got %v", expected, metrics.TimeInStatus)
+	}
+	for status, d := range expected {
+		if metrics.TimeInStatus[status] != d {
+			t.Errorf("Expected %s in %q, got %s", d, status, metrics.TimeInStatus[status])
+		}
+	}
+}
+
+func TestComputeFlowMetrics_Reopened(t *testing.T) {
+	issue := flowMetricsIssue(&Status{ID: "3", Name: "In Progress"},
+		flowMetricsChange("2020-01-02T00:00:00.000+0000", "1", "Open", "3", "In Progress"),
+		flowMetricsChange("2020-01-03T00:00:00.000+0000", "3", "In Progress", "10001", "Done"),
+		flowMetricsChange("2020-01-04T00:00:00.000+0000", "10001", "Done", "3", "In Progress"),
+	)
+
+	metrics := ComputeFlowMetrics(issue, flowMetricsCategories, time.Date(2020, 1, 6, 0, 0, 0, 0, time.UTC))
+	if metrics.Done != nil || metrics.LeadTime != 0 || metrics.CycleTime != 0 {
+		t.Errorf("Expected a reopened issue not to be done, got %+v", metrics)
+	}
+	if metrics.Started == nil || !metrics.Started.Equal(time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected the first start, got %v", metrics.Started)
+	}
+	if metrics.TimeInStatus["In Progress"] != 3*24*time.Hour {
+		t.Errorf("Expected 3 days in progress until now, got %s", metrics.TimeInStatus["In Progress"])
+	}
+}
+
+func TestComputeFlowMetrics_NoChanges(t *testing.T) {
+	issue := flowMetricsIssue(&Status{ID: "1", Name: "Open"})
+
+	metrics := ComputeFlowMetrics(issue, flowMetricsCategories, time.Date(2020, 1, 3, 0, 0, 0, 0, time.UTC))
+	if metrics.Started != nil || metrics.Done != nil {
+		t.Errorf("Expected an open issue, got %+v", metrics)
+	}
+	if metrics.TimeInStatus["Open"] != 48*time.Hour {
+		t.Errorf("Expected 2 days in Open, got %v", metrics.TimeInStatus)
+	}
+}
+
+func TestIssueService_GetFlowMetrics(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/status", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":"1","name":"Open","statusCategory":{"key":"new"}},
+			{"id":"3","name":"In Progress","statusCategory":{"key":"indeterminate"}},
+			{"id":"10001","name":"Done","statusCategory":{"key":"done"}}]`)
+	})
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/search?jql=project+%3D+EX&maxResults=50&expand=changelog&fields=status,created")
+		fmt.Fprint(w, `{"startAt":0,"maxResults":50,"total":1,"issues":[{"key":"EX-1",
+			"fields":{"created":"2020-01-01T00:00:00.000+0000","status":{"id":"10001","name":"Done"}},
+			"changelog":{"histories":[
+				{"created":"2020-01-02T00:00:00.000+0000","items":[{"field":"status","from":"1","fromString":"Open","to":"3","toString":"In Progress"}]},
+				{"created":"2020-01-03T12:00:00.000+0000","items":[{"field":"status","from":"3","fromString":"In Progress","to":"10001","toString":"Done"}]}
+			]}}]}`)
+	})
+
+	metrics, err := testClient.Issue.GetFlowMetrics("project = EX", nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(metrics) != 1 {
+		t.Fatalf("Expected the metrics of 1 issue, got %d", len(metrics))
+	}
+	if metrics[0].CycleTime != 36*time.Hour || metrics[0].LeadTime != 60*time.Hour {
+		t.Errorf("Unexpected metrics %+v", metrics[0])
+	}
+}
@@ -1,6 +1,7 @@
 package jira
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 )
@@ -21,8 +22,15 @@ type groupMembersResult struct {
 	Members    []GroupMember `json:"values"`
 }
 
+func (l *groupMembersResult) pageInfo() PageInfo {
+	return PageInfo{StartAt: l.StartAt, MaxResults: l.MaxResults, Total: l.Total}
+}
+
 // Group represents a JIRA group
 type Group struct {
+	Name                 string          `json:"name,omitempty"`
+	GroupID              string          `json:"groupId,omitempty"`
+	Self                 string          `json:"self,omitempty"`
 	ID                   string          `json:"id"`
 	Title                string          `json:"title"`
 	Type                 string          `json:"type"`
@@ -58,16 +66,16 @@ type GroupSearchOptions struct {
 	IncludeInactiveUsers bool
 }
 
-// Get returns a paginated list of users who are members of the specified group and its subgroups.
+// GetWithContext returns a paginated list of users who are members of the specified group and its subgroups.
 // Users in the page are ordered by user names.
 // User of this resource is required to have sysadmin or admin permissions.
 //
 // JIRA API docs: https://docs.atlassian.com/jira/REST/server/#api/2/group-getUsersFromGroup
 //
 // WARNING: This API only returns the first page of group members
-func (s *GroupService) Get(name string) ([]GroupMember, *Response, error) {
+func (s *GroupService) GetWithContext(ctx context.Context, name string) ([]GroupMember, *Response, error) {
 	apiEndpoint := fmt.Sprintf("/rest/api/2/group/member?groupname=%s", url.QueryEscape(name))
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -75,18 +83,23 @@ func (s *GroupService) Get(name string) ([]GroupMember, *Response, error) {
 	group := new(groupMembersResult)
 	resp, err := s.client.Do(req, group)
 	if err != nil {
-		return nil, resp, err
+		return nil, resp, NewJiraError(resp, err)
 	}
 
 	return group.Members, resp, nil
 }
 
-// GetWithOptions returns a paginated list of members of the specified group and its subgroups.
+// Get wraps GetWithContext using the background context.
+func (s *GroupService) Get(name string) ([]GroupMember, *Response, error) {
+	return s.GetWithContext(context.Background(), name)
+}
+
+// GetWithOptionsWithContext returns a paginated list of members of the specified group and its subgroups.
 // Users in the page are ordered by user names.
 // User of this resource is required to have sysadmin or admin permissions.
 //
 // JIRA API docs: https://docs.atlassian.com/jira/REST/server/#api/2/group-getUsersFromGroup
-func (s *GroupService) GetWithOptions(name string, options *GroupSearchOptions) ([]GroupMember, *Response, error) {
+func (s *GroupService) GetWithOptionsWithContext(ctx context.Context, name string, options *GroupSearchOptions) ([]GroupMember, *Response, error) {
 	var apiEndpoint string
 	if options == nil {
 		apiEndpoint = fmt.Sprintf("/rest/api/2/group/member?groupname=%s", url.QueryEscape(name))
@@ -99,7 +112,7 @@ func (s *GroupService) GetWithOptions(name string, options *GroupSearchOptions)
 			options.IncludeInactiveUsers,
 		)
 	}
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -107,21 +120,26 @@ func (s *GroupService) GetWithOptions(name string, options *GroupSearchOptions)
 	group := new(groupMembersResult)
 	resp, err := s.client.Do(req, group)
 	if err != nil {
-		return nil, resp, err
+		return nil, resp, NewJiraError(resp, err)
 	}
 	return group.Members, resp, nil
 }
 
-// Add adds user to group
+// GetWithOptions wraps GetWithOptionsWithContext using the background context.
+func (s *GroupService) GetWithOptions(name string, options *GroupSearchOptions) ([]GroupMember, *Response, error) {
+	return s.GetWithOptionsWithContext(context.Background(), name, options)
+}
+
+// AddWithContext adds user to group
 //
 // JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/group-addUserToGroup
-func (s *GroupService) Add(groupname string, username string) (*Group, *Response, error) {
-	apiEndpoint := fmt.Sprintf("/rest/api/2/group/user?groupname=%s", groupname)
+func (s *GroupService) AddWithContext(ctx context.Context, groupname string, username string) (*Group, *Response, error) {
+	apiEndpoint := fmt.Sprintf("/rest/api/2/group/user?groupname=%s", url.QueryEscape(groupname))
 	var user struct {
 		Name string `json:"name"`
 	}
 	user.Name = username
-	req, err := s.client.NewRequest("POST", apiEndpoint, &user)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, &user)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -136,12 +154,17 @@ func (s *GroupService) Add(groupname string, username string) (*Group, *Response
 	return responseGroup, resp, nil
 }
 
-// Remove removes user from group
+// Add wraps AddWithContext using the background context.
+func (s *GroupService) Add(groupname string, username string) (*Group, *Response, error) {
+	return s.AddWithContext(context.Background(), groupname, username)
+}
+
+// RemoveWithContext removes user from group
 //
 // JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/group-removeUserFromGroup
-func (s *GroupService) Remove(groupname string, username string) (*Response, error) {
-	apiEndpoint := fmt.Sprintf("/rest/api/2/group/user?groupname=%s&username=%s", groupname, username)
-	req, err := s.client.NewRequest("DELETE", apiEndpoint, nil)
+func (s *GroupService) RemoveWithContext(ctx context.Context, groupname string, username string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("/rest/api/2/group/user?groupname=%s&username=%s", url.QueryEscape(groupname), url.QueryEscape(username))
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -154,3 +177,143 @@ func (s *GroupService) Remove(groupname string, username string) (*Response, err
 
 	return resp, nil
 }
+
+// Remove wraps RemoveWithContext using the background context.
+func (s *GroupService) Remove(groupname string, username string) (*Response, error) {
+	return s.RemoveWithContext(context.Background(), groupname, username)
+}
+
+// GetMembersPagesWithContext calls f for every member of the group name and its subgroups, requesting all pages.
+// The paging starts at options.StartAt with options.MaxResults members per page (50 if 0).
+// The iteration stops at the first error returned by f.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/server/#api/2/group-getUsersFromGroup
+func (s *GroupService) GetMembersPagesWithContext(ctx context.Context, name string, options *GroupSearchOptions, f func(GroupMember) error) error {
+	opts := GroupSearchOptions{MaxResults: 50}
+	if options != nil {
+		opts = *options
+		if opts.MaxResults == 0 {
+			opts.MaxResults = 50
+		}
+	}
+
+	for {
+		members, resp, err := s.GetWithOptionsWithContext(ctx, name, &opts)
+		if err != nil {
+			return err
+		}
+		for _, member := range members {
+			if err := f(member); err != nil {
+				return err
+			}
+		}
+		if len(members) == 0 || !resp.HasNextPage() {
+			return nil
+		}
+		opts.StartAt = resp.NextStartAt()
+	}
+}
+
+// GetMembersPages wraps GetMembersPagesWithContext using the background context.
+func (s *GroupService) GetMembersPages(name string, options *GroupSearchOptions, f func(GroupMember) error) error {
+	return s.GetMembersPagesWithContext(context.Background(), name, options, f)
+}
+
+// AddByAccountIDWithContext adds the user with the given account ID to group. JIRA Cloud only.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-groups/#api-rest-api-2-group-user-post
+func (s *GroupService) AddByAccountIDWithContext(ctx context.Context, groupname, accountID string) (*Group, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/group/user?groupname=%s", url.QueryEscape(groupname))
+	user := struct {
+		AccountID string `json:"accountId"`
+	}{accountID}
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, &user)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	group := new(Group)
+	resp, err := s.client.Do(req, group)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return group, resp, nil
+}
+
+// AddByAccountID wraps AddByAccountIDWithContext using the background context.
+func (s *GroupService) AddByAccountID(groupname, accountID string) (*Group, *Response, error) {
+	return s.AddByAccountIDWithContext(context.Background(), groupname, accountID)
+}
+
+// RemoveByAccountIDWithContext removes the user with the given account ID from group. JIRA Cloud only.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-groups/#api-rest-api-2-group-user-delete
+func (s *GroupService) RemoveByAccountIDWithContext(ctx context.Context, groupname, accountID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/group/user?groupname=%s&accountId=%s", url.QueryEscape(groupname), url.QueryEscape(accountID))
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// RemoveByAccountID wraps RemoveByAccountIDWithContext using the background context.
+func (s *GroupService) RemoveByAccountID(groupname, accountID string) (*Response, error) {
+	return s.RemoveByAccountIDWithContext(context.Background(), groupname, accountID)
+}
+
+// CreateWithContext creates a group with the given name.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-groups/#api-rest-api-2-group-post
+func (s *GroupService) CreateWithContext(ctx context.Context, name string) (*Group, *Response, error) {
+	payload := struct {
+		Name string `json:"name"`
+	}{name}
+	req, err := s.client.NewRequestWithContext(ctx, "POST", "rest/api/2/group", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	group := new(Group)
+	resp, err := s.client.Do(req, group)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return group, resp, nil
+}
+
+// Create wraps CreateWithContext using the background context.
+func (s *GroupService) Create(name string) (*Group, *Response, error) {
+	return s.CreateWithContext(context.Background(), name)
+}
+
+// DeleteWithContext deletes the group with the given name.
+// If swapGroup is not empty, the restrictions of comments and worklogs to the deleted group are moved to swapGroup.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-groups/#api-rest-api-2-group-delete
+func (s *GroupService) DeleteWithContext(ctx context.Context, name, swapGroup string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/group?groupname=%s", url.QueryEscape(name))
+	if swapGroup != "" {
+		apiEndpoint += "&swapGroup=" + url.QueryEscape(swapGroup)
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// Delete wraps DeleteWithContext using the background context.
+func (s *GroupService) Delete(name, swapGroup string) (*Response, error) {
+	return s.DeleteWithContext(context.Background(), name, swapGroup)
+}
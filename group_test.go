@@ -2,6 +2,7 @@ package jira
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"testing"
 )
@@ -109,3 +110,103 @@ func TestGroupService_Remove(t *testing.T) {
 		t.Errorf("Error given: %s", err)
 	}
 }
+
+func TestGroupService_GetMembersPages(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/group/member", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.URL.Query().Get("startAt") {
+		case "0":
+			fmt.Fprint(w, `{"maxResults":2,"startAt":0,"total":3,"isLast":false,"values":[{"name":"michael"},{"name":"alex"}]}`)
+		case "2":
+			fmt.Fprint(w, `{"maxResults":2,"startAt":2,"total":3,"isLast":true,"values":[{"name":"sara"}]}`)
+		default:
+			t.Errorf("Unexpected startAt %s", r.URL.Query().Get("startAt"))
+		}
+	})
+
+	names := []string{}
+	err := testClient.Group.GetMembersPages("jira users", &GroupSearchOptions{MaxResults: 2}, func(member GroupMember) error {
+		names = append(names, member.Name)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(names) != 3 || names[2] != "sara" {
+		t.Errorf("Unexpected members %v", names)
+	}
+}
+
+func TestGroupService_AddByAccountID(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/group/user", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, "/rest/api/2/group/user?groupname=jira+users")
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"accountId":"5b10ac8d82e05b22cc7d4ef5"}`+"\n" {
+			t.Errorf("Unexpected body %s", body)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"name":"jira users","groupId":"276f955c-63d7-42c8-9520-92d01dca0625","self":"https://your-domain.atlassian.net/rest/api/2/group?groupId=276f955c-63d7-42c8-9520-92d01dca0625"}`)
+	})
+
+	group, _, err := testClient.Group.AddByAccountID("jira users", "5b10ac8d82e05b22cc7d4ef5")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if group.Name != "jira users" || group.GroupID == "" {
+		t.Errorf("Unexpected group %+v", group)
+	}
+}
+
+func TestGroupService_RemoveByAccountID(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/group/user", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, "/rest/api/2/group/user?groupname=jira+users&accountId=5b10ac8d82e05b22cc7d4ef5")
+	})
+
+	if _, err := testClient.Group.RemoveByAccountID("jira users", "5b10ac8d82e05b22cc7d4ef5"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestGroupService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/group", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, "/rest/api/2/group")
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"name":"reviewers"}`+"\n" {
+			t.Errorf("Unexpected body %s", body)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"name":"reviewers","self":"https://your-domain.atlassian.net/rest/api/2/group?groupname=reviewers"}`)
+	})
+
+	group, _, err := testClient.Group.Create("reviewers")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if group.Name != "reviewers" {
+		t.Errorf("Unexpected group %+v", group)
+	}
+}
+
+func TestGroupService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/group", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, "/rest/api/2/group?groupname=old+reviewers&swapGroup=reviewers")
+	})
+
+	if _, err := testClient.Group.Delete("old reviewers", "reviewers"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
@@ -0,0 +1,167 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// IssueTreeNode is a node of an issue hierarchy, e.g. an epic with its stories and their sub-tasks
+type IssueTreeNode struct {
+	Issue    Issue
+	Children []*IssueTreeNode
+}
+
+// Walk calls f for the node and all its descendants in depth-first order.
+// depth is 0 for the node Walk is called on. Walking stops at the first error returned by f.
+func (n *IssueTreeNode) Walk(f func(node *IssueTreeNode, depth int) error) error {
+	return n.walk(f, 0)
+}
+
+func (n *IssueTreeNode) walk(f func(node *IssueTreeNode, depth int) error, depth int) error {
+	if err := f(n, depth); err != nil {
+		return err
+	}
+	for _, child := range n.Children {
+		if err := child.walk(f, depth+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Keys returns the keys of the node and all its descendants in depth-first order
+func (n *IssueTreeNode) Keys() []string {
+	keys := []string{}
+	n.Walk(func(node *IssueTreeNode, depth int) error {
+		keys = append(keys, node.Issue.Key)
+		return nil
+	})
+	return keys
+}
+
+// IssueTreeOptions specifies the optional parameters to the IssueService.GetIssueTree method
+type IssueTreeOptions struct {
+	// Fields are the fields loaded for every issue of the tree.
+	// "issuetype" is always added, as it is needed to detect epics. Default: summary, status, issuetype, parent.
+	Fields []string
+	// MaxDepth limits the depth of the tree below the root issue. 0 means unlimited.
+	MaxDepth int
+}
+
+// GetIssueTreeWithContext loads the issue hierarchy below the given issue, e.g. an initiative with its epics,
+// their stories and the sub-tasks of the stories.
+// The children of an issue are the issues whose parent it is (JQL "parent = KEY").
+// For epics the issues of the epic are additionally loaded via the Agile API,
+// which covers instances that still link issues to epics by the "Epic Link" field.
+func (s *IssueService) GetIssueTreeWithContext(ctx context.Context, issueKey string, options *IssueTreeOptions) (*IssueTreeNode, error) {
+	if options == nil {
+		options = &IssueTreeOptions{}
+	}
+	fields := options.Fields
+	if len(fields) == 0 {
+		fields = []string{"summary", "status", "issuetype", "parent"}
+	}
+	hasIssueType := false
+	for _, f := range fields {
+		if f == "issuetype" || f == "*all" || f == "*navigable" {
+			hasIssueType = true
+		}
+	}
+	if !hasIssueType {
+		fields = append(fields, "issuetype")
+	}
+
+	issue, _, err := s.GetWithContext(ctx, issueKey, &GetQueryOptions{Fields: strings.Join(fields, ",")})
+	if err != nil {
+		return nil, err
+	}
+
+	root := &IssueTreeNode{Issue: *issue}
+	visited := map[string]bool{issue.Key: true}
+	if err := s.loadIssueTreeChildren(ctx, root, fields, options.MaxDepth, 1, visited); err != nil {
+		return nil, err
+	}
+	return root, nil
+}
+
+// GetIssueTree wraps GetIssueTreeWithContext using the background context.
+func (s *IssueService) GetIssueTree(issueKey string, options *IssueTreeOptions) (*IssueTreeNode, error) {
+	return s.GetIssueTreeWithContext(context.Background(), issueKey, options)
+}
+
+// loadIssueTreeChildren loads the children of node recursively
+func (s *IssueService) loadIssueTreeChildren(ctx context.Context, node *IssueTreeNode, fields []string, maxDepth, depth int, visited map[string]bool) error {
+	if maxDepth > 0 && depth > maxDepth {
+		return nil
+	}
+
+	children := []Issue{}
+	add := func(issue Issue) error {
+		if !visited[issue.Key] {
+			visited[issue.Key] = true
+			children = append(children, issue)
+		}
+		return nil
+	}
+
+	jql := fmt.Sprintf("parent = %s ORDER BY key ASC", quoteJQL(node.Issue.Key))
+	err := s.SearchPagesWithContext(ctx, jql, &SearchOptions{MaxResults: 100, Fields: fields}, add)
+	if err != nil {
+		return err
+	}
+
+	if isEpic(&node.Issue) {
+		if err := s.epicIssuesPages(ctx, node.Issue.Key, fields, add); err != nil {
+			return err
+		}
+	}
+
+	for _, child := range children {
+		childNode := &IssueTreeNode{Issue: child}
+		node.Children = append(node.Children, childNode)
+		if err := s.loadIssueTreeChildren(ctx, childNode, fields, maxDepth, depth+1, visited); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// epicIssuesPages calls f for every issue of the epic, as returned by the Agile API
+//
+// JIRA API docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/epic-getIssuesForEpic
+func (s *IssueService) epicIssuesPages(ctx context.Context, epicKey string, fields []string, f func(Issue) error) error {
+	startAt := 0
+	for {
+		apiEndpoint := fmt.Sprintf("rest/agile/1.0/epic/%s/issue?startAt=%d&maxResults=100&fields=%s", epicKey, startAt, strings.Join(fields, ","))
+		req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+		if err != nil {
+			return err
+		}
+
+		result := new(searchResult)
+		resp, err := s.client.Do(req, result)
+		if err != nil {
+			return NewJiraError(resp, err)
+		}
+
+		for _, issue := range result.Issues {
+			if err := f(issue); err != nil {
+				return err
+			}
+		}
+
+		startAt += len(result.Issues)
+		if len(result.Issues) == 0 || startAt >= result.Total {
+			return nil
+		}
+	}
+}
+
+// isEpic reports if the issue is an epic, by its hierarchy level or the name of its issue type
+func isEpic(issue *Issue) bool {
+	if issue.Fields == nil {
+		return false
+	}
+	return issue.Fields.Type.HierarchyLevel == IssueTypeHierarchyLevelEpic || issue.Fields.Type.Name == "Epic"
+}
@@ -0,0 +1,78 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestIssueService_GetIssueTree(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/issue/TEST-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/issue/TEST-1?fields=summary%2Cstatus%2Cissuetype%2Cparent")
+		fmt.Fprint(w, `{"key":"TEST-1","fields":{"summary":"Epic","issuetype":{"name":"Epic","hierarchyLevel":1}}}`)
+	})
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.URL.Query().Get("jql") {
+		case `parent = "TEST-1" ORDER BY key ASC`:
+			fmt.Fprint(w, `{"startAt":0,"maxResults":100,"total":1,"issues":[{"key":"TEST-2","fields":{"issuetype":{"name":"Story"}}}]}`)
+		case `parent = "TEST-2" ORDER BY key ASC`:
+			fmt.Fprint(w, `{"startAt":0,"maxResults":100,"total":2,"issues":[{"key":"TEST-4","fields":{"issuetype":{"name":"Sub-task","subtask":true,"hierarchyLevel":-1}}},{"key":"TEST-5","fields":{"issuetype":{"name":"Sub-task","subtask":true,"hierarchyLevel":-1}}}]}`)
+		default:
+			fmt.Fprint(w, `{"startAt":0,"maxResults":100,"total":0,"issues":[]}`)
+		}
+	})
+	testMux.HandleFunc("/rest/agile/1.0/epic/TEST-1/issue", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestParams(t, r, map[string]string{"startAt": "0", "maxResults": "100", "fields": "summary,status,issuetype,parent"})
+		// TEST-2 is returned by both, the search and the epic, and must only be added once
+		fmt.Fprint(w, `{"startAt":0,"maxResults":100,"total":2,"issues":[{"key":"TEST-2","fields":{"issuetype":{"name":"Story"}}},{"key":"TEST-3","fields":{"issuetype":{"name":"Story"}}}]}`)
+	})
+
+	tree, err := testClient.Issue.GetIssueTree("TEST-1", nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	if keys := tree.Keys(); !reflect.DeepEqual(keys, []string{"TEST-1", "TEST-2", "TEST-4", "TEST-5", "TEST-3"}) {
+		t.Errorf("Unexpected tree %v", keys)
+	}
+
+	depths := map[string]int{}
+	tree.Walk(func(node *IssueTreeNode, depth int) error {
+		depths[node.Issue.Key] = depth
+		return nil
+	})
+	if depths["TEST-1"] != 0 || depths["TEST-3"] != 1 || depths["TEST-5"] != 2 {
+		t.Errorf("Unexpected depths %v", depths)
+	}
+}
+
+func TestIssueService_GetIssueTree_MaxDepth(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/issue/TEST-2", func(w http.ResponseWriter, r *http.Request) {
+		testRequestURL(t, r, "/rest/api/2/issue/TEST-2?fields=summary%2Cissuetype")
+		fmt.Fprint(w, `{"key":"TEST-2","fields":{"issuetype":{"name":"Story"}}}`)
+	})
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		if jql := r.URL.Query().Get("jql"); jql != `parent = "TEST-2" ORDER BY key ASC` {
+			t.Errorf("Unexpected search %s", jql)
+		}
+		fmt.Fprint(w, `{"startAt":0,"maxResults":100,"total":1,"issues":[{"key":"TEST-4","fields":{"issuetype":{"name":"Sub-task"}}}]}`)
+	})
+
+	tree, err := testClient.Issue.GetIssueTree("TEST-2", &IssueTreeOptions{Fields: []string{"summary"}, MaxDepth: 1})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if keys := tree.Keys(); !reflect.DeepEqual(keys, []string{"TEST-2", "TEST-4"}) {
+		t.Errorf("Unexpected tree %v", keys)
+	}
+}
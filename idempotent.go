@@ -0,0 +1,83 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// ExternalIDPropertyKey is the key of the issue property, which CreateIdempotent stores the external ID in.
+// The value of the property is {"id": "<external ID>"}.
+const ExternalIDPropertyKey = "externalId"
+
+// CreateIdempotentWithContext creates an issue like CreateWithPayload, unless an issue with the same external ID exists already.
+// The external ID is supplied by the caller, e.g. the ID of the record in another system or of a job,
+// and is stored in the ExternalIDPropertyKey property of the created issue.
+// This prevents duplicate issues when a job is retried after a failure.
+//
+// The existing issue is searched with JQL, in the project of the payload if it is set.
+// Therefore the property has to be indexed for JQL as issue.property[externalId].id,
+// e.g. by the entity property index of an app. Issues created shortly before the call may not be indexed yet,
+// so concurrent calls with the same external ID are not protected against.
+//
+// The returned bool reports whether the issue was created. If not, the returned issue is the existing one.
+func (s *IssueService) CreateIdempotentWithContext(ctx context.Context, externalID string, payload *IssueCreatePayload) (*Issue, bool, error) {
+	if externalID == "" {
+		return nil, false, fmt.Errorf("external ID is required")
+	}
+	if payload == nil {
+		payload = &IssueCreatePayload{}
+	}
+
+	existing, err := s.findByExternalID(ctx, externalID, payload.Fields)
+	if err != nil {
+		return nil, false, err
+	}
+	if existing != nil {
+		return existing, false, nil
+	}
+
+	create := *payload
+	create.Properties = append(append([]EntityProperty{}, payload.Properties...), EntityProperty{
+		Key:   ExternalIDPropertyKey,
+		Value: map[string]string{"id": externalID},
+	})
+	issue, _, err := s.CreateWithPayloadWithContext(ctx, &create)
+	if err != nil {
+		return nil, false, err
+	}
+	return issue, true, nil
+}
+
+// CreateIdempotent wraps CreateIdempotentWithContext using the background context.
+func (s *IssueService) CreateIdempotent(externalID string, payload *IssueCreatePayload) (*Issue, bool, error) {
+	return s.CreateIdempotentWithContext(context.Background(), externalID, payload)
+}
+
+// findByExternalID returns the issue with the given external ID, or nil if there is none
+func (s *IssueService) findByExternalID(ctx context.Context, externalID string, fields *IssueFields) (*Issue, error) {
+	jql := fmt.Sprintf("issue.property[%s].id = %s", ExternalIDPropertyKey, quoteJQL(externalID))
+	if fields != nil {
+		if project := fields.Project.Key; project != "" {
+			jql = fmt.Sprintf("project = %s AND %s", quoteJQL(project), jql)
+		} else if project := fields.Project.ID; project != "" {
+			jql = fmt.Sprintf("project = %s AND %s", project, jql)
+		}
+	}
+
+	issues, _, err := s.searchPageWithContext(ctx, jql, &SearchOptions{MaxResults: 1, Fields: []string{"summary"}}, "")
+	if err != nil {
+		return nil, err
+	}
+	if len(issues) == 0 {
+		return nil, nil
+	}
+	return &issues[0], nil
+}
+
+// quoteJQL returns s as a quoted JQL string literal
+func quoteJQL(s string) string {
+	s = strings.Replace(s, `\`, `\\`, -1)
+	s = strings.Replace(s, `"`, `\"`, -1)
+	return `"` + s + `"`
+}
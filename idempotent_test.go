@@ -0,0 +1,93 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestIssueService_CreateIdempotent_Existing(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if jql := r.URL.Query().Get("jql"); jql != `project = "EX" AND issue.property[externalId].id = "job-\"42\""` {
+			t.Errorf("Unexpected JQL %s", jql)
+		}
+		fmt.Fprint(w, `{"startAt":0,"maxResults":1,"total":1,"issues":[{"id":"10002","key":"EX-1"}]}`)
+	})
+	testMux.HandleFunc("/rest/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected no issue to be created")
+	})
+
+	issue, created, err := testClient.Issue.CreateIdempotent(`job-"42"`, &IssueCreatePayload{
+		Fields: &IssueFields{Project: Project{Key: "EX"}, Summary: "Nightly job failed"},
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if created || issue.Key != "EX-1" {
+		t.Errorf("Expected existing issue EX-1, got %s (created: %t)", issue.Key, created)
+	}
+}
+
+func TestIssueService_CreateIdempotent_New(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"startAt":0,"maxResults":1,"total":0,"issues":[]}`)
+	})
+	testMux.HandleFunc("/rest/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		var payload IssueCreatePayload
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("Error decoding payload: %s", err)
+		}
+		if len(payload.Properties) != 1 || payload.Properties[0].Key != ExternalIDPropertyKey {
+			t.Fatalf("Expected external ID property, got %+v", payload.Properties)
+		}
+		if value := payload.Properties[0].Value.(map[string]interface{}); value["id"] != "job-42" {
+			t.Errorf("Unexpected property value %v", value)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"10003","key":"EX-2"}`)
+	})
+
+	issue, created, err := testClient.Issue.CreateIdempotent("job-42", &IssueCreatePayload{
+		Fields: &IssueFields{Project: Project{ID: "10000"}, Summary: "Nightly job failed"},
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if !created || issue.Key != "EX-2" {
+		t.Errorf("Expected created issue EX-2, got %s (created: %t)", issue.Key, created)
+	}
+}
+
+func TestIssueService_CreateIdempotent_ValidationError(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"startAt":0,"maxResults":1,"total":0,"issues":[]}`)
+	})
+	testMux.HandleFunc("/rest/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"errorMessages":[],"errors":{"summary":"You must specify a summary of the issue."}}`)
+	})
+
+	_, created, err := testClient.Issue.CreateIdempotent("job-42", &IssueCreatePayload{
+		Fields: &IssueFields{Project: Project{ID: "10000"}},
+	})
+	if err == nil || created {
+		t.Fatalf("Expected an error and no created issue, got %v (created: %t)", err, created)
+	}
+	jerr, ok := AsError(err)
+	if !ok {
+		t.Fatalf("Expected a *jira.Error, got %T: %s", err, err)
+	}
+	if !jerr.IsValidationError() || jerr.Errors["summary"] == "" {
+		t.Errorf("Expected the validation error of the summary, got %+v", jerr)
+	}
+}
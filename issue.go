@@ -2,6 +2,7 @@ package jira
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -10,6 +11,7 @@ import (
 	"net/http"
 	"net/url"
 	"reflect"
+	"sort"
 	"strings"
 	"time"
 
@@ -61,10 +63,11 @@ type ChangelogItems struct {
 
 // ChangelogHistory reflects one single changelog history entry
 type ChangelogHistory struct {
-	Id      string           `json:"id" structs:"id"`
-	Author  User             `json:"author" structs:"author"`
-	Created string           `json:"created" structs:"created"`
-	Items   []ChangelogItems `json:"items" structs:"items"`
+	Id              string           `json:"id" structs:"id"`
+	Author          User             `json:"author" structs:"author"`
+	Created         string           `json:"created" structs:"created"`
+	Items           []ChangelogItems `json:"items" structs:"items"`
+	HistoryMetadata *HistoryMetadata `json:"historyMetadata,omitempty" structs:"historyMetadata,omitempty"`
 }
 
 // Changelog reflects the change log of an issue
@@ -112,6 +115,7 @@ type IssueFields struct {
 	Created                       Time              `json:"created,omitempty" structs:"created,omitempty"`
 	Duedate                       Date              `json:"duedate,omitempty" structs:"duedate,omitempty"`
 	Watches                       *Watches          `json:"watches,omitempty" structs:"watches,omitempty"`
+	Votes                         *Votes            `json:"votes,omitempty" structs:"votes,omitempty"`
 	Assignee                      *User             `json:"assignee,omitempty" structs:"assignee,omitempty"`
 	Updated                       Time              `json:"updated,omitempty" structs:"updated,omitempty"`
 	Description                   string            `json:"description,omitempty" structs:"description,omitempty"`
@@ -209,6 +213,7 @@ func (i *IssueFields) UnmarshalJSON(data []byte) error {
 
 // IssueRenderedFields represents rendered fields of a JIRA issue.
 // Not all IssueFields are rendered.
+// The rendered values of custom fields are kept in Unknowns, see CustomField.
 type IssueRenderedFields struct {
 	// TODO Missing fields
 	//      * "aggregatetimespent": null,
@@ -216,13 +221,68 @@ type IssueRenderedFields struct {
 	//      * "lastViewed": null,
 	//      * "aggregatetimeoriginalestimate": null,
 	//      * "aggregatetimeestimate": null,
-	//      * "environment": null,
-	Resolutiondate string    `json:"resolutiondate,omitempty" structs:"resolutiondate,omitempty"`
-	Created        string    `json:"created,omitempty" structs:"created,omitempty"`
-	Duedate        string    `json:"duedate,omitempty" structs:"duedate,omitempty"`
-	Updated        string    `json:"updated,omitempty" structs:"updated,omitempty"`
-	Comments       *Comments `json:"comment,omitempty" structs:"comment,omitempty"`
-	Description    string    `json:"description,omitempty" structs:"description,omitempty"`
+	Resolutiondate string        `json:"resolutiondate,omitempty" structs:"resolutiondate,omitempty"`
+	Created        string        `json:"created,omitempty" structs:"created,omitempty"`
+	Duedate        string        `json:"duedate,omitempty" structs:"duedate,omitempty"`
+	Updated        string        `json:"updated,omitempty" structs:"updated,omitempty"`
+	Comments       *Comments     `json:"comment,omitempty" structs:"comment,omitempty"`
+	Description    string        `json:"description,omitempty" structs:"description,omitempty"`
+	Environment    string        `json:"environment,omitempty" structs:"environment,omitempty"`
+	Worklog        *Worklog      `json:"worklog,omitempty" structs:"worklog,omitempty"`
+	TimeTracking   *TimeTracking `json:"timetracking,omitempty" structs:"timetracking,omitempty"`
+	Unknowns       tcontainer.MarshalMap
+}
+
+// UnmarshalJSON is a custom JSON unmarshal function for the IssueRenderedFields structs.
+// It maps the rendered custom fields to the "Unknowns" key.
+func (i *IssueRenderedFields) UnmarshalJSON(data []byte) error {
+	type Alias IssueRenderedFields
+	aux := (*Alias)(i)
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	totalMap := tcontainer.NewMarshalMap()
+	if err := json.Unmarshal(data, &totalMap); err != nil {
+		return err
+	}
+
+	t := reflect.TypeOf(*i)
+	for n := 0; n < t.NumField(); n++ {
+		tagDetail := t.Field(n).Tag.Get("json")
+		if tagDetail == "" {
+			continue
+		}
+		delete(totalMap, strings.Split(tagDetail, ",")[0])
+	}
+	i.Unknowns = totalMap
+	return nil
+}
+
+// MarshalJSON is a custom JSON marshal function for the IssueRenderedFields structs.
+// It shifts the rendered custom fields in "Unknowns" a level up.
+func (i *IssueRenderedFields) MarshalJSON() ([]byte, error) {
+	type Alias IssueRenderedFields
+	m := map[string]interface{}{}
+	known, err := json.Marshal((*Alias)(i))
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(known, &m); err != nil {
+		return nil, err
+	}
+	delete(m, "Unknowns")
+	for key, value := range i.Unknowns {
+		m[key] = value
+	}
+	return json.Marshal(m)
+}
+
+// CustomField returns the rendered HTML of the custom field with the given ID, e.g. "customfield_10001".
+// It returns false if the custom field was not rendered, e.g. because it is empty or not a text field.
+func (i *IssueRenderedFields) CustomField(id string) (string, bool) {
+	value, ok := i.Unknowns[id].(string)
+	return value, ok
 }
 
 // IssueType represents a type of a JIRA issue.
@@ -235,6 +295,8 @@ type IssueType struct {
 	Name        string `json:"name,omitempty" structs:"name,omitempty"`
 	Subtask     bool   `json:"subtask,omitempty" structs:"subtask,omitempty"`
 	AvatarID    int    `json:"avatarId,omitempty" structs:"avatarId,omitempty"`
+	// HierarchyLevel is the level of the issue type in the issue type hierarchy, e.g. -1 for sub-tasks, 0 for stories and 1 for epics
+	HierarchyLevel int `json:"hierarchyLevel,omitempty" structs:"hierarchyLevel,omitempty"`
 }
 
 // Watches represents a type of how many and which user are "observing" a JIRA issue to track the status / updates.
@@ -247,10 +309,37 @@ type Watches struct {
 
 // Watcher represents a simplified user that "observes" the issue
 type Watcher struct {
-	Self        string `json:"self,omitempty" structs:"self,omitempty"`
-	Name        string `json:"name,omitempty" structs:"name,omitempty"`
-	DisplayName string `json:"displayName,omitempty" structs:"displayName,omitempty"`
-	Active      bool   `json:"active,omitempty" structs:"active,omitempty"`
+	Self        string     `json:"self,omitempty" structs:"self,omitempty"`
+	Name        string     `json:"name,omitempty" structs:"name,omitempty"`
+	Key         string     `json:"key,omitempty" structs:"key,omitempty"`
+	AccountID   string     `json:"accountId,omitempty" structs:"accountId,omitempty"`
+	AccountType string     `json:"accountType,omitempty" structs:"accountType,omitempty"`
+	DisplayName string     `json:"displayName,omitempty" structs:"displayName,omitempty"`
+	AvatarUrls  AvatarUrls `json:"avatarUrls,omitempty" structs:"avatarUrls,omitempty"`
+	Active      bool       `json:"active,omitempty" structs:"active,omitempty"`
+}
+
+// User converts the watcher into a User with the details JIRA returned for the watcher.
+func (w *Watcher) User() *User {
+	return &User{
+		Self:        w.Self,
+		Name:        w.Name,
+		Key:         w.Key,
+		AccountID:   w.AccountID,
+		AccountType: w.AccountType,
+		DisplayName: w.DisplayName,
+		AvatarUrls:  w.AvatarUrls,
+		Active:      w.Active,
+	}
+}
+
+// Votes represents the votes of a JIRA issue.
+// Voters is only populated if the user has the permission to view voters of the issue.
+type Votes struct {
+	Self     string  `json:"self,omitempty" structs:"self,omitempty"`
+	Votes    int     `json:"votes,omitempty" structs:"votes,omitempty"`
+	HasVoted bool    `json:"hasVoted,omitempty" structs:"hasVoted,omitempty"`
+	Voters   []*User `json:"voters,omitempty" structs:"voters,omitempty"`
 }
 
 // AvatarUrls represents different dimensions of avatars / images
@@ -299,21 +388,87 @@ type transitionResult struct {
 
 // Transition represents an issue transition in JIRA
 type Transition struct {
-	ID     string                     `json:"id" structs:"id"`
-	Name   string                     `json:"name" structs:"name"`
-	To     Status                     `json:"to" structs:"status"`
-	Fields map[string]TransitionField `json:"fields" structs:"fields"`
+	ID            string                     `json:"id" structs:"id"`
+	Name          string                     `json:"name" structs:"name"`
+	To            Status                     `json:"to" structs:"status"`
+	HasScreen     bool                       `json:"hasScreen,omitempty" structs:"hasScreen,omitempty"`
+	IsGlobal      bool                       `json:"isGlobal,omitempty" structs:"isGlobal,omitempty"`
+	IsInitial     bool                       `json:"isInitial,omitempty" structs:"isInitial,omitempty"`
+	IsConditional bool                       `json:"isConditional,omitempty" structs:"isConditional,omitempty"`
+	IsAvailable   bool                       `json:"isAvailable,omitempty" structs:"isAvailable,omitempty"`
+	Fields        map[string]TransitionField `json:"fields" structs:"fields"`
+}
+
+// RequiredFields returns the sorted IDs of the fields which have to be set when performing the transition
+func (t Transition) RequiredFields() []string {
+	fields := []string{}
+	for id, field := range t.Fields {
+		if field.Required {
+			fields = append(fields, id)
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// SettableFields returns the sorted IDs of the fields which can be set when performing the transition
+func (t Transition) SettableFields() []string {
+	fields := []string{}
+	for id, field := range t.Fields {
+		for _, operation := range field.Operations {
+			if operation == "set" {
+				fields = append(fields, id)
+				break
+			}
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// TransitionsWithField returns the transitions which have the field with the given ID on their screen,
+// e.g. "resolution" for the transitions which resolve the issue.
+func TransitionsWithField(transitions []Transition, fieldID string) []Transition {
+	result := []Transition{}
+	for _, transition := range transitions {
+		if _, ok := transition.Fields[fieldID]; ok {
+			result = append(result, transition)
+		}
+	}
+	return result
+}
+
+// GetTransitionsOptions specifies the optional parameters for the GetTransitionsWithOptions method
+type GetTransitionsOptions struct {
+	// TransitionID restricts the result to the transition with this ID
+	TransitionID string `url:"transitionId,omitempty"`
+	// SkipRemoteOnlyCondition ignores the conditions of apps which can only be evaluated remotely
+	SkipRemoteOnlyCondition bool `url:"skipRemoteOnlyCondition,omitempty"`
+	// IncludeUnavailableTransitions includes transitions whose conditions are not met.
+	// Transition.IsAvailable is only set with this option.
+	IncludeUnavailableTransitions bool `url:"includeUnavailableTransitions,omitempty"`
+	// SortByOpsBarAndStatus sorts the transitions as the issue view does
+	SortByOpsBarAndStatus bool `url:"sortByOpsBarAndStatus,omitempty"`
 }
 
-// TransitionField represents the value of one Transition
+// TransitionField describes a field of the screen of a Transition
 type TransitionField struct {
-	Required bool `json:"required" structs:"required"`
+	Required        bool          `json:"required" structs:"required"`
+	Name            string        `json:"name,omitempty" structs:"name,omitempty"`
+	Key             string        `json:"key,omitempty" structs:"key,omitempty"`
+	Schema          FieldSchema   `json:"schema,omitempty" structs:"schema,omitempty"`
+	HasDefaultValue bool          `json:"hasDefaultValue,omitempty" structs:"hasDefaultValue,omitempty"`
+	Operations      []string      `json:"operations,omitempty" structs:"operations,omitempty"`
+	AllowedValues   []interface{} `json:"allowedValues,omitempty" structs:"allowedValues,omitempty"`
+	AutoCompleteURL string        `json:"autoCompleteUrl,omitempty" structs:"autoCompleteUrl,omitempty"`
 }
 
 // CreateTransitionPayload is used for creating new issue transitions
 type CreateTransitionPayload struct {
-	Transition TransitionPayload       `json:"transition" structs:"transition"`
-	Fields     TransitionPayloadFields `json:"fields" structs:"fields"`
+	Transition      TransitionPayload           `json:"transition" structs:"transition"`
+	Fields          TransitionPayloadFields     `json:"fields" structs:"fields"`
+	Update          map[string][]FieldOperation `json:"update,omitempty" structs:"update,omitempty"`
+	HistoryMetadata *HistoryMetadata            `json:"historyMetadata,omitempty" structs:"historyMetadata,omitempty"`
 }
 
 // TransitionPayload represents the request payload of Transition calls like DoTransition
@@ -326,6 +481,67 @@ type TransitionPayloadFields struct {
 	Resolution *Resolution `json:"resolution,omitempty" structs:"resolution,omitempty"`
 }
 
+// These constants are the operations of the update section of the issue create, edit and transition payloads
+const (
+	FieldOperationAdd    = "add"
+	FieldOperationSet    = "set"
+	FieldOperationRemove = "remove"
+	FieldOperationEdit   = "edit"
+	FieldOperationCopy   = "copy"
+)
+
+// FieldOperation represents one operation of the update section of an issue payload,
+// like {"add": "label"}. The key is one of the FieldOperation* constants.
+type FieldOperation map[string]interface{}
+
+// IssueCreatePayload represents the complete request payload of IssueService.CreateWithPayload.
+// Next to the fields, it supports field operations in Update (e.g. adding issue links or labels),
+// a Transition to move the created issue into a non-initial status
+// and the HistoryMetadata recorded with the creation.
+type IssueCreatePayload struct {
+	Fields          *IssueFields                `json:"fields,omitempty" structs:"fields,omitempty"`
+	Update          map[string][]FieldOperation `json:"update,omitempty" structs:"update,omitempty"`
+	Transition      *TransitionPayload          `json:"transition,omitempty" structs:"transition,omitempty"`
+	HistoryMetadata *HistoryMetadata            `json:"historyMetadata,omitempty" structs:"historyMetadata,omitempty"`
+	Properties      []EntityProperty            `json:"properties,omitempty" structs:"properties,omitempty"`
+}
+
+// IssueUpdatePayload represents the complete request payload of IssueService.UpdateWithPayload.
+// Fields are set to the given values, Update contains field operations
+// and HistoryMetadata describes the source of the change in the issue history.
+type IssueUpdatePayload struct {
+	Fields          *IssueFields                `json:"fields,omitempty" structs:"fields,omitempty"`
+	Update          map[string][]FieldOperation `json:"update,omitempty" structs:"update,omitempty"`
+	HistoryMetadata *HistoryMetadata            `json:"historyMetadata,omitempty" structs:"historyMetadata,omitempty"`
+	Properties      []EntityProperty            `json:"properties,omitempty" structs:"properties,omitempty"`
+}
+
+// HistoryMetadataParticipant represents the actor or the cause of a change in the issue history
+type HistoryMetadataParticipant struct {
+	ID             string `json:"id,omitempty" structs:"id,omitempty"`
+	DisplayName    string `json:"displayName,omitempty" structs:"displayName,omitempty"`
+	DisplayNameKey string `json:"displayNameKey,omitempty" structs:"displayNameKey,omitempty"`
+	Type           string `json:"type,omitempty" structs:"type,omitempty"`
+	AvatarURL      string `json:"avatarUrl,omitempty" structs:"avatarUrl,omitempty"`
+	URL            string `json:"url,omitempty" structs:"url,omitempty"`
+}
+
+// HistoryMetadata describes the source of a change in the issue history,
+// e.g. to attribute changes made by an external system.
+type HistoryMetadata struct {
+	Type                   string                      `json:"type,omitempty" structs:"type,omitempty"`
+	Description            string                      `json:"description,omitempty" structs:"description,omitempty"`
+	DescriptionKey         string                      `json:"descriptionKey,omitempty" structs:"descriptionKey,omitempty"`
+	ActivityDescription    string                      `json:"activityDescription,omitempty" structs:"activityDescription,omitempty"`
+	ActivityDescriptionKey string                      `json:"activityDescriptionKey,omitempty" structs:"activityDescriptionKey,omitempty"`
+	EmailDescription       string                      `json:"emailDescription,omitempty" structs:"emailDescription,omitempty"`
+	EmailDescriptionKey    string                      `json:"emailDescriptionKey,omitempty" structs:"emailDescriptionKey,omitempty"`
+	Actor                  *HistoryMetadataParticipant `json:"actor,omitempty" structs:"actor,omitempty"`
+	Generator              *HistoryMetadataParticipant `json:"generator,omitempty" structs:"generator,omitempty"`
+	Cause                  *HistoryMetadataParticipant `json:"cause,omitempty" structs:"cause,omitempty"`
+	ExtraData              map[string]string           `json:"extraData,omitempty" structs:"extraData,omitempty"`
+}
+
 // Option represents an option value in a SelectList or MultiSelect
 // custom issue field
 type Option struct {
@@ -386,6 +602,10 @@ type Worklog struct {
 	Worklogs   []WorklogRecord `json:"worklogs" structs:"worklogs"`
 }
 
+func (l *Worklog) pageInfo() PageInfo {
+	return PageInfo{StartAt: l.StartAt, MaxResults: l.MaxResults, Total: l.Total}
+}
+
 // WorklogRecord represents one entry of a Worklog
 type WorklogRecord struct {
 	Self             string           `json:"self,omitempty" structs:"self,omitempty"`
@@ -487,6 +707,36 @@ type CommentVisibility struct {
 	Value string `json:"value,omitempty" structs:"value,omitempty"`
 }
 
+// These constants are the types of a CommentVisibility.
+// The Value of the visibility is the name of the role or group, which is allowed to see the comment.
+const (
+	CommentVisibilityRole  = "role"
+	CommentVisibilityGroup = "group"
+)
+
+// GetCommentsOptions specifies the optional parameters to the GetComments method
+type GetCommentsOptions struct {
+	StartAt int `url:"startAt,omitempty"`
+	// MaxResults is the maximum number of comments per page. Default: 50.
+	MaxResults int `url:"maxResults,omitempty"`
+	// OrderBy orders the comments by the created date, "created" or "-created" for descending order
+	OrderBy string `url:"orderBy,omitempty"`
+	// Expand: Use "renderedBody" to include the body rendered in HTML
+	Expand string `url:"expand,omitempty"`
+}
+
+// commentsPage is a page of the comments of an issue
+type commentsPage struct {
+	StartAt    int        `json:"startAt"`
+	MaxResults int        `json:"maxResults"`
+	Total      int        `json:"total"`
+	Comments   []*Comment `json:"comments"`
+}
+
+func (l *commentsPage) pageInfo() PageInfo {
+	return PageInfo{StartAt: l.StartAt, MaxResults: l.MaxResults, Total: l.Total}
+}
+
 // SearchOptions specifies the optional parameters to various List methods that
 // support pagination.
 // Pagination is used for the JIRA REST APIs to conserve server resources and limit
@@ -514,6 +764,67 @@ type searchResult struct {
 	Total      int     `json:"total" structs:"total"`
 }
 
+func (l *searchResult) pageInfo() PageInfo {
+	return PageInfo{StartAt: l.StartAt, MaxResults: l.MaxResults, Total: l.Total}
+}
+
+// SearchJQLOptions specifies the optional parameters to the IssueService.SearchJQL method.
+// In contrast to SearchOptions the pages are addressed by NextPageToken instead of StartAt.
+type SearchJQLOptions struct {
+	// NextPageToken: The token of the page to fetch, as returned in Response.NextPageToken. Empty for the first page.
+	NextPageToken string `url:"nextPageToken,omitempty"`
+	// MaxResults: The maximum number of issues to return per page. Default: 50.
+	MaxResults int `url:"maxResults,omitempty"`
+	// Fields: The list of fields to return for each issue. By default, only the issue ID is returned.
+	Fields []string `url:"fields,comma,omitempty"`
+	// Expand: Expand specific sections in the returned issues
+	Expand string `url:"expand,omitempty"`
+	// Properties: The list of issue properties to return for each issue
+	Properties []string `url:"properties,comma,omitempty"`
+	// FieldsByKeys: Reference fields by their key (rather than ID)
+	FieldsByKeys bool `url:"fieldsByKeys,omitempty"`
+	// ReconcileIssues: Up to 50 issue IDs to reconcile with the search results for read-after-write consistency
+	ReconcileIssues []int `url:"reconcileIssues,comma,omitempty"`
+}
+
+// searchJQLResult is only a small wrapper around the SearchJQL method
+// to be able to parse the results
+type searchJQLResult struct {
+	Issues        []Issue `json:"issues" structs:"issues"`
+	NextPageToken string  `json:"nextPageToken" structs:"nextPageToken"`
+	IsLast        bool    `json:"isLast" structs:"isLast"`
+}
+
+func (l *searchJQLResult) pageInfo() PageInfo {
+	return PageInfo{NextPageToken: l.NextPageToken, IsLast: l.IsLast}
+}
+
+// BulkFetchMaxIssues is the maximum number of issues which can be fetched by a single IssueService.BulkFetch call
+const BulkFetchMaxIssues = 100
+
+// BulkFetchOptions specifies the parameters to the IssueService.BulkFetch method
+type BulkFetchOptions struct {
+	// IssueIDsOrKeys are the IDs or keys of the issues to fetch, at most BulkFetchMaxIssues
+	IssueIDsOrKeys []string `json:"issueIdsOrKeys" structs:"issueIdsOrKeys"`
+	// Fields is the list of fields to return for each issue. By default, all navigable fields are returned.
+	Fields       []string `json:"fields,omitempty" structs:"fields,omitempty"`
+	Expand       []string `json:"expand,omitempty" structs:"expand,omitempty"`
+	Properties   []string `json:"properties,omitempty" structs:"properties,omitempty"`
+	FieldsByKeys bool     `json:"fieldsByKeys,omitempty" structs:"fieldsByKeys,omitempty"`
+}
+
+// BulkFetchIssueError represents an issue which could not be fetched by IssueService.BulkFetch
+type BulkFetchIssueError struct {
+	ID           string `json:"id" structs:"id"`
+	ErrorMessage string `json:"errorMessage" structs:"errorMessage"`
+}
+
+// BulkFetchResult is the result of IssueService.BulkFetch
+type BulkFetchResult struct {
+	Issues      []Issue               `json:"issues" structs:"issues"`
+	IssueErrors []BulkFetchIssueError `json:"issueErrors" structs:"issueErrors"`
+}
+
 // GetQueryOptions specifies the optional parameters for the Get Issue methods
 type GetQueryOptions struct {
 	// Fields is the list of fields to return for the issue. By default, all fields are returned.
@@ -534,6 +845,8 @@ type GetWorklogsQueryOptions struct {
 	Expand     string `url:"expand,omitempty"`
 }
 
+// AddWorklogQueryOptions specifies the optional parameters for the Add Worklog and Update Worklog methods.
+// Pass them with WithQueryOptions.
 type AddWorklogQueryOptions struct {
 	NotifyUsers          bool   `url:"notifyUsers,omitempty"`
 	AdjustEstimate       string `url:"adjustEstimate,omitempty"`
@@ -543,6 +856,26 @@ type AddWorklogQueryOptions struct {
 	OverrideEditableFlag bool   `url:"overrideEditableFlag,omitempty"`
 }
 
+// DeleteWorklogQueryOptions specifies the optional parameters for the Delete Worklog method.
+// Pass them with WithQueryOptions.
+type DeleteWorklogQueryOptions struct {
+	NotifyUsers          bool   `url:"notifyUsers,omitempty"`
+	AdjustEstimate       string `url:"adjustEstimate,omitempty"`
+	NewEstimate          string `url:"newEstimate,omitempty"`
+	IncreaseBy           string `url:"increaseBy,omitempty"`
+	OverrideEditableFlag bool   `url:"overrideEditableFlag,omitempty"`
+}
+
+// These constants are the values of the adjustEstimate parameter of the worklog methods.
+// AdjustEstimateNew needs the NewEstimate, AdjustEstimateManual the ReduceBy (IncreaseBy on delete) of the options.
+// AdjustEstimateManual is not supported by the Update Worklog method.
+const (
+	AdjustEstimateNew    = "new"
+	AdjustEstimateLeave  = "leave"
+	AdjustEstimateManual = "manual"
+	AdjustEstimateAuto   = "auto"
+)
+
 // CustomFields represents custom fields of JIRA
 // This can heavily differ between JIRA instances
 type CustomFields map[string]string
@@ -581,11 +914,11 @@ type RemoteLinkIcon struct {
 
 // RemoteLinkStatus if the link is a resolvable object (issue, epic) - the structure represent its status
 type RemoteLinkStatus struct {
-	Resolved bool
-	Icon     *RemoteLinkIcon
+	Resolved bool            `json:"resolved" structs:"resolved"`
+	Icon     *RemoteLinkIcon `json:"icon,omitempty" structs:"icon,omitempty"`
 }
 
-// Get returns a full representation of the issue for the given issue key.
+// GetWithContext returns a full representation of the issue for the given issue key.
 // JIRA will attempt to identify the issue by the issueIdOrKey path parameter.
 // This can be an issue id, or an issue key.
 // If the issue cannot be found via an exact match, JIRA will also look for the issue in a case-insensitive way, or by looking to see if the issue was moved.
@@ -593,9 +926,9 @@ type RemoteLinkStatus struct {
 // The given options will be appended to the query string
 //
 // JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue-getIssue
-func (s *IssueService) Get(issueID string, options *GetQueryOptions) (*Issue, *Response, error) {
+func (s *IssueService) GetWithContext(ctx context.Context, issueID string, options *GetQueryOptions) (*Issue, *Response, error) {
 	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s", issueID)
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -618,13 +951,51 @@ func (s *IssueService) Get(issueID string, options *GetQueryOptions) (*Issue, *R
 	return issue, resp, nil
 }
 
-// DownloadAttachment returns a Response of an attachment for a given attachmentID.
+// Get wraps GetWithContext using the background context.
+func (s *IssueService) Get(issueID string, options *GetQueryOptions) (*Issue, *Response, error) {
+	return s.GetWithContext(context.Background(), issueID, options)
+}
+
+// BulkFetchWithContext returns the issues for the given issue IDs or keys in a single request.
+// Issues which can not be found or viewed are reported in BulkFetchResult.IssueErrors.
+// At most BulkFetchMaxIssues issues can be fetched at once.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issues/#api-rest-api-2-issue-bulkfetch-post
+func (s *IssueService) BulkFetchWithContext(ctx context.Context, options *BulkFetchOptions) (*BulkFetchResult, *Response, error) {
+	if options == nil {
+		return nil, nil, fmt.Errorf("the issues to fetch are required")
+	}
+	if len(options.IssueIDsOrKeys) > BulkFetchMaxIssues {
+		return nil, nil, fmt.Errorf("can not fetch %d issues at once, the maximum is %d", len(options.IssueIDsOrKeys), BulkFetchMaxIssues)
+	}
+
+	apiEndpoint := "rest/api/2/issue/bulkfetch"
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(BulkFetchResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return result, resp, nil
+}
+
+// BulkFetch wraps BulkFetchWithContext using the background context.
+func (s *IssueService) BulkFetch(options *BulkFetchOptions) (*BulkFetchResult, *Response, error) {
+	return s.BulkFetchWithContext(context.Background(), options)
+}
+
+// DownloadAttachmentWithContext returns a Response of an attachment for a given attachmentID.
 // The attachment is in the Response.Body of the response.
-// This is an io.ReadCloser.
+// This is an io.ReadCloser, the content is streamed from JIRA while it is read and not buffered in memory.
 // The caller should close the resp.Body.
-func (s *IssueService) DownloadAttachment(attachmentID string) (*Response, error) {
+func (s *IssueService) DownloadAttachmentWithContext(ctx context.Context, attachmentID string) (*Response, error) {
 	apiEndpoint := fmt.Sprintf("secure/attachment/%s/", attachmentID)
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -638,8 +1009,17 @@ func (s *IssueService) DownloadAttachment(attachmentID string) (*Response, error
 	return resp, nil
 }
 
-// PostAttachment uploads r (io.Reader) as an attachment to a given issueID
-func (s *IssueService) PostAttachment(issueID string, r io.Reader, attachmentName string) (*[]Attachment, *Response, error) {
+// DownloadAttachment wraps DownloadAttachmentWithContext using the background context.
+func (s *IssueService) DownloadAttachment(attachmentID string) (*Response, error) {
+	return s.DownloadAttachmentWithContext(context.Background(), attachmentID)
+}
+
+// PostAttachmentWithContext uploads r (io.Reader) as an attachment to a given issueID.
+// The file is sent as multipart/form-data with the "X-Atlassian-Token: no-check" header, which JIRA requires
+// to skip its XSRF check. The content is buffered in memory, use PostAttachmentWithProgress to stream large files.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issue/{issueIdOrKey}/attachments-addAttachment
+func (s *IssueService) PostAttachmentWithContext(ctx context.Context, issueID string, r io.Reader, attachmentName string) (*[]Attachment, *Response, error) {
 	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/attachments", issueID)
 
 	b := new(bytes.Buffer)
@@ -658,7 +1038,7 @@ func (s *IssueService) PostAttachment(issueID string, r io.Reader, attachmentNam
 	}
 	writer.Close()
 
-	req, err := s.client.NewMultiPartRequest("POST", apiEndpoint, b)
+	req, err := s.client.NewMultiPartRequestWithContext(ctx, "POST", apiEndpoint, b)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -676,11 +1056,16 @@ func (s *IssueService) PostAttachment(issueID string, r io.Reader, attachmentNam
 	return attachment, resp, nil
 }
 
-// DeleteAttachment deletes an attachment of a given attachmentID
-func (s *IssueService) DeleteAttachment(attachmentID string) (*Response, error) {
+// PostAttachment wraps PostAttachmentWithContext using the background context.
+func (s *IssueService) PostAttachment(issueID string, r io.Reader, attachmentName string) (*[]Attachment, *Response, error) {
+	return s.PostAttachmentWithContext(context.Background(), issueID, r, attachmentName)
+}
+
+// DeleteAttachmentWithContext deletes an attachment of a given attachmentID
+func (s *IssueService) DeleteAttachmentWithContext(ctx context.Context, attachmentID string) (*Response, error) {
 	apiEndpoint := fmt.Sprintf("rest/api/2/attachment/%s", attachmentID)
 
-	req, err := s.client.NewRequest("DELETE", apiEndpoint, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -694,14 +1079,19 @@ func (s *IssueService) DeleteAttachment(attachmentID string) (*Response, error)
 	return resp, nil
 }
 
-// GetWorklogs gets all the worklogs for an issue.
+// DeleteAttachment wraps DeleteAttachmentWithContext using the background context.
+func (s *IssueService) DeleteAttachment(attachmentID string) (*Response, error) {
+	return s.DeleteAttachmentWithContext(context.Background(), attachmentID)
+}
+
+// GetWorklogsWithContext gets all the worklogs for an issue.
 // This method is especially important if you need to read all the worklogs, not just the first page.
 //
 // https://docs.atlassian.com/jira/REST/cloud/#api/2/issue/{issueIdOrKey}/worklog-getIssueWorklog
-func (s *IssueService) GetWorklogs(issueID string, options ...func(*http.Request) error) (*Worklog, *Response, error) {
+func (s *IssueService) GetWorklogsWithContext(ctx context.Context, issueID string, options ...func(*http.Request) error) (*Worklog, *Response, error) {
 	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/worklog", issueID)
 
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -715,7 +1105,15 @@ func (s *IssueService) GetWorklogs(issueID string, options ...func(*http.Request
 
 	v := new(Worklog)
 	resp, err := s.client.Do(req, v)
-	return v, resp, err
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return v, resp, nil
+}
+
+// GetWorklogs wraps GetWorklogsWithContext using the background context.
+func (s *IssueService) GetWorklogs(issueID string, options ...func(*http.Request) error) (*Worklog, *Response, error) {
+	return s.GetWorklogsWithContext(context.Background(), issueID, options...)
 }
 
 // Applies query options to http request.
@@ -734,21 +1132,44 @@ func WithQueryOptions(options interface{}) func(*http.Request) error {
 	}
 }
 
-// Create creates an issue or a sub-task from a JSON representation.
+// CreateWithContext creates an issue or a sub-task from a JSON representation.
 // Creating a sub-task is similar to creating a regular issue, with two important differences:
 // The issueType field must correspond to a sub-task issue type and you must provide a parent field in the issue create request containing the id or key of the parent issue.
 //
 // JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue-createIssues
+func (s *IssueService) CreateWithContext(ctx context.Context, issue *Issue) (*Issue, *Response, error) {
+	return s.create(ctx, issue)
+}
+
+// Create wraps CreateWithContext using the background context.
 func (s *IssueService) Create(issue *Issue) (*Issue, *Response, error) {
+	return s.CreateWithContext(context.Background(), issue)
+}
+
+// CreateWithPayloadWithContext creates an issue or a sub-task like Create,
+// but additionally applies the field operations, the transition and the history metadata of the payload.
+// This allows to create an issue directly in a non-initial status, with its links and labels, in one request.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issues/#api-rest-api-2-issue-post
+func (s *IssueService) CreateWithPayloadWithContext(ctx context.Context, payload *IssueCreatePayload) (*Issue, *Response, error) {
+	return s.create(ctx, payload)
+}
+
+// CreateWithPayload wraps CreateWithPayloadWithContext using the background context.
+func (s *IssueService) CreateWithPayload(payload *IssueCreatePayload) (*Issue, *Response, error) {
+	return s.CreateWithPayloadWithContext(context.Background(), payload)
+}
+
+func (s *IssueService) create(ctx context.Context, payload interface{}) (*Issue, *Response, error) {
 	apiEndpoint := "rest/api/2/issue"
-	req, err := s.client.NewRequest("POST", apiEndpoint, issue)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, payload)
 	if err != nil {
 		return nil, nil, err
 	}
 	resp, err := s.client.Do(req, nil)
 	if err != nil {
 		// incase of error return the resp for further inspection
-		return nil, resp, err
+		return nil, resp, NewJiraError(resp, err)
 	}
 
 	responseIssue := new(Issue)
@@ -764,17 +1185,17 @@ func (s *IssueService) Create(issue *Issue) (*Issue, *Response, error) {
 	return responseIssue, resp, nil
 }
 
-// UpdateWithOptions updates an issue from a JSON representation,
+// UpdateWithOptionsWithContext updates an issue from a JSON representation,
 // while also specifiying query params. The issue is found by key.
 //
 // JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/issue-editIssue
-func (s *IssueService) UpdateWithOptions(issue *Issue, opts *UpdateQueryOptions) (*Issue, *Response, error) {
+func (s *IssueService) UpdateWithOptionsWithContext(ctx context.Context, issue *Issue, opts *UpdateQueryOptions) (*Issue, *Response, error) {
 	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%v", issue.Key)
 	url, err := addOptions(apiEndpoint, opts)
 	if err != nil {
 		return nil, nil, err
 	}
-	req, err := s.client.NewRequest("PUT", url, issue)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", url, issue)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -790,25 +1211,61 @@ func (s *IssueService) UpdateWithOptions(issue *Issue, opts *UpdateQueryOptions)
 	return &ret, resp, nil
 }
 
-// Update updates an issue from a JSON representation. The issue is found by key.
+// UpdateWithOptions wraps UpdateWithOptionsWithContext using the background context.
+func (s *IssueService) UpdateWithOptions(issue *Issue, opts *UpdateQueryOptions) (*Issue, *Response, error) {
+	return s.UpdateWithOptionsWithContext(context.Background(), issue, opts)
+}
+
+// UpdateWithContext updates an issue from a JSON representation. The issue is found by key.
 //
 // JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/issue-editIssue
+func (s *IssueService) UpdateWithContext(ctx context.Context, issue *Issue) (*Issue, *Response, error) {
+	return s.UpdateWithOptionsWithContext(ctx, issue, nil)
+}
+
+// Update wraps UpdateWithContext using the background context.
 func (s *IssueService) Update(issue *Issue) (*Issue, *Response, error) {
-	return s.UpdateWithOptions(issue, nil)
+	return s.UpdateWithContext(context.Background(), issue)
+}
+
+// UpdateWithPayloadWithContext edits an issue with the fields, field operations and history metadata of the payload.
+// The issue can be an issue id, or an issue key.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issues/#api-rest-api-2-issue-issueidorkey-put
+func (s *IssueService) UpdateWithPayloadWithContext(ctx context.Context, issueID string, payload *IssueUpdatePayload, opts *UpdateQueryOptions) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%v", issueID)
+	url, err := addOptions(apiEndpoint, opts)
+	if err != nil {
+		return nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", url, payload)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// UpdateWithPayload wraps UpdateWithPayloadWithContext using the background context.
+func (s *IssueService) UpdateWithPayload(issueID string, payload *IssueUpdatePayload, opts *UpdateQueryOptions) (*Response, error) {
+	return s.UpdateWithPayloadWithContext(context.Background(), issueID, payload, opts)
 }
 
-// UpdateIssue updates an issue from a JSON representation. The issue is found by key.
+// UpdateIssueWithContext updates an issue from a JSON representation. The issue is found by key.
 //
 // https://docs.atlassian.com/jira/REST/7.4.0/#api/2/issue-editIssue
-func (s *IssueService) UpdateIssue(jiraID string, data map[string]interface{}) (*Response, error) {
+func (s *IssueService) UpdateIssueWithContext(ctx context.Context, jiraID string, data map[string]interface{}) (*Response, error) {
 	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%v", jiraID)
-	req, err := s.client.NewRequest("PUT", apiEndpoint, data)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, data)
 	if err != nil {
 		return nil, err
 	}
 	resp, err := s.client.Do(req, nil)
 	if err != nil {
-		return resp, err
+		return resp, NewJiraError(resp, err)
 	}
 
 	// This is just to follow the rest of the API's convention of returning an issue.
@@ -816,12 +1273,17 @@ func (s *IssueService) UpdateIssue(jiraID string, data map[string]interface{}) (
 	return resp, nil
 }
 
-// AddComment adds a new comment to issueID.
+// UpdateIssue wraps UpdateIssueWithContext using the background context.
+func (s *IssueService) UpdateIssue(jiraID string, data map[string]interface{}) (*Response, error) {
+	return s.UpdateIssueWithContext(context.Background(), jiraID, data)
+}
+
+// AddCommentWithContext adds a new comment to issueID.
 //
 // JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue-addComment
-func (s *IssueService) AddComment(issueID string, comment *Comment) (*Comment, *Response, error) {
+func (s *IssueService) AddCommentWithContext(ctx context.Context, issueID string, comment *Comment) (*Comment, *Response, error) {
 	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/comment", issueID)
-	req, err := s.client.NewRequest("POST", apiEndpoint, comment)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, comment)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -836,17 +1298,50 @@ func (s *IssueService) AddComment(issueID string, comment *Comment) (*Comment, *
 	return responseComment, resp, nil
 }
 
-// UpdateComment updates the body of a comment, identified by comment.ID, on the issueID.
+// AddComment wraps AddCommentWithContext using the background context.
+func (s *IssueService) AddComment(issueID string, comment *Comment) (*Comment, *Response, error) {
+	return s.AddCommentWithContext(context.Background(), issueID, comment)
+}
+
+// GetCommentWithContext returns a single comment of issueID.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issue-getComment
+func (s *IssueService) GetCommentWithContext(ctx context.Context, issueID, commentID string) (*Comment, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/comment/%s", issueID, commentID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	comment := new(Comment)
+	resp, err := s.client.Do(req, comment)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return comment, resp, nil
+}
+
+// GetComment wraps GetCommentWithContext using the background context.
+func (s *IssueService) GetComment(issueID, commentID string) (*Comment, *Response, error) {
+	return s.GetCommentWithContext(context.Background(), issueID, commentID)
+}
+
+// UpdateCommentWithContext updates the body of a comment, identified by comment.ID, on the issueID.
 //
 // JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/issue/{issueIdOrKey}/comment-updateComment
-func (s *IssueService) UpdateComment(issueID string, comment *Comment) (*Comment, *Response, error) {
+func (s *IssueService) UpdateCommentWithContext(ctx context.Context, issueID string, comment *Comment) (*Comment, *Response, error) {
 	reqBody := struct {
-		Body string `json:"body"`
+		Body       string             `json:"body"`
+		Visibility *CommentVisibility `json:"visibility,omitempty"`
 	}{
 		Body: comment.Body,
 	}
+	// Without the visibility JIRA would remove the restriction of the comment
+	if comment.Visibility.Type != "" {
+		reqBody.Visibility = &comment.Visibility
+	}
 	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/comment/%s", issueID, comment.ID)
-	req, err := s.client.NewRequest("PUT", apiEndpoint, reqBody)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, reqBody)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -854,18 +1349,79 @@ func (s *IssueService) UpdateComment(issueID string, comment *Comment) (*Comment
 	responseComment := new(Comment)
 	resp, err := s.client.Do(req, responseComment)
 	if err != nil {
-		return nil, resp, err
+		return nil, resp, NewJiraError(resp, err)
 	}
 
 	return responseComment, resp, nil
 }
 
-// DeleteComment Deletes a comment from an issueID.
+// UpdateComment wraps UpdateCommentWithContext using the background context.
+func (s *IssueService) UpdateComment(issueID string, comment *Comment) (*Comment, *Response, error) {
+	return s.UpdateCommentWithContext(context.Background(), issueID, comment)
+}
+
+// GetCommentsWithContext returns a page of the comments of issueID.
+// The paging values of the page are set in the PageInfo of the Response.
+// Comments restricted with a CommentVisibility are only returned to users of the role or group.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issue-getComments
+func (s *IssueService) GetCommentsWithContext(ctx context.Context, issueID string, options *GetCommentsOptions) ([]*Comment, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/api/2/issue/%s/comment", issueID), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(commentsPage)
+	resp, err := s.client.Do(req, page)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return page.Comments, resp, nil
+}
+
+// GetComments wraps GetCommentsWithContext using the background context.
+func (s *IssueService) GetComments(issueID string, options *GetCommentsOptions) ([]*Comment, *Response, error) {
+	return s.GetCommentsWithContext(context.Background(), issueID, options)
+}
+
+// GetAllCommentsWithContext returns all comments of issueID, by requesting all pages of GetComments.
+// options.StartAt is ignored.
+func (s *IssueService) GetAllCommentsWithContext(ctx context.Context, issueID string, options *GetCommentsOptions) ([]*Comment, error) {
+	opts := GetCommentsOptions{}
+	if options != nil {
+		opts = *options
+	}
+	opts.StartAt = 0
+
+	comments := []*Comment{}
+	for {
+		page, resp, err := s.GetCommentsWithContext(ctx, issueID, &opts)
+		if err != nil {
+			return nil, err
+		}
+		comments = append(comments, page...)
+		if len(page) == 0 || !resp.HasNextPage() {
+			return comments, nil
+		}
+		opts.StartAt = resp.NextStartAt()
+	}
+}
+
+// GetAllComments wraps GetAllCommentsWithContext using the background context.
+func (s *IssueService) GetAllComments(issueID string, options *GetCommentsOptions) ([]*Comment, error) {
+	return s.GetAllCommentsWithContext(context.Background(), issueID, options)
+}
+
+// DeleteCommentWithContext Deletes a comment from an issueID.
 //
 // JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-api-3-issue-issueIdOrKey-comment-id-delete
-func (s *IssueService) DeleteComment(issueID, commentID string) error {
+func (s *IssueService) DeleteCommentWithContext(ctx context.Context, issueID, commentID string) error {
 	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/comment/%s", issueID, commentID)
-	req, err := s.client.NewRequest("DELETE", apiEndpoint, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
 	if err != nil {
 		return err
 	}
@@ -879,12 +1435,17 @@ func (s *IssueService) DeleteComment(issueID, commentID string) error {
 	return nil
 }
 
-// AddWorklogRecord adds a new worklog record to issueID.
+// DeleteComment wraps DeleteCommentWithContext using the background context.
+func (s *IssueService) DeleteComment(issueID, commentID string) error {
+	return s.DeleteCommentWithContext(context.Background(), issueID, commentID)
+}
+
+// AddWorklogRecordWithContext adds a new worklog record to issueID.
 //
 // https://developer.atlassian.com/cloud/jira/platform/rest/#api-api-2-issue-issueIdOrKey-worklog-post
-func (s *IssueService) AddWorklogRecord(issueID string, record *WorklogRecord, options ...func(*http.Request) error) (*WorklogRecord, *Response, error) {
+func (s *IssueService) AddWorklogRecordWithContext(ctx context.Context, issueID string, record *WorklogRecord, options ...func(*http.Request) error) (*WorklogRecord, *Response, error) {
 	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/worklog", issueID)
-	req, err := s.client.NewRequest("POST", apiEndpoint, record)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, record)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -906,12 +1467,17 @@ func (s *IssueService) AddWorklogRecord(issueID string, record *WorklogRecord, o
 	return responseRecord, resp, nil
 }
 
-// UpdateWorklogRecord updates a worklog record.
+// AddWorklogRecord wraps AddWorklogRecordWithContext using the background context.
+func (s *IssueService) AddWorklogRecord(issueID string, record *WorklogRecord, options ...func(*http.Request) error) (*WorklogRecord, *Response, error) {
+	return s.AddWorklogRecordWithContext(context.Background(), issueID, record, options...)
+}
+
+// UpdateWorklogRecordWithContext updates a worklog record.
 //
 // https://docs.atlassian.com/software/jira/docs/api/REST/7.1.2/#api/2/issue-updateWorklog
-func (s *IssueService) UpdateWorklogRecord(issueID, worklogID string, record *WorklogRecord, options ...func(*http.Request) error) (*WorklogRecord, *Response, error) {
+func (s *IssueService) UpdateWorklogRecordWithContext(ctx context.Context, issueID, worklogID string, record *WorklogRecord, options ...func(*http.Request) error) (*WorklogRecord, *Response, error) {
 	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/worklog/%s", issueID, worklogID)
-	req, err := s.client.NewRequest("PUT", apiEndpoint, record)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, record)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -933,40 +1499,161 @@ func (s *IssueService) UpdateWorklogRecord(issueID, worklogID string, record *Wo
 	return responseRecord, resp, nil
 }
 
-// AddLink adds a link between two issues.
+// UpdateWorklogRecord wraps UpdateWorklogRecordWithContext using the background context.
+func (s *IssueService) UpdateWorklogRecord(issueID, worklogID string, record *WorklogRecord, options ...func(*http.Request) error) (*WorklogRecord, *Response, error) {
+	return s.UpdateWorklogRecordWithContext(context.Background(), issueID, worklogID, record, options...)
+}
+
+// GetWorklogRecordWithContext returns the worklog record with worklogID of issueID.
 //
-// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issueLink
-func (s *IssueService) AddLink(issueLink *IssueLink) (*Response, error) {
-	apiEndpoint := fmt.Sprintf("rest/api/2/issueLink")
-	req, err := s.client.NewRequest("POST", apiEndpoint, issueLink)
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-worklogs/#api-rest-api-2-issue-issueidorkey-worklog-id-get
+func (s *IssueService) GetWorklogRecordWithContext(ctx context.Context, issueID, worklogID string, options ...func(*http.Request) error) (*WorklogRecord, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/worklog/%s", issueID, worklogID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	resp, err := s.client.Do(req, nil)
+	for _, option := range options {
+		err = option(req)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	record := new(WorklogRecord)
+	resp, err := s.client.Do(req, record)
 	if err != nil {
-		err = NewJiraError(resp, err)
+		return nil, resp, NewJiraError(resp, err)
 	}
+	return record, resp, nil
+}
 
-	return resp, err
+// GetWorklogRecord wraps GetWorklogRecordWithContext using the background context.
+func (s *IssueService) GetWorklogRecord(issueID, worklogID string, options ...func(*http.Request) error) (*WorklogRecord, *Response, error) {
+	return s.GetWorklogRecordWithContext(context.Background(), issueID, worklogID, options...)
 }
 
-// Search will search for tickets according to the jql
+// DeleteWorklogRecordWithContext deletes the worklog record with worklogID of issueID.
+// The remaining estimate of the issue is adjusted as given by the DeleteWorklogQueryOptions passed with WithQueryOptions,
+// by default it is increased by the time spent of the record.
 //
-// JIRA API docs: https://developer.atlassian.com/jiradev/jira-apis/jira-rest-apis/jira-rest-api-tutorials/jira-rest-api-example-query-issues
-func (s *IssueService) Search(jql string, options *SearchOptions) ([]Issue, *Response, error) {
-	var u string
-	if options == nil {
-		u = fmt.Sprintf("rest/api/2/search?jql=%s", url.QueryEscape(jql))
-	} else {
-		u = "rest/api/2/search?jql=" + url.QueryEscape(jql)
-		if options.StartAt != 0 {
-			u += fmt.Sprintf("&startAt=%d", options.StartAt)
-		}
-		if options.MaxResults != 0 {
-			u += fmt.Sprintf("&maxResults=%d", options.MaxResults)
-		}
-		if options.Expand != "" {
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-worklogs/#api-rest-api-2-issue-issueidorkey-worklog-id-delete
+func (s *IssueService) DeleteWorklogRecordWithContext(ctx context.Context, issueID, worklogID string, options ...func(*http.Request) error) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/worklog/%s", issueID, worklogID)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, option := range options {
+		err = option(req)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// DeleteWorklogRecord wraps DeleteWorklogRecordWithContext using the background context.
+func (s *IssueService) DeleteWorklogRecord(issueID, worklogID string, options ...func(*http.Request) error) (*Response, error) {
+	return s.DeleteWorklogRecordWithContext(context.Background(), issueID, worklogID, options...)
+}
+
+// AddLinkWithContext adds a link between two issues.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issueLink
+func (s *IssueService) AddLinkWithContext(ctx context.Context, issueLink *IssueLink) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issueLink")
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, issueLink)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		err = NewJiraError(resp, err)
+	}
+
+	return resp, err
+}
+
+// AddLink wraps AddLinkWithContext using the background context.
+func (s *IssueService) AddLink(issueLink *IssueLink) (*Response, error) {
+	return s.AddLinkWithContext(context.Background(), issueLink)
+}
+
+// GetLinkWithContext returns the link between two issues with the given ID.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-links/#api-rest-api-2-issuelink-linkid-get
+func (s *IssueService) GetLinkWithContext(ctx context.Context, linkID string) (*IssueLink, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issueLink/%s", linkID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	issueLink := new(IssueLink)
+	resp, err := s.client.Do(req, issueLink)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return issueLink, resp, nil
+}
+
+// GetLink wraps GetLinkWithContext using the background context.
+func (s *IssueService) GetLink(linkID string) (*IssueLink, *Response, error) {
+	return s.GetLinkWithContext(context.Background(), linkID)
+}
+
+// DeleteLinkWithContext deletes the link between two issues with the given ID.
+// The IDs of the links of an issue are returned in its IssueLinks field.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-links/#api-rest-api-2-issuelink-linkid-delete
+func (s *IssueService) DeleteLinkWithContext(ctx context.Context, linkID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issueLink/%s", linkID)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// DeleteLink wraps DeleteLinkWithContext using the background context.
+func (s *IssueService) DeleteLink(linkID string) (*Response, error) {
+	return s.DeleteLinkWithContext(context.Background(), linkID)
+}
+
+// SearchWithContext will search for tickets according to the jql
+//
+// Search uses the offset based endpoint rest/api/2/search, which JIRA Server and Data Center provide.
+// JIRA Cloud replaces it with the token based endpoint, which can not address a page by StartAt,
+// so Search is not routed to it. On JIRA Cloud use SearchJQL or SearchPages, which follows WithSearchAPI.
+//
+// JIRA API docs: https://developer.atlassian.com/jiradev/jira-apis/jira-rest-apis/jira-rest-api-tutorials/jira-rest-api-example-query-issues
+func (s *IssueService) SearchWithContext(ctx context.Context, jql string, options *SearchOptions) ([]Issue, *Response, error) {
+	var u string
+	if options == nil {
+		u = fmt.Sprintf("rest/api/2/search?jql=%s", url.QueryEscape(jql))
+	} else {
+		u = "rest/api/2/search?jql=" + url.QueryEscape(jql)
+		if options.StartAt != 0 {
+			u += fmt.Sprintf("&startAt=%d", options.StartAt)
+		}
+		if options.MaxResults != 0 {
+			u += fmt.Sprintf("&maxResults=%d", options.MaxResults)
+		}
+		if options.Expand != "" {
 			u += fmt.Sprintf("&expand=%s", options.Expand)
 		}
 		if strings.Join(options.Fields, ",") != "" {
@@ -977,7 +1664,7 @@ func (s *IssueService) Search(jql string, options *SearchOptions) ([]Issue, *Res
 		}
 	}
 
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return []Issue{}, nil, err
 	}
@@ -990,22 +1677,66 @@ func (s *IssueService) Search(jql string, options *SearchOptions) ([]Issue, *Res
 	return v.Issues, resp, err
 }
 
-// SearchPages will get issues from all pages in a search
+// Search wraps SearchWithContext using the background context.
+func (s *IssueService) Search(jql string, options *SearchOptions) ([]Issue, *Response, error) {
+	return s.SearchWithContext(context.Background(), jql, options)
+}
+
+// CountWithContext returns the number of issues matching jql.
+// The search is sent with maxResults=0, so JIRA only returns the total and no issue.
+// If the token based endpoint is selected by WithSearchAPI, e.g. on JIRA Cloud,
+// the count of ApproximateCount is returned, as that endpoint does not return a total.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-search/#api-rest-api-2-search-get
+func (s *IssueService) CountWithContext(ctx context.Context, jql string) (int, *Response, error) {
+	if s.client.useSearchJQL() {
+		return s.ApproximateCountWithContext(ctx, jql)
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/api/2/search?jql=%s&maxResults=0&fields=id", url.QueryEscape(jql))
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	v := new(searchResult)
+	resp, err := s.client.Do(req, v)
+	if err != nil {
+		return 0, resp, NewJiraError(resp, err)
+	}
+	return v.Total, resp, nil
+}
+
+// Count wraps CountWithContext using the background context.
+func (s *IssueService) Count(jql string) (int, *Response, error) {
+	return s.CountWithContext(context.Background(), jql)
+}
+
+// SearchPagesWithContext will get issues from all pages in a search.
+// The pages are requested from the endpoint selected by WithSearchAPI: the offset based endpoint like Search,
+// or the token based endpoint like SearchJQLPages, e.g. on JIRA Cloud.
 //
 // JIRA API docs: https://developer.atlassian.com/jiradev/jira-apis/jira-rest-apis/jira-rest-api-tutorials/jira-rest-api-example-query-issues
-func (s *IssueService) SearchPages(jql string, options *SearchOptions, f func(Issue) error) error {
-	if options == nil {
-		options = &SearchOptions{
-			StartAt:    0,
-			MaxResults: 50,
+func (s *IssueService) SearchPagesWithContext(ctx context.Context, jql string, options *SearchOptions, f func(Issue) error) error {
+	if s.client.useSearchJQL() {
+		var skip int
+		if options != nil {
+			skip = options.StartAt
 		}
+		return s.SearchJQLPagesWithContext(ctx, jql, options.jqlOptions(), skipIssues(skip, f))
 	}
 
-	if options.MaxResults == 0 {
-		options.MaxResults = 50
+	// The options are copied, so the caller's StartAt is not advanced
+	opts := SearchOptions{MaxResults: 50}
+	if options != nil {
+		opts = *options
+		if opts.MaxResults == 0 {
+			opts.MaxResults = 50
+		}
 	}
+	options = &opts
 
-	issues, resp, err := s.Search(jql, options)
+	issues, resp, err := s.SearchWithContext(ctx, jql, options)
 	if err != nil {
 		return err
 	}
@@ -1018,22 +1749,125 @@ func (s *IssueService) SearchPages(jql string, options *SearchOptions, f func(Is
 			}
 		}
 
-		if resp.StartAt+resp.MaxResults >= resp.Total {
+		if len(issues) == 0 || resp.StartAt+resp.MaxResults >= resp.Total {
 			return nil
 		}
 
 		options.StartAt += resp.MaxResults
-		issues, resp, err = s.Search(jql, options)
+		issues, resp, err = s.SearchWithContext(ctx, jql, options)
 		if err != nil {
 			return err
 		}
 	}
 }
 
-// GetCustomFields returns a map of customfield_* keys with string values
-func (s *IssueService) GetCustomFields(issueID string) (CustomFields, *Response, error) {
+// SearchPages wraps SearchPagesWithContext using the background context.
+func (s *IssueService) SearchPages(jql string, options *SearchOptions, f func(Issue) error) error {
+	return s.SearchPagesWithContext(context.Background(), jql, options, f)
+}
+
+// SearchJQLWithContext will search for issues according to the jql using the token based pagination.
+// The token of the next page is returned in Response.NextPageToken, Response.IsLast reports if
+// the returned page is the last one. The endpoint does not return a total, see ApproximateCount.
+// It is the replacement of Search on JIRA Cloud and is not available on JIRA Server and Data Center.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-search/#api-rest-api-2-search-jql-get
+func (s *IssueService) SearchJQLWithContext(ctx context.Context, jql string, options *SearchJQLOptions) ([]Issue, *Response, error) {
+	u, err := addOptions("rest/api/2/search/jql", options)
+	if err != nil {
+		return nil, nil, err
+	}
+	if strings.Contains(u, "?") {
+		u += "&jql=" + url.QueryEscape(jql)
+	} else {
+		u += "?jql=" + url.QueryEscape(jql)
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return []Issue{}, nil, err
+	}
+
+	v := new(searchJQLResult)
+	resp, err := s.client.Do(req, v)
+	if err != nil {
+		err = NewJiraError(resp, err)
+	}
+	return v.Issues, resp, err
+}
+
+// SearchJQL wraps SearchJQLWithContext using the background context.
+func (s *IssueService) SearchJQL(jql string, options *SearchJQLOptions) ([]Issue, *Response, error) {
+	return s.SearchJQLWithContext(context.Background(), jql, options)
+}
+
+// SearchJQLPagesWithContext will get issues from all pages in a search using the token based pagination
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-search/#api-rest-api-2-search-jql-get
+func (s *IssueService) SearchJQLPagesWithContext(ctx context.Context, jql string, options *SearchJQLOptions, f func(Issue) error) error {
+	if options == nil {
+		options = &SearchJQLOptions{}
+	}
+
+	for {
+		issues, resp, err := s.SearchJQLWithContext(ctx, jql, options)
+		if err != nil {
+			return err
+		}
+
+		for _, issue := range issues {
+			err = f(issue)
+			if err != nil {
+				return err
+			}
+		}
+
+		if resp.IsLast || resp.NextPageToken == "" {
+			return nil
+		}
+		options.NextPageToken = resp.NextPageToken
+	}
+}
+
+// SearchJQLPages wraps SearchJQLPagesWithContext using the background context.
+func (s *IssueService) SearchJQLPages(jql string, options *SearchJQLOptions, f func(Issue) error) error {
+	return s.SearchJQLPagesWithContext(context.Background(), jql, options, f)
+}
+
+// ApproximateCountWithContext returns an approximate count of the issues matching the jql.
+// Recent updates might not be immediately visible in the returned count.
+// Like SearchJQL, it is only available on JIRA Cloud, see Count for JIRA Server and Data Center.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-search/#api-rest-api-2-search-approximate-count-post
+func (s *IssueService) ApproximateCountWithContext(ctx context.Context, jql string) (int, *Response, error) {
+	apiEndpoint := "rest/api/2/search/approximate-count"
+	payload := struct {
+		JQL string `json:"jql"`
+	}{jql}
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, payload)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	result := new(struct {
+		Count int `json:"count"`
+	})
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return 0, resp, NewJiraError(resp, err)
+	}
+	return result.Count, resp, nil
+}
+
+// ApproximateCount wraps ApproximateCountWithContext using the background context.
+func (s *IssueService) ApproximateCount(jql string) (int, *Response, error) {
+	return s.ApproximateCountWithContext(context.Background(), jql)
+}
+
+// GetCustomFieldsWithContext returns a map of customfield_* keys with string values
+func (s *IssueService) GetCustomFieldsWithContext(ctx context.Context, issueID string) (CustomFields, *Response, error) {
 	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s", issueID)
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1067,13 +1901,18 @@ func (s *IssueService) GetCustomFields(issueID string) (CustomFields, *Response,
 	return cf, resp, nil
 }
 
-// GetTransitions gets a list of the transitions possible for this issue by the current user,
+// GetCustomFields wraps GetCustomFieldsWithContext using the background context.
+func (s *IssueService) GetCustomFields(issueID string) (CustomFields, *Response, error) {
+	return s.GetCustomFieldsWithContext(context.Background(), issueID)
+}
+
+// GetTransitionsWithContext gets a list of the transitions possible for this issue by the current user,
 // along with fields that are required and their types.
 //
 // JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue-getTransitions
-func (s *IssueService) GetTransitions(id string) ([]Transition, *Response, error) {
+func (s *IssueService) GetTransitionsWithContext(ctx context.Context, id string) ([]Transition, *Response, error) {
 	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/transitions?expand=transitions.fields", id)
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1086,27 +1925,114 @@ func (s *IssueService) GetTransitions(id string) ([]Transition, *Response, error
 	return result.Transitions, resp, err
 }
 
-// DoTransition performs a transition on an issue.
+// GetTransitions wraps GetTransitionsWithContext using the background context.
+func (s *IssueService) GetTransitions(id string) ([]Transition, *Response, error) {
+	return s.GetTransitionsWithContext(context.Background(), id)
+}
+
+// GetTransitionsWithOptionsWithContext is like GetTransitionsWithContext, but accepts GetTransitionsOptions.
+// The fields of the transition screens are always expanded.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issues/#api-rest-api-2-issue-issueidorkey-transitions-get
+func (s *IssueService) GetTransitionsWithOptionsWithContext(ctx context.Context, id string, options *GetTransitionsOptions) ([]Transition, *Response, error) {
+	query := struct {
+		Expand string `url:"expand"`
+		GetTransitionsOptions
+	}{Expand: "transitions.fields"}
+	if options != nil {
+		query.GetTransitionsOptions = *options
+	}
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/api/2/issue/%s/transitions", id), &query)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(transitionResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result.Transitions, resp, nil
+}
+
+// GetTransitionsWithOptions wraps GetTransitionsWithOptionsWithContext using the background context.
+func (s *IssueService) GetTransitionsWithOptions(id string, options *GetTransitionsOptions) ([]Transition, *Response, error) {
+	return s.GetTransitionsWithOptionsWithContext(context.Background(), id, options)
+}
+
+// DoTransitionWithContext performs a transition on an issue.
 // When performing the transition you can update or set other issue fields.
 //
 // JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue-doTransition
+func (s *IssueService) DoTransitionWithContext(ctx context.Context, ticketID, transitionID string) (*Response, error) {
+	payload := CreateTransitionPayload{
+		Transition: TransitionPayload{
+			ID: transitionID,
+		},
+	}
+	return s.DoTransitionWithPayloadWithContext(ctx, ticketID, payload)
+}
+
+// DoTransition wraps DoTransitionWithContext using the background context.
 func (s *IssueService) DoTransition(ticketID, transitionID string) (*Response, error) {
+	return s.DoTransitionWithContext(context.Background(), ticketID, transitionID)
+}
+
+// DoTransitionWithMetadataWithContext performs a transition on an issue
+// and records the history metadata with the change, e.g. to attribute it to an external system.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issues/#api-rest-api-2-issue-issueidorkey-transitions-post
+func (s *IssueService) DoTransitionWithMetadataWithContext(ctx context.Context, ticketID, transitionID string, metadata *HistoryMetadata) (*Response, error) {
 	payload := CreateTransitionPayload{
 		Transition: TransitionPayload{
 			ID: transitionID,
 		},
+		HistoryMetadata: metadata,
 	}
-	return s.DoTransitionWithPayload(ticketID, payload)
+	return s.DoTransitionWithPayloadWithContext(ctx, ticketID, payload)
 }
 
-// DoTransitionWithPayload performs a transition on an issue using any payload.
+// DoTransitionWithMetadata wraps DoTransitionWithMetadataWithContext using the background context.
+func (s *IssueService) DoTransitionWithMetadata(ticketID, transitionID string, metadata *HistoryMetadata) (*Response, error) {
+	return s.DoTransitionWithMetadataWithContext(context.Background(), ticketID, transitionID, metadata)
+}
+
+// DoTransitionWithFieldsWithContext performs a transition on an issue and changes the issue in the same call.
+// fields sets field values by field ID, e.g. {"resolution": {"name": "Done"}},
+// update applies field operations, e.g. {"comment": [{"add": {"body": "Fixed"}}]}.
+// Both may be nil. See Transition.RequiredFields for the fields, which have to be set.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue-doTransition
+func (s *IssueService) DoTransitionWithFieldsWithContext(ctx context.Context, ticketID, transitionID string, fields map[string]interface{}, update map[string][]FieldOperation) (*Response, error) {
+	payload := struct {
+		Transition TransitionPayload           `json:"transition"`
+		Fields     map[string]interface{}      `json:"fields,omitempty"`
+		Update     map[string][]FieldOperation `json:"update,omitempty"`
+	}{
+		Transition: TransitionPayload{ID: transitionID},
+		Fields:     fields,
+		Update:     update,
+	}
+	return s.DoTransitionWithPayloadWithContext(ctx, ticketID, payload)
+}
+
+// DoTransitionWithFields wraps DoTransitionWithFieldsWithContext using the background context.
+func (s *IssueService) DoTransitionWithFields(ticketID, transitionID string, fields map[string]interface{}, update map[string][]FieldOperation) (*Response, error) {
+	return s.DoTransitionWithFieldsWithContext(context.Background(), ticketID, transitionID, fields, update)
+}
+
+// DoTransitionWithPayloadWithContext performs a transition on an issue using any payload.
 // When performing the transition you can update or set other issue fields.
 //
 // JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue-doTransition
-func (s *IssueService) DoTransitionWithPayload(ticketID, payload interface{}) (*Response, error) {
+func (s *IssueService) DoTransitionWithPayloadWithContext(ctx context.Context, ticketID, payload interface{}) (*Response, error) {
 	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/transitions", ticketID)
 
-	req, err := s.client.NewRequest("POST", apiEndpoint, payload)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, payload)
 	if err != nil {
 		return nil, err
 	}
@@ -1119,6 +2045,11 @@ func (s *IssueService) DoTransitionWithPayload(ticketID, payload interface{}) (*
 	return resp, err
 }
 
+// DoTransitionWithPayload wraps DoTransitionWithPayloadWithContext using the background context.
+func (s *IssueService) DoTransitionWithPayload(ticketID, payload interface{}) (*Response, error) {
+	return s.DoTransitionWithPayloadWithContext(context.Background(), ticketID, payload)
+}
+
 // InitIssueWithMetaAndFields returns Issue with with values from fieldsConfig properly set.
 //  * metaProject should contain metaInformation about the project where the issue should be created.
 //  * metaIssuetype is the MetaInformation about the Issuetype that needs to be created.
@@ -1198,45 +2129,51 @@ func InitIssueWithMetaAndFields(metaProject *MetaProject, metaIssuetype *MetaIss
 	return issue, nil
 }
 
-// Delete will delete a specified issue.
-func (s *IssueService) Delete(issueID string) (*Response, error) {
-	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s", issueID)
-
-	// to enable deletion of subtasks; without this, the request will fail if the issue has subtasks
-	deletePayload := make(map[string]interface{})
-	deletePayload["deleteSubtasks"] = "true"
-	content, _ := json.Marshal(deletePayload)
+// DeleteWithContext will delete a specified issue.
+// The subtasks of the issue are deleted as well, without this the request fails if the issue has subtasks.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issue-deleteIssue
+func (s *IssueService) DeleteWithContext(ctx context.Context, issueID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s?deleteSubtasks=true", issueID)
 
-	req, err := s.client.NewRequest("DELETE", apiEndpoint, content)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	resp, err := s.client.Do(req, nil)
-	return resp, err
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// Delete wraps DeleteWithContext using the background context.
+func (s *IssueService) Delete(issueID string) (*Response, error) {
+	return s.DeleteWithContext(context.Background(), issueID)
 }
 
-// GetWatchers wil return all the users watching/observing the given issue
+// GetWatchersWithContext wil return all the users watching/observing the given issue
+//
+// On instances which still expose user names, the full user details are fetched for every watcher.
+// On instances without user names (JIRA Cloud), the details returned with the watcher list
+// (display name, account ID, active flag, ...) are used.
 //
 // JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issue-getIssueWatchers
-func (s *IssueService) GetWatchers(issueID string) (*[]User, *Response, error) {
-	watchesAPIEndpoint := fmt.Sprintf("rest/api/2/issue/%s/watchers", issueID)
-
-	req, err := s.client.NewRequest("GET", watchesAPIEndpoint, nil)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	watches := new(Watches)
-	resp, err := s.client.Do(req, watches)
+func (s *IssueService) GetWatchersWithContext(ctx context.Context, issueID string) (*[]User, *Response, error) {
+	watches, resp, err := s.GetWatchersListWithContext(ctx, issueID)
 	if err != nil {
-		return nil, nil, NewJiraError(resp, err)
+		return nil, resp, err
 	}
 
 	result := []User{}
 	user := new(User)
 	for _, watcher := range watches.Watchers {
-		user, resp, err = s.client.User.Get(watcher.Name)
+		if watcher.Name == "" {
+			result = append(result, *watcher.User())
+			continue
+		}
+		user, resp, err = s.client.User.GetWithContext(ctx, watcher.Name)
 		if err != nil {
 			return nil, resp, NewJiraError(resp, err)
 		}
@@ -1246,13 +2183,46 @@ func (s *IssueService) GetWatchers(issueID string) (*[]User, *Response, error) {
 	return &result, resp, nil
 }
 
-// AddWatcher adds watcher to the given issue
+// GetWatchers wraps GetWatchersWithContext using the background context.
+func (s *IssueService) GetWatchers(issueID string) (*[]User, *Response, error) {
+	return s.GetWatchersWithContext(context.Background(), issueID)
+}
+
+// GetWatchersListWithContext returns the watchers of the given issue as JIRA returns them,
+// without looking up every single watcher.
+// Use Watcher.User to convert a watcher into a User.
+// The endpoint is not paginated, neither on JIRA Cloud nor on JIRA Server, all watchers are returned at once.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issue-getIssueWatchers
+func (s *IssueService) GetWatchersListWithContext(ctx context.Context, issueID string) (*Watches, *Response, error) {
+	watchesAPIEndpoint := fmt.Sprintf("rest/api/2/issue/%s/watchers", issueID)
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", watchesAPIEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	watches := new(Watches)
+	resp, err := s.client.Do(req, watches)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return watches, resp, nil
+}
+
+// GetWatchersList wraps GetWatchersListWithContext using the background context.
+func (s *IssueService) GetWatchersList(issueID string) (*Watches, *Response, error) {
+	return s.GetWatchersListWithContext(context.Background(), issueID)
+}
+
+// AddWatcherWithContext adds watcher to the given issue
 //
 // JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issue-addWatcher
-func (s *IssueService) AddWatcher(issueID string, userName string) (*Response, error) {
+func (s *IssueService) AddWatcherWithContext(ctx context.Context, issueID string, userName string) (*Response, error) {
 	apiEndPoint := fmt.Sprintf("rest/api/2/issue/%s/watchers", issueID)
 
-	req, err := s.client.NewRequest("POST", apiEndPoint, userName)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndPoint, userName)
 	if err != nil {
 		return nil, err
 	}
@@ -1265,13 +2235,18 @@ func (s *IssueService) AddWatcher(issueID string, userName string) (*Response, e
 	return resp, err
 }
 
-// RemoveWatcher removes given user from given issue
+// AddWatcher wraps AddWatcherWithContext using the background context.
+func (s *IssueService) AddWatcher(issueID string, userName string) (*Response, error) {
+	return s.AddWatcherWithContext(context.Background(), issueID, userName)
+}
+
+// RemoveWatcherWithContext removes given user from given issue
 //
 // JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issue-removeWatcher
-func (s *IssueService) RemoveWatcher(issueID string, userName string) (*Response, error) {
+func (s *IssueService) RemoveWatcherWithContext(ctx context.Context, issueID string, userName string) (*Response, error) {
 	apiEndPoint := fmt.Sprintf("rest/api/2/issue/%s/watchers", issueID)
 
-	req, err := s.client.NewRequest("DELETE", apiEndPoint, userName)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndPoint, userName)
 	if err != nil {
 		return nil, err
 	}
@@ -1284,13 +2259,88 @@ func (s *IssueService) RemoveWatcher(issueID string, userName string) (*Response
 	return resp, err
 }
 
-// UpdateAssignee updates the user assigned to work on the given issue
+// RemoveWatcher wraps RemoveWatcherWithContext using the background context.
+func (s *IssueService) RemoveWatcher(issueID string, userName string) (*Response, error) {
+	return s.RemoveWatcherWithContext(context.Background(), issueID, userName)
+}
+
+// GetVotesWithContext returns the votes of the given issue, including the list of users who voted.
+// The voters are only returned if the user has the permission to view voters of the issue.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issue-getVotes
+func (s *IssueService) GetVotesWithContext(ctx context.Context, issueID string) (*Votes, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/votes", issueID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	votes := new(Votes)
+	resp, err := s.client.Do(req, votes)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return votes, resp, nil
+}
+
+// GetVotes wraps GetVotesWithContext using the background context.
+func (s *IssueService) GetVotes(issueID string) (*Votes, *Response, error) {
+	return s.GetVotesWithContext(context.Background(), issueID)
+}
+
+// AddVoteWithContext adds the vote of the current user to the given issue.
+// Users can not vote on the issues they reported.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-votes/#api-rest-api-2-issue-issueidorkey-votes-post
+func (s *IssueService) AddVoteWithContext(ctx context.Context, issueID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/votes", issueID)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// AddVote wraps AddVoteWithContext using the background context.
+func (s *IssueService) AddVote(issueID string) (*Response, error) {
+	return s.AddVoteWithContext(context.Background(), issueID)
+}
+
+// RemoveVoteWithContext removes the vote of the current user from the given issue.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-votes/#api-rest-api-2-issue-issueidorkey-votes-delete
+func (s *IssueService) RemoveVoteWithContext(ctx context.Context, issueID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/votes", issueID)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// RemoveVote wraps RemoveVoteWithContext using the background context.
+func (s *IssueService) RemoveVote(issueID string) (*Response, error) {
+	return s.RemoveVoteWithContext(context.Background(), issueID)
+}
+
+// UpdateAssigneeWithContext updates the user assigned to work on the given issue
 //
 // JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.2/#api/2/issue-assign
-func (s *IssueService) UpdateAssignee(issueID string, assignee *User) (*Response, error) {
+func (s *IssueService) UpdateAssigneeWithContext(ctx context.Context, issueID string, assignee *User) (*Response, error) {
 	apiEndPoint := fmt.Sprintf("rest/api/2/issue/%s/assignee", issueID)
 
-	req, err := s.client.NewRequest("PUT", apiEndPoint, assignee)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndPoint, assignee)
 	if err != nil {
 		return nil, err
 	}
@@ -1303,6 +2353,11 @@ func (s *IssueService) UpdateAssignee(issueID string, assignee *User) (*Response
 	return resp, err
 }
 
+// UpdateAssignee wraps UpdateAssigneeWithContext using the background context.
+func (s *IssueService) UpdateAssignee(issueID string, assignee *User) (*Response, error) {
+	return s.UpdateAssigneeWithContext(context.Background(), issueID, assignee)
+}
+
 func (c ChangelogHistory) CreatedTime() (time.Time, error) {
 	var t time.Time
 	// Ignore null
@@ -1313,12 +2368,12 @@ func (c ChangelogHistory) CreatedTime() (time.Time, error) {
 	return t, err
 }
 
-// GetRemoteLinks gets remote issue links on the issue.
+// GetRemoteLinksWithContext gets remote issue links on the issue.
 //
 // JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue-getRemoteIssueLinks
-func (s *IssueService) GetRemoteLinks(id string) (*[]RemoteLink, *Response, error) {
+func (s *IssueService) GetRemoteLinksWithContext(ctx context.Context, id string) (*[]RemoteLink, *Response, error) {
 	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/remotelink", id)
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1330,3 +2385,162 @@ func (s *IssueService) GetRemoteLinks(id string) (*[]RemoteLink, *Response, erro
 	}
 	return result, resp, err
 }
+
+// GetRemoteLinks wraps GetRemoteLinksWithContext using the background context.
+func (s *IssueService) GetRemoteLinks(id string) (*[]RemoteLink, *Response, error) {
+	return s.GetRemoteLinksWithContext(context.Background(), id)
+}
+
+// GetRemoteLinkWithContext gets the remote issue link linkID on the issue.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-remote-links/#api-rest-api-2-issue-issueidorkey-remotelink-linkid-get
+func (s *IssueService) GetRemoteLinkWithContext(ctx context.Context, issueID string, linkID int) (*RemoteLink, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/remotelink/%d", issueID, linkID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	remoteLink := new(RemoteLink)
+	resp, err := s.client.Do(req, remoteLink)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return remoteLink, resp, nil
+}
+
+// GetRemoteLink wraps GetRemoteLinkWithContext using the background context.
+func (s *IssueService) GetRemoteLink(issueID string, linkID int) (*RemoteLink, *Response, error) {
+	return s.GetRemoteLinkWithContext(context.Background(), issueID, linkID)
+}
+
+// GetRemoteLinkByGlobalIDWithContext gets the remote issue link with the given global ID on the issue.
+// JIRA responds with 404 Not Found if the issue has no remote link with this global ID.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-remote-links/#api-rest-api-2-issue-issueidorkey-remotelink-get
+func (s *IssueService) GetRemoteLinkByGlobalIDWithContext(ctx context.Context, issueID, globalID string) (*RemoteLink, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/remotelink?globalId=%s", issueID, url.QueryEscape(globalID))
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	remoteLink := new(RemoteLink)
+	resp, err := s.client.Do(req, remoteLink)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return remoteLink, resp, nil
+}
+
+// GetRemoteLinkByGlobalID wraps GetRemoteLinkByGlobalIDWithContext using the background context.
+func (s *IssueService) GetRemoteLinkByGlobalID(issueID, globalID string) (*RemoteLink, *Response, error) {
+	return s.GetRemoteLinkByGlobalIDWithContext(context.Background(), issueID, globalID)
+}
+
+// UpsertRemoteLinkWithContext creates the remote link on the issue, or updates the remote link with the same global ID.
+// Integrations can call it on every sync without duplicating their links. remotelink.GlobalID is required.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-remote-links/#api-rest-api-2-issue-issueidorkey-remotelink-post
+func (s *IssueService) UpsertRemoteLinkWithContext(ctx context.Context, issueID string, remotelink *RemoteLink) (*RemoteLink, *Response, error) {
+	if remotelink == nil || remotelink.GlobalID == "" {
+		return nil, nil, fmt.Errorf("can not upsert remote link without global ID")
+	}
+	return s.AddRemoteLinkWithContext(ctx, issueID, remotelink)
+}
+
+// UpsertRemoteLink wraps UpsertRemoteLinkWithContext using the background context.
+func (s *IssueService) UpsertRemoteLink(issueID string, remotelink *RemoteLink) (*RemoteLink, *Response, error) {
+	return s.UpsertRemoteLinkWithContext(context.Background(), issueID, remotelink)
+}
+
+// DeleteRemoteLinkByGlobalIDWithContext deletes the remote link with the given global ID from the issue.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-remote-links/#api-rest-api-2-issue-issueidorkey-remotelink-delete
+func (s *IssueService) DeleteRemoteLinkByGlobalIDWithContext(ctx context.Context, issueID, globalID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/remotelink?globalId=%s", issueID, url.QueryEscape(globalID))
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// DeleteRemoteLinkByGlobalID wraps DeleteRemoteLinkByGlobalIDWithContext using the background context.
+func (s *IssueService) DeleteRemoteLinkByGlobalID(issueID, globalID string) (*Response, error) {
+	return s.DeleteRemoteLinkByGlobalIDWithContext(context.Background(), issueID, globalID)
+}
+
+// AddRemoteLinkWithContext adds a remote link to the issue and returns its ID and Self.
+// If remotelink.GlobalID is set and a remote link with this global ID exists on the issue, JIRA updates that link instead.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-remote-links/#api-rest-api-2-issue-issueidorkey-remotelink-post
+func (s *IssueService) AddRemoteLinkWithContext(ctx context.Context, issueID string, remotelink *RemoteLink) (*RemoteLink, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/remotelink", issueID)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, remotelink)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(RemoteLink)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result, resp, nil
+}
+
+// AddRemoteLink wraps AddRemoteLinkWithContext using the background context.
+func (s *IssueService) AddRemoteLink(issueID string, remotelink *RemoteLink) (*RemoteLink, *Response, error) {
+	return s.AddRemoteLinkWithContext(context.Background(), issueID, remotelink)
+}
+
+// UpdateRemoteLinkWithContext replaces the remote link linkID on the issue with remotelink.
+// Fields which are not set in remotelink are cleared.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-remote-links/#api-rest-api-2-issue-issueidorkey-remotelink-linkid-put
+func (s *IssueService) UpdateRemoteLinkWithContext(ctx context.Context, issueID string, linkID int, remotelink *RemoteLink) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/remotelink/%d", issueID, linkID)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, remotelink)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// UpdateRemoteLink wraps UpdateRemoteLinkWithContext using the background context.
+func (s *IssueService) UpdateRemoteLink(issueID string, linkID int, remotelink *RemoteLink) (*Response, error) {
+	return s.UpdateRemoteLinkWithContext(context.Background(), issueID, linkID, remotelink)
+}
+
+// DeleteRemoteLinkWithContext deletes the remote link linkID from the issue.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-remote-links/#api-rest-api-2-issue-issueidorkey-remotelink-linkid-delete
+func (s *IssueService) DeleteRemoteLinkWithContext(ctx context.Context, issueID string, linkID int) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/remotelink/%d", issueID, linkID)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// DeleteRemoteLink wraps DeleteRemoteLinkWithContext using the background context.
+func (s *IssueService) DeleteRemoteLink(issueID string, linkID int) (*Response, error) {
+	return s.DeleteRemoteLinkWithContext(context.Background(), issueID, linkID)
+}
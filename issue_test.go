@@ -1,6 +1,7 @@
 package jira
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/google/go-cmp/cmp"
@@ -35,6 +36,24 @@ func TestIssueService_Get_Success(t *testing.T) {
 	}
 }
 
+func TestIssueService_GetWithContext_Canceled(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10002", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected the canceled request not to be sent")
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	issue, _, err := testClient.Issue.GetWithContext(ctx, "10002", nil)
+	if issue != nil {
+		t.Errorf("Expected no issue, got %v", issue)
+	}
+	if err == nil {
+		t.Error("Expected an error for the canceled context")
+	}
+}
+
 func TestIssueService_Get_WithQuerySuccess(t *testing.T) {
 	setup()
 	defer teardown()
@@ -82,6 +101,55 @@ func TestIssueService_Create(t *testing.T) {
 	}
 }
 
+func TestIssueService_CreateWithPayload(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, "/rest/api/2/issue")
+
+		var payload map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("Error decoding payload: %s", err)
+		}
+		if transition, _ := payload["transition"].(map[string]interface{}); transition["id"] != "31" {
+			t.Errorf("Expected transition 31, got %v", payload["transition"])
+		}
+		update, _ := payload["update"].(map[string]interface{})
+		if labels, _ := update["labels"].([]interface{}); len(labels) != 1 || labels[0].(map[string]interface{})["add"] != "triaged" {
+			t.Errorf("Unexpected labels update %v", update["labels"])
+		}
+		metadata, _ := payload["historyMetadata"].(map[string]interface{})
+		if metadata["type"] != "myplugin:type" || metadata["actor"].(map[string]interface{})["id"] != "tony" {
+			t.Errorf("Unexpected history metadata %v", metadata)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"10000","key":"ED-24","self":"https://your-domain.atlassian.net/rest/api/2/issue/10000"}`)
+	})
+
+	payload := &IssueCreatePayload{
+		Fields: &IssueFields{
+			Summary: "example bug report",
+		},
+		Update: map[string][]FieldOperation{
+			"labels": {{FieldOperationAdd: "triaged"}},
+		},
+		Transition: &TransitionPayload{ID: "31"},
+		HistoryMetadata: &HistoryMetadata{
+			Type:  "myplugin:type",
+			Actor: &HistoryMetadataParticipant{ID: "tony", Type: "mysystem-user"},
+		},
+	}
+	issue, _, err := testClient.Issue.CreateWithPayload(payload)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if issue.Key != "ED-24" {
+		t.Errorf("Expected issue ED-24, got %s", issue.Key)
+	}
+}
+
 func TestIssueService_CreateThenGet(t *testing.T) {
 	setup()
 	defer teardown()
@@ -211,6 +279,10 @@ func TestIssueService_UpdateComment(t *testing.T) {
 	testMux.HandleFunc("/rest/api/2/issue/10000/comment/10001", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "PUT")
 		testRequestURL(t, r, "/rest/api/2/issue/10000/comment/10001")
+		b, _ := ioutil.ReadAll(r.Body)
+		if !strings.Contains(string(b), `"visibility":{"type":"role","value":"Administrators"}`) {
+			t.Errorf("Expected the visibility to be kept, got %s", b)
+		}
 
 		w.WriteHeader(http.StatusCreated)
 		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/2/issue/10010/comment/10001","id":"10001","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"body":"Lorem ipsum dolor sit amet, consectetur adipiscing elit. Pellentesque eget venenatis elit. Duis eu justo eget augue iaculis fermentum. Sed semper quam laoreet nisi egestas at posuere augue semper.","updateAuthor":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"created":"2016-03-16T04:22:37.356+0000","updated":"2016-03-16T04:22:37.356+0000","visibility":{"type":"role","value":"Administrators"}}`)
@@ -294,6 +366,61 @@ func TestIssueService_UpdateWorklogRecord(t *testing.T) {
 	}
 }
 
+func TestIssueService_AddWorklogRecord_AdjustEstimate(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10000/worklog", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, "/rest/api/2/issue/10000/worklog?adjustEstimate=manual&reduceBy=2h")
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"100028","issueId":"10002","timeSpent":"2h","timeSpentSeconds":7200}`)
+	})
+	options := &AddWorklogQueryOptions{AdjustEstimate: AdjustEstimateManual, ReduceBy: "2h"}
+	record, _, err := testClient.Issue.AddWorklogRecord("10000", &WorklogRecord{TimeSpent: "2h"}, WithQueryOptions(options))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if record.TimeSpentSeconds != 7200 {
+		t.Errorf("Expected 7200 seconds, got %d", record.TimeSpentSeconds)
+	}
+}
+
+func TestIssueService_GetWorklogRecord(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10000/worklog/100028", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/issue/10000/worklog/100028")
+
+		fmt.Fprint(w, `{"id":"100028","issueId":"10002","comment":"I did some work here.","timeSpent":"3h 20m","timeSpentSeconds":12000}`)
+	})
+
+	record, _, err := testClient.Issue.GetWorklogRecord("10000", "100028")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if record.ID != "100028" || record.TimeSpent != "3h 20m" {
+		t.Errorf("Unexpected record %+v", record)
+	}
+}
+
+func TestIssueService_DeleteWorklogRecord(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10000/worklog/100028", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, "/rest/api/2/issue/10000/worklog/100028?adjustEstimate=new&newEstimate=1d")
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	options := &DeleteWorklogQueryOptions{AdjustEstimate: AdjustEstimateNew, NewEstimate: "1d"}
+	if _, err := testClient.Issue.DeleteWorklogRecord("10000", "100028", WithQueryOptions(options)); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
 func TestIssueService_AddLink(t *testing.T) {
 	setup()
 	defer teardown()
@@ -334,6 +461,39 @@ func TestIssueService_AddLink(t *testing.T) {
 	}
 }
 
+func TestIssueService_GetLink(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issueLink/10001", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/issueLink/10001")
+		fmt.Fprint(w, `{"id":"10001","type":{"id":"1000","name":"Duplicate","inward":"Duplicated by","outward":"Duplicates"},
+			"inwardIssue":{"id":"10004","key":"PR-3"},"outwardIssue":{"id":"10004L","key":"PR-2"}}`)
+	})
+
+	link, _, err := testClient.Issue.GetLink("10001")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if link.Type.Name != "Duplicate" || link.InwardIssue.Key != "PR-3" || link.OutwardIssue.Key != "PR-2" {
+		t.Errorf("Unexpected link %+v", link)
+	}
+}
+
+func TestIssueService_DeleteLink(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issueLink/10001", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, "/rest/api/2/issueLink/10001")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Issue.DeleteLink("10001"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
 func TestIssueService_Get_Fields(t *testing.T) {
 	setup()
 	defer teardown()
@@ -395,6 +555,38 @@ func TestIssueService_Get_RenderedFields(t *testing.T) {
 	}
 }
 
+func TestIssueRenderedFields_CustomField(t *testing.T) {
+	var rendered IssueRenderedFields
+	data := `{"description":"<p>Hello</p>","environment":"<p>Linux</p>","customfield_10001":"<p>Acceptance <b>criteria</b></p>","customfield_10002":null}`
+	if err := json.Unmarshal([]byte(data), &rendered); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if rendered.Description != "<p>Hello</p>" || rendered.Environment != "<p>Linux</p>" {
+		t.Errorf("Unexpected rendered fields %+v", rendered)
+	}
+	if value, ok := rendered.CustomField("customfield_10001"); !ok || value != "<p>Acceptance <b>criteria</b></p>" {
+		t.Errorf("Unexpected rendered custom field %q", value)
+	}
+	if _, ok := rendered.CustomField("customfield_10002"); ok {
+		t.Error("Expected empty custom field not to be rendered")
+	}
+	if _, ok := rendered.Unknowns["description"]; ok {
+		t.Error("Expected known fields not to be in Unknowns")
+	}
+
+	out, err := json.Marshal(&rendered)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	var roundtrip IssueRenderedFields
+	if err := json.Unmarshal(out, &roundtrip); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if value, _ := roundtrip.CustomField("customfield_10001"); value != "<p>Acceptance <b>criteria</b></p>" {
+		t.Errorf("Expected custom field to survive marshalling, got %s", out)
+	}
+}
+
 func TestIssueService_DownloadAttachment(t *testing.T) {
 	var testAttachment = "Here is an attachment"
 
@@ -463,6 +655,9 @@ func TestIssueService_PostAttachment(t *testing.T) {
 	testMux.HandleFunc("/rest/api/2/issue/10000/attachments", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "POST")
 		testRequestURL(t, r, "/rest/api/2/issue/10000/attachments")
+		if got := r.Header.Get("X-Atlassian-Token"); got != "no-check" {
+			t.Errorf("Expected X-Atlassian-Token no-check. Got %s", got)
+		}
 		status := http.StatusOK
 
 		file, _, err := r.FormFile("file")
@@ -690,6 +885,129 @@ func TestIssueService_SearchPages(t *testing.T) {
 	}
 }
 
+func TestIssueService_BulkFetch(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/bulkfetch", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, "/rest/api/2/issue/bulkfetch")
+
+		var options BulkFetchOptions
+		if err := json.NewDecoder(r.Body).Decode(&options); err != nil {
+			t.Error(err)
+		}
+		if !reflect.DeepEqual(options.IssueIDsOrKeys, []string{"TEST-1", "TEST-2", "TEST-404"}) {
+			t.Errorf("Unexpected issueIdsOrKeys %v", options.IssueIDsOrKeys)
+		}
+		if !reflect.DeepEqual(options.Fields, []string{"summary"}) {
+			t.Errorf("Unexpected fields %v", options.Fields)
+		}
+		fmt.Fprint(w, `{"issues":[{"id":"10001","key":"TEST-1","fields":{"summary":"First"}},{"id":"10002","key":"TEST-2","fields":{"summary":"Second"}}],"issueErrors":[{"id":"TEST-404","errorMessage":"Issue does not exist or you do not have permission to see it."}]}`)
+	})
+
+	result, _, err := testClient.Issue.BulkFetch(&BulkFetchOptions{
+		IssueIDsOrKeys: []string{"TEST-1", "TEST-2", "TEST-404"},
+		Fields:         []string{"summary"},
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(result.Issues) != 2 || result.Issues[1].Fields.Summary != "Second" {
+		t.Errorf("Unexpected issues %+v", result.Issues)
+	}
+	if len(result.IssueErrors) != 1 || result.IssueErrors[0].ID != "TEST-404" {
+		t.Errorf("Unexpected issue errors %+v", result.IssueErrors)
+	}
+}
+
+func TestIssueService_BulkFetch_TooManyIssues(t *testing.T) {
+	setup()
+	defer teardown()
+
+	keys := make([]string, BulkFetchMaxIssues+1)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("TEST-%d", i)
+	}
+	if _, _, err := testClient.Issue.BulkFetch(&BulkFetchOptions{IssueIDsOrKeys: keys}); err == nil {
+		t.Error("Expected an error. Got none")
+	}
+	if _, _, err := testClient.Issue.BulkFetch(nil); err == nil {
+		t.Error("Expected an error for nil options. Got none")
+	}
+}
+
+func TestIssueService_SearchJQL(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/search/jql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestParams(t, r, map[string]string{"jql": "project = TEST", "maxResults": "2", "fields": "summary,status"})
+		fmt.Fprint(w, `{"issues":[{"id":"10001","key":"TEST-1"},{"id":"10002","key":"TEST-2"}],"nextPageToken":"CAEaAggD","isLast":false}`)
+	})
+
+	issues, resp, err := testClient.Issue.SearchJQL("project = TEST", &SearchJQLOptions{MaxResults: 2, Fields: []string{"summary", "status"}})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(issues) != 2 {
+		t.Errorf("Expected 2 issues. Got %d", len(issues))
+	}
+	if resp.NextPageToken != "CAEaAggD" || resp.IsLast {
+		t.Errorf("Unexpected paging values %q, %v", resp.NextPageToken, resp.IsLast)
+	}
+}
+
+func TestIssueService_SearchJQLPages(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/search/jql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.URL.Query().Get("nextPageToken") {
+		case "":
+			fmt.Fprint(w, `{"issues":[{"key":"TEST-1"},{"key":"TEST-2"}],"nextPageToken":"page2","isLast":false}`)
+		case "page2":
+			fmt.Fprint(w, `{"issues":[{"key":"TEST-3"}],"isLast":true}`)
+		default:
+			t.Errorf("Unexpected URL: %v", r.URL)
+		}
+	})
+
+	keys := []string{}
+	err := testClient.Issue.SearchJQLPages("project = TEST", nil, func(issue Issue) error {
+		keys = append(keys, issue.Key)
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if !reflect.DeepEqual(keys, []string{"TEST-1", "TEST-2", "TEST-3"}) {
+		t.Errorf("Unexpected issues %v", keys)
+	}
+}
+
+func TestIssueService_ApproximateCount(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/search/approximate-count", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, "/rest/api/2/search/approximate-count")
+
+		body, _ := ioutil.ReadAll(r.Body)
+		if !strings.Contains(string(body), `"jql":"project = TEST"`) {
+			t.Errorf("Expected jql in body. Got %s", body)
+		}
+		fmt.Fprint(w, `{"count":153}`)
+	})
+
+	count, _, err := testClient.Issue.ApproximateCount("project = TEST")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if count != 153 {
+		t.Errorf("Expected count 153. Got %d", count)
+	}
+}
+
 func TestIssueService_GetCustomFields(t *testing.T) {
 	setup()
 	defer teardown()
@@ -768,6 +1086,100 @@ func TestIssueService_GetTransitions(t *testing.T) {
 	if transitions[0].Fields["summary"].Required != false {
 		t.Errorf("First transition summary field should not be required")
 	}
+
+	field := transitions[0].Fields["summary"]
+	if field.Schema.Items != "option" || len(field.Operations) != 2 || len(field.AllowedValues) != 2 {
+		t.Errorf("Unexpected transition field %+v", field)
+	}
+	if required := transitions[0].RequiredFields(); len(required) != 0 {
+		t.Errorf("Expected no required fields, got %v", required)
+	}
+}
+
+func TestIssueService_GetTransitionsWithOptions(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/123/transitions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/issue/123/transitions?expand=transitions.fields&includeUnavailableTransitions=true")
+		fmt.Fprint(w, `{"transitions":[{"id":"2","name":"Close Issue","isAvailable":true,"fields":{
+			"resolution":{"required":true,"name":"Resolution","operations":["set"]},
+			"comment":{"required":false,"name":"Comment","operations":["add"]}}},
+			{"id":"711","name":"QA Review","isAvailable":false,"fields":{}}]}`)
+	})
+
+	transitions, _, err := testClient.Issue.GetTransitionsWithOptions("123", &GetTransitionsOptions{IncludeUnavailableTransitions: true})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(transitions) != 2 || !transitions[0].IsAvailable || transitions[1].IsAvailable {
+		t.Errorf("Unexpected transitions %+v", transitions)
+	}
+	if resolving := TransitionsWithField(transitions, "resolution"); len(resolving) != 1 || resolving[0].ID != "2" {
+		t.Errorf("Unexpected transitions with resolution %+v", resolving)
+	}
+}
+
+func TestTransition_SettableFields(t *testing.T) {
+	transition := Transition{Fields: map[string]TransitionField{
+		"resolution": {Operations: []string{"set"}},
+		"comment":    {Operations: []string{"add"}},
+		"labels":     {Operations: []string{"add", "set", "remove"}},
+	}}
+	if settable := transition.SettableFields(); !reflect.DeepEqual(settable, []string{"labels", "resolution"}) {
+		t.Errorf("Unexpected settable fields %v", settable)
+	}
+}
+
+func TestTransition_RequiredFields(t *testing.T) {
+	transition := Transition{Fields: map[string]TransitionField{
+		"resolution": {Required: true},
+		"comment":    {Required: false},
+		"assignee":   {Required: true},
+	}}
+	if required := transition.RequiredFields(); !reflect.DeepEqual(required, []string{"assignee", "resolution"}) {
+		t.Errorf("Unexpected required fields %v", required)
+	}
+}
+
+func TestIssueService_DoTransitionWithFields(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testAPIEndpoint := "/rest/api/2/issue/123/transitions"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("Got error: %v", err)
+		}
+		expected := map[string]interface{}{
+			"transition": map[string]interface{}{"id": "31"},
+			"fields": map[string]interface{}{
+				"resolution": map[string]interface{}{"name": "Done"},
+			},
+			"update": map[string]interface{}{
+				"comment": []interface{}{
+					map[string]interface{}{"add": map[string]interface{}{"body": "Fixed in 1.2"}},
+				},
+			},
+		}
+		if !reflect.DeepEqual(payload, expected) {
+			t.Errorf("Expected %v, got %v", expected, payload)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	fields := map[string]interface{}{"resolution": map[string]string{"name": "Done"}}
+	update := map[string][]FieldOperation{
+		"comment": {{FieldOperationAdd: map[string]string{"body": "Fixed in 1.2"}}},
+	}
+	if _, err := testClient.Issue.DoTransitionWithFields("123", "31", fields, update); err != nil {
+		t.Errorf("Got error: %v", err)
+	}
 }
 
 func TestIssueService_DoTransition(t *testing.T) {
@@ -800,6 +1212,77 @@ func TestIssueService_DoTransition(t *testing.T) {
 	}
 }
 
+func TestIssueService_DoTransitionWithMetadata(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testAPIEndpoint := "/rest/api/2/issue/123/transitions"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload CreateTransitionPayload
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("Got error: %v", err)
+		}
+		if payload.Transition.ID != "22" {
+			t.Errorf("Expected transition 22, got %s", payload.Transition.ID)
+		}
+		if payload.HistoryMetadata == nil || payload.HistoryMetadata.Cause == nil || payload.HistoryMetadata.Cause.ID != "build-42" {
+			t.Errorf("Unexpected history metadata %+v", payload.HistoryMetadata)
+		}
+		if payload.HistoryMetadata.ExtraData["pipeline"] != "deploy" {
+			t.Errorf("Unexpected extra data %v", payload.HistoryMetadata.ExtraData)
+		}
+	})
+
+	_, err := testClient.Issue.DoTransitionWithMetadata("123", "22", &HistoryMetadata{
+		Description: "Deployed to production",
+		Cause:       &HistoryMetadataParticipant{ID: "build-42", Type: "ci:build"},
+		ExtraData:   map[string]string{"pipeline": "deploy"},
+	})
+	if err != nil {
+		t.Errorf("Got error: %v", err)
+	}
+}
+
+func TestIssueService_UpdateWithPayload(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testAPIEndpoint := "/rest/api/2/issue/PROJ-9001"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, testAPIEndpoint+"?overrideEditableFlag=true")
+
+		var payload IssueUpdatePayload
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("Got error: %v", err)
+		}
+		if payload.HistoryMetadata == nil || payload.HistoryMetadata.Actor.ID != "sync-bot" {
+			t.Errorf("Unexpected history metadata %+v", payload.HistoryMetadata)
+		}
+		if ops := payload.Update["labels"]; len(ops) != 1 || ops[0][FieldOperationRemove] != "stale" {
+			t.Errorf("Unexpected update %v", payload.Update)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	payload := &IssueUpdatePayload{
+		Update: map[string][]FieldOperation{
+			"labels": {{FieldOperationRemove: "stale"}},
+		},
+		HistoryMetadata: &HistoryMetadata{
+			Actor: &HistoryMetadataParticipant{ID: "sync-bot", DisplayName: "Sync"},
+		},
+	}
+	if _, err := testClient.Issue.UpdateWithPayload("PROJ-9001", payload, &UpdateQueryOptions{OverrideEditableFlag: true}); err != nil {
+		t.Errorf("Got error: %v", err)
+	}
+}
+
 func TestIssueService_DoTransitionWithPayload(t *testing.T) {
 	setup()
 	defer teardown()
@@ -1354,7 +1837,10 @@ func TestIssueService_Delete(t *testing.T) {
 	defer teardown()
 	testMux.HandleFunc("/rest/api/2/issue/10002", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "DELETE")
-		testRequestURL(t, r, "/rest/api/2/issue/10002")
+		testRequestURL(t, r, "/rest/api/2/issue/10002?deleteSubtasks=true")
+		if r.ContentLength > 0 {
+			t.Errorf("Expected no request body, got %d bytes", r.ContentLength)
+		}
 
 		w.WriteHeader(http.StatusNoContent)
 		fmt.Fprint(w, `{}`)
@@ -1535,6 +2021,118 @@ func TestIssueService_GetWatchers(t *testing.T) {
 	}
 }
 
+func TestIssueService_GetWatchers_WithoutUserNames(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10002/watchers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/issue/10002/watchers")
+
+		fmt.Fprint(w, `{"self":"https://example.atlassian.net/rest/api/2/issue/EX-1/watchers","isWatching":false,"watchCount":2,"watchers":[{"self":"https://example.atlassian.net/rest/api/2/user?accountId=5b10a2844c20165700ede21g","accountId":"5b10a2844c20165700ede21g","displayName":"Mia Krystof","active":true},{"self":"https://example.atlassian.net/rest/api/2/user?accountId=5b10ac8d82e05b22cc7d4ef5","accountId":"5b10ac8d82e05b22cc7d4ef5","displayName":"Emma Richards","active":false}]}`)
+	})
+	testMux.HandleFunc("/rest/api/2/user", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Unexpected user lookup for a watcher without user name")
+	})
+
+	watchers, _, err := testClient.Issue.GetWatchers("10002")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+		return
+	}
+	if len(*watchers) != 2 {
+		t.Errorf("Expected 2 watchers, got: %d", len(*watchers))
+		return
+	}
+	if (*watchers)[0].AccountID != "5b10a2844c20165700ede21g" || (*watchers)[0].DisplayName != "Mia Krystof" || !(*watchers)[0].Active {
+		t.Errorf("Unexpected watcher: %+v", (*watchers)[0])
+	}
+	if (*watchers)[1].Active {
+		t.Error("Expected second watcher to be inactive")
+	}
+}
+
+func TestIssueService_GetWatchersList(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10002/watchers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/issue/10002/watchers")
+
+		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/2/issue/EX-1/watchers","isWatching":true,"watchCount":1,"watchers":[{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","key":"fred","displayName":"Fred F. User","active":true}]}`)
+	})
+
+	watches, _, err := testClient.Issue.GetWatchersList("10002")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+		return
+	}
+	if watches.WatchCount != 1 || !watches.IsWatching {
+		t.Errorf("Unexpected watches: %+v", watches)
+	}
+	if len(watches.Watchers) != 1 || watches.Watchers[0].User().Name != "fred" {
+		t.Errorf("Unexpected watchers: %+v", watches.Watchers)
+	}
+}
+
+func TestIssueService_GetVotes(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10002/votes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/issue/10002/votes")
+
+		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/2/issue/EX-1/votes","votes":2,"hasVoted":true,"voters":[{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":true},{"self":"http://www.example.com/jira/rest/api/2/user?username=jane","name":"jane","accountId":"5b10a2844c20165700ede21g","displayName":"Jane Doe","active":true}]}`)
+	})
+
+	votes, _, err := testClient.Issue.GetVotes("10002")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+		return
+	}
+	if votes == nil {
+		t.Error("Expected votes. Votes is nil")
+		return
+	}
+	if votes.Votes != 2 || !votes.HasVoted {
+		t.Errorf("Expected 2 votes with hasVoted, got: %+v", votes)
+	}
+	if len(votes.Voters) != 2 {
+		t.Errorf("Expected 2 voters, got: %d", len(votes.Voters))
+		return
+	}
+	if votes.Voters[1].AccountID != "5b10a2844c20165700ede21g" {
+		t.Errorf("Expected voter accountId, got: %s", votes.Voters[1].AccountID)
+	}
+}
+
+func TestIssueService_AddVote(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10002/votes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, "/rest/api/2/issue/10002/votes")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Issue.AddVote("10002"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestIssueService_RemoveVote(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10002/votes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, "/rest/api/2/issue/10002/votes")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Issue.RemoveVote("10002"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
 func TestIssueService_UpdateAssignee(t *testing.T) {
 	setup()
 	defer teardown()
@@ -1685,3 +2283,206 @@ func TestIssueService_GetRemoteLinks(t *testing.T) {
 		t.Errorf("First remote link object status should be resolved")
 	}
 }
+
+func TestIssueService_GetRemoteLink(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/PROJ-1/remotelink/10000", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/issue/PROJ-1/remotelink/10000")
+		fmt.Fprint(w, `{"id":10000,"self":"https://your-domain.atlassian.net/rest/api/2/issue/PROJ-1/remotelink/10000",
+			"globalId":"system=http://www.mycompany.com/support&id=1","relationship":"causes",
+			"object":{"url":"http://www.mycompany.com/support?id=1","title":"TSTSUP-111","status":{"resolved":true,"icon":{"title":"Case Closed"}}}}`)
+	})
+
+	remoteLink, _, err := testClient.Issue.GetRemoteLink("PROJ-1", 10000)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if remoteLink.ID != 10000 || remoteLink.Object.Title != "TSTSUP-111" || !remoteLink.Object.Status.Resolved || remoteLink.Object.Status.Icon.Title != "Case Closed" {
+		t.Errorf("Unexpected remote link %+v", remoteLink)
+	}
+}
+
+func TestIssueService_GetRemoteLinkByGlobalID(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/PROJ-1/remotelink", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/issue/PROJ-1/remotelink?globalId=system%3Dhttp%3A%2F%2Fwww.mycompany.com%2Fsupport%26id%3D1")
+		fmt.Fprint(w, `{"id":10000,"globalId":"system=http://www.mycompany.com/support&id=1","object":{"url":"http://www.mycompany.com/support?id=1","title":"TSTSUP-111"}}`)
+	})
+
+	remoteLink, _, err := testClient.Issue.GetRemoteLinkByGlobalID("PROJ-1", "system=http://www.mycompany.com/support&id=1")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if remoteLink.ID != 10000 || remoteLink.Object.Title != "TSTSUP-111" {
+		t.Errorf("Unexpected remote link %+v", remoteLink)
+	}
+}
+
+func TestIssueService_UpsertRemoteLink(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/PROJ-1/remotelink", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, "/rest/api/2/issue/PROJ-1/remotelink")
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"globalId":"build=42","object":{"url":"https://ci.example.com/builds/42","title":"Build #42"}}`+"\n" {
+			t.Errorf("Unexpected body %s", body)
+		}
+		fmt.Fprint(w, `{"id":10000,"self":"https://your-domain.atlassian.net/rest/api/2/issue/PROJ-1/remotelink/10000"}`)
+	})
+
+	remoteLink, _, err := testClient.Issue.UpsertRemoteLink("PROJ-1", &RemoteLink{
+		GlobalID: "build=42",
+		Object:   &RemoteLinkObject{URL: "https://ci.example.com/builds/42", Title: "Build #42"},
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if remoteLink.ID != 10000 {
+		t.Errorf("Unexpected remote link %+v", remoteLink)
+	}
+
+	if _, _, err := testClient.Issue.UpsertRemoteLink("PROJ-1", &RemoteLink{}); err == nil {
+		t.Error("Expected an error without global ID. Got none")
+	}
+	if _, _, err := testClient.Issue.UpsertRemoteLink("PROJ-1", nil); err == nil {
+		t.Error("Expected an error without remote link. Got none")
+	}
+}
+
+func TestIssueService_DeleteRemoteLinkByGlobalID(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/PROJ-1/remotelink", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, "/rest/api/2/issue/PROJ-1/remotelink?globalId=build%3D42")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Issue.DeleteRemoteLinkByGlobalID("PROJ-1", "build=42"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestIssueService_AddRemoteLink(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/PROJ-1/remotelink", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, "/rest/api/2/issue/PROJ-1/remotelink")
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"globalId":"build=42","object":{"url":"https://ci.example.com/builds/42","title":"Build #42","status":{"resolved":false}}}`+"\n" {
+			t.Errorf("Unexpected body %s", body)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":10000,"self":"https://your-domain.atlassian.net/rest/api/2/issue/PROJ-1/remotelink/10000"}`)
+	})
+
+	remoteLink, _, err := testClient.Issue.AddRemoteLink("PROJ-1", &RemoteLink{
+		GlobalID: "build=42",
+		Object:   &RemoteLinkObject{URL: "https://ci.example.com/builds/42", Title: "Build #42", Status: &RemoteLinkStatus{}},
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if remoteLink.ID != 10000 || remoteLink.Self == "" {
+		t.Errorf("Unexpected remote link %+v", remoteLink)
+	}
+}
+
+func TestIssueService_UpdateRemoteLink(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/PROJ-1/remotelink/10000", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, "/rest/api/2/issue/PROJ-1/remotelink/10000")
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"object":{"url":"https://ci.example.com/builds/43","title":"Build #43"}}`+"\n" {
+			t.Errorf("Unexpected body %s", body)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	remoteLink := &RemoteLink{Object: &RemoteLinkObject{URL: "https://ci.example.com/builds/43", Title: "Build #43"}}
+	if _, err := testClient.Issue.UpdateRemoteLink("PROJ-1", 10000, remoteLink); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestIssueService_DeleteRemoteLink(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/PROJ-1/remotelink/10000", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, "/rest/api/2/issue/PROJ-1/remotelink/10000")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Issue.DeleteRemoteLink("PROJ-1", 10000); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestIssueService_GetComments(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10000/comment", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/issue/10000/comment?maxResults=2&orderBy=-created")
+		fmt.Fprint(w, `{"startAt":0,"maxResults":2,"total":3,"comments":[{"id":"10003","body":"Internal","visibility":{"type":"role","value":"Developers"}},{"id":"10002","body":"Public"}]}`)
+	})
+
+	comments, resp, err := testClient.Issue.GetComments("10000", &GetCommentsOptions{MaxResults: 2, OrderBy: "-created"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(comments) != 2 || comments[0].Visibility.Type != CommentVisibilityRole {
+		t.Errorf("Unexpected comments %+v", comments)
+	}
+	if resp.Total != 3 || !resp.HasNextPage() {
+		t.Errorf("Expected a next page, got %+v", resp.PageInfo)
+	}
+}
+
+func TestIssueService_GetAllComments(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10000/comment", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if r.URL.Query().Get("startAt") == "2" {
+			fmt.Fprint(w, `{"startAt":2,"maxResults":2,"total":3,"comments":[{"id":"10003"}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"startAt":0,"maxResults":2,"total":3,"comments":[{"id":"10001"},{"id":"10002"}]}`)
+	})
+
+	comments, err := testClient.Issue.GetAllComments("10000", &GetCommentsOptions{MaxResults: 2, StartAt: 5})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(comments) != 3 || comments[2].ID != "10003" {
+		t.Errorf("Unexpected comments %+v", comments)
+	}
+}
+
+func TestIssueService_Count(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/search?jql=project+%3D+EX&maxResults=0&fields=id")
+		fmt.Fprint(w, `{"startAt":0,"maxResults":0,"total":1234,"issues":[]}`)
+	})
+
+	count, _, err := testClient.Issue.Count("project = EX")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if count != 1234 {
+		t.Errorf("Expected 1234 issues, got %d", count)
+	}
+}
@@ -0,0 +1,209 @@
+package jira
+
+import (
+	"reflect"
+	"time"
+)
+
+// DiffIssues returns the update payload, which changes the editable fields of before to the values of after.
+// It is meant for services synchronizing issues from another system:
+// instead of hand-crafting the body of every edit, the desired state is set on a copy of the issue
+// and the payload is sent with IssueService.UpdateWithPayload.
+//
+// The payload only consists of field operations in Update:
+// labels, components and versions are added and removed item by item, all other fields are set.
+// Custom fields in IssueFields.Unknowns are compared as a whole and set if they differ.
+// Fields, which can not be edited, like status, created or the time tracking values, are ignored.
+// DiffIssues returns nil if there is no difference.
+func DiffIssues(before, after *Issue) *IssueUpdatePayload {
+	var b, a IssueFields
+	if before != nil && before.Fields != nil {
+		b = *before.Fields
+	}
+	if after != nil && after.Fields != nil {
+		a = *after.Fields
+	}
+
+	update := map[string][]FieldOperation{}
+	set := func(field string, value interface{}) {
+		update[field] = []FieldOperation{{FieldOperationSet: value}}
+	}
+
+	if a.Summary != b.Summary {
+		set("summary", a.Summary)
+	}
+	if a.Description != b.Description {
+		set("description", nullIfEmpty(a.Description))
+	}
+	if !time.Time(a.Duedate).Equal(time.Time(b.Duedate)) {
+		if time.Time(a.Duedate).IsZero() {
+			set("duedate", nil)
+		} else {
+			set("duedate", time.Time(a.Duedate).Format("2006-01-02"))
+		}
+	}
+	if !sameEntity(a.Type.ID, a.Type.Name, b.Type.ID, b.Type.Name) {
+		set("issuetype", entityRef(a.Type.ID, a.Type.Name))
+	}
+	if !samePriority(a.Priority, b.Priority) {
+		if a.Priority == nil {
+			set("priority", nil)
+		} else {
+			set("priority", entityRef(a.Priority.ID, a.Priority.Name))
+		}
+	}
+	if userKey(a.Assignee) != userKey(b.Assignee) {
+		set("assignee", userRef(a.Assignee))
+	}
+	if userKey(a.Reporter) != userKey(b.Reporter) {
+		set("reporter", userRef(a.Reporter))
+	}
+
+	diffItems(update, "labels", labelItems(b.Labels), labelItems(a.Labels))
+	diffItems(update, "components", componentItems(b.Components), componentItems(a.Components))
+	diffItems(update, "fixVersions", fixVersionItems(b.FixVersions), fixVersionItems(a.FixVersions))
+	diffItems(update, "versions", affectsVersionItems(b.AffectsVersions), affectsVersionItems(a.AffectsVersions))
+
+	for field, value := range a.Unknowns {
+		if old, ok := b.Unknowns[field]; !ok || !reflect.DeepEqual(old, value) {
+			set(field, value)
+		}
+	}
+	for field := range b.Unknowns {
+		if _, ok := a.Unknowns[field]; !ok {
+			set(field, nil)
+		}
+	}
+
+	if len(update) == 0 {
+		return nil
+	}
+	return &IssueUpdatePayload{Update: update}
+}
+
+// diffItem is an item of a multi value field, identified by id and name (see sameEntity) and sent as value
+type diffItem struct {
+	id    string
+	name  string
+	value interface{}
+}
+
+// containsItem reports if items contains an item, which is the same entity as item
+func containsItem(items []diffItem, item diffItem) bool {
+	for _, other := range items {
+		if sameEntity(item.id, item.name, other.id, other.name) {
+			return true
+		}
+	}
+	return false
+}
+
+// diffItems adds the add and remove operations, which turn the before items into the after items, to update
+func diffItems(update map[string][]FieldOperation, field string, before, after []diffItem) {
+	var operations []FieldOperation
+	for _, item := range before {
+		if !containsItem(after, item) {
+			operations = append(operations, FieldOperation{FieldOperationRemove: item.value})
+		}
+	}
+	var added []diffItem
+	for _, item := range after {
+		if !containsItem(before, item) && !containsItem(added, item) {
+			operations = append(operations, FieldOperation{FieldOperationAdd: item.value})
+			added = append(added, item)
+		}
+	}
+	if len(operations) > 0 {
+		update[field] = operations
+	}
+}
+
+func labelItems(labels []string) []diffItem {
+	items := make([]diffItem, 0, len(labels))
+	for _, label := range labels {
+		items = append(items, diffItem{name: label, value: label})
+	}
+	return items
+}
+
+func componentItems(components []*Component) []diffItem {
+	items := make([]diffItem, 0, len(components))
+	for _, component := range components {
+		if component != nil {
+			items = append(items, diffItem{id: component.ID, name: component.Name, value: entityRef(component.ID, component.Name)})
+		}
+	}
+	return items
+}
+
+func fixVersionItems(versions []*FixVersion) []diffItem {
+	items := make([]diffItem, 0, len(versions))
+	for _, version := range versions {
+		if version != nil {
+			items = append(items, diffItem{id: version.ID, name: version.Name, value: entityRef(version.ID, version.Name)})
+		}
+	}
+	return items
+}
+
+func affectsVersionItems(versions []*AffectsVersion) []diffItem {
+	items := make([]diffItem, 0, len(versions))
+	for _, version := range versions {
+		if version != nil {
+			items = append(items, diffItem{id: version.ID, name: version.Name, value: entityRef(version.ID, version.Name)})
+		}
+	}
+	return items
+}
+
+// entityRef references an entity by ID, or by name if the ID is unknown
+func entityRef(id, name string) map[string]string {
+	if id != "" {
+		return map[string]string{"id": id}
+	}
+	return map[string]string{"name": name}
+}
+
+// sameEntity reports if two references are the same entity. They are compared by ID if both have an ID,
+// otherwise by name, e.g. if the desired state only names the issue type while JIRA returned it with its ID.
+func sameEntity(id1, name1, id2, name2 string) bool {
+	if id1 != "" && id2 != "" {
+		return id1 == id2
+	}
+	return name1 == name2
+}
+
+func samePriority(p1, p2 *Priority) bool {
+	if p1 == nil || p2 == nil {
+		return p1 == p2
+	}
+	return sameEntity(p1.ID, p1.Name, p2.ID, p2.Name)
+}
+
+// userRef references a user by account ID, or by name on JIRA Server
+func userRef(user *User) interface{} {
+	if user == nil {
+		return nil
+	}
+	if user.AccountID != "" {
+		return map[string]string{"accountId": user.AccountID}
+	}
+	return map[string]string{"name": user.Name}
+}
+
+func userKey(user *User) string {
+	if user == nil {
+		return ""
+	}
+	if user.AccountID != "" {
+		return "accountId:" + user.AccountID
+	}
+	return "name:" + user.Name
+}
+
+func nullIfEmpty(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
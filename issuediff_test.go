@@ -0,0 +1,96 @@
+package jira
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/trivago/tgo/tcontainer"
+)
+
+func TestDiffIssues_NoChange(t *testing.T) {
+	issue := &Issue{Fields: &IssueFields{Summary: "Same", Labels: []string{"a"}}}
+	if payload := DiffIssues(issue, issue); payload != nil {
+		t.Errorf("Expected no payload, got %+v", payload.Update)
+	}
+}
+
+func TestDiffIssues(t *testing.T) {
+	before := &Issue{Fields: &IssueFields{
+		Summary:     "Old summary",
+		Description: "Old description",
+		Priority:    &Priority{ID: "3"},
+		Assignee:    &User{AccountID: "5b10a2844c20165700ede21g"},
+		Labels:      []string{"keep", "drop"},
+		Components:  []*Component{{ID: "10000"}},
+		FixVersions: []*FixVersion{{ID: "10100"}},
+		Unknowns:    tcontainer.MarshalMap{"customfield_10001": 3.0, "customfield_10002": "gone"},
+	}}
+	after := &Issue{Fields: &IssueFields{
+		Summary:     "New summary",
+		Priority:    &Priority{ID: "3"},
+		Duedate:     Date(time.Date(2020, 5, 17, 0, 0, 0, 0, time.UTC)),
+		Labels:      []string{"keep", "new"},
+		Components:  []*Component{{ID: "10000"}, {Name: "Backend"}},
+		FixVersions: []*FixVersion{{ID: "10100"}},
+		Unknowns:    tcontainer.MarshalMap{"customfield_10001": 5.0},
+	}}
+
+	payload := DiffIssues(before, after)
+	if payload == nil {
+		t.Fatal("Expected payload, got nil")
+	}
+	if payload.Fields != nil {
+		t.Errorf("Expected no fields, got %+v", payload.Fields)
+	}
+
+	got, err := json.Marshal(payload.Update)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	expected := `{"assignee":[{"set":null}],"components":[{"add":{"name":"Backend"}}],"customfield_10001":[{"set":5}],"customfield_10002":[{"set":null}],"description":[{"set":null}],"duedate":[{"set":"2020-05-17"}],"labels":[{"remove":"drop"},{"add":"new"}],"summary":[{"set":"New summary"}]}`
+	if string(got) != expected {
+		t.Errorf("Unexpected update\n got: %s\nwant: %s", got, expected)
+	}
+}
+
+func TestDiffIssues_ByName(t *testing.T) {
+	// as returned by JIRA, with IDs and names
+	before := &Issue{Fields: &IssueFields{
+		Type:        IssueType{ID: "10001", Name: "Bug"},
+		Priority:    &Priority{ID: "3", Name: "Medium"},
+		Components:  []*Component{{ID: "10000", Name: "Backend"}, {ID: "10001", Name: "Frontend"}},
+		FixVersions: []*FixVersion{{ID: "10100", Name: "1.0"}},
+	}}
+	// the desired state, only with names
+	after := &Issue{Fields: &IssueFields{
+		Type:        IssueType{Name: "Bug"},
+		Priority:    &Priority{Name: "Medium"},
+		Components:  []*Component{{Name: "Backend"}, {Name: "API"}},
+		FixVersions: []*FixVersion{{Name: "1.0"}},
+	}}
+
+	payload := DiffIssues(before, after)
+	if payload == nil {
+		t.Fatal("Expected payload, got nil")
+	}
+	got, err := json.Marshal(payload.Update)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	expected := `{"components":[{"remove":{"id":"10001"}},{"add":{"name":"API"}}]}`
+	if string(got) != expected {
+		t.Errorf("Unexpected update\n got: %s\nwant: %s", got, expected)
+	}
+}
+
+func TestDiffIssues_Users(t *testing.T) {
+	before := &Issue{Fields: &IssueFields{Reporter: &User{Name: "fred"}}}
+	after := &Issue{Fields: &IssueFields{Reporter: &User{Name: "barney"}, Assignee: &User{AccountID: "abc"}}}
+
+	got, _ := json.Marshal(DiffIssues(before, after).Update)
+	expected := `{"assignee":[{"set":{"accountId":"abc"}}],"reporter":[{"set":{"name":"barney"}}]}`
+	if string(got) != expected {
+		t.Errorf("Unexpected update\n got: %s\nwant: %s", got, expected)
+	}
+}
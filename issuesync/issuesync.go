@@ -0,0 +1,238 @@
+// Package issuesync mirrors the issues of a JQL scope into a local snapshot.
+//
+// The first Sync loads all issues of the scope. Every following Sync only searches
+// the issues updated since the last one (using an "updated >=" watermark),
+// compares them with the snapshot and emits typed change events,
+// optionally including the changelog entries written since the previous state of the issue.
+//
+// Deleted issues and issues moved out of the scope are not detected by the incremental sync.
+// A full resync (by starting with an empty snapshot) picks those up.
+package issuesync
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira"
+)
+
+// DefaultOverlap is the default time the incremental search reaches back before the watermark.
+// JQL only supports minute precision and the search index may lag behind,
+// so the search overlaps with the previous one. Issues which did not change are skipped.
+const DefaultOverlap = 2 * time.Minute
+
+// EventType is the kind of change of an Event
+type EventType string
+
+// These constants are the types of events emitted by Syncer.SyncWithContext
+const (
+	// EventCreated is emitted for issues which are not in the snapshot yet
+	EventCreated EventType = "created"
+	// EventUpdated is emitted for issues of the snapshot which were updated
+	EventUpdated EventType = "updated"
+)
+
+// Event describes the change of one issue
+type Event struct {
+	Type EventType
+	// Issue is the current state of the issue
+	Issue *jira.Issue
+	// Previous is the state of the issue in the snapshot, nil for EventCreated
+	Previous *jira.Issue
+	// Changes are the changelog entries written after the previous state.
+	// They are only loaded for EventUpdated and if Syncer.Changelog is set.
+	Changes []jira.ChangelogHistory
+}
+
+// Snapshot is the local state of the issues of the scope
+type Snapshot struct {
+	// Issues by issue ID
+	Issues map[string]*jira.Issue
+	// Watermark is the latest update time of all issues of the snapshot
+	Watermark time.Time
+}
+
+// Syncer maintains the snapshot of the issues matching JQL
+type Syncer struct {
+	client *jira.Client
+
+	// JQL selects the issues to sync. It must not contain an ORDER BY clause.
+	JQL string
+	// Fields are the fields loaded of every issue. "updated" is always loaded.
+	// If empty, all navigable fields are loaded.
+	Fields []string
+	// Changelog enables loading the changelog entries of updated issues
+	Changelog bool
+	// Overlap is the time the incremental search reaches back before the watermark
+	Overlap time.Duration
+	// Snapshot is the local state, it is updated by Sync.
+	// It can be set to the snapshot of a previous run to continue syncing incrementally.
+	Snapshot *Snapshot
+
+	location *time.Location
+}
+
+// New returns a Syncer for the issues matching jql with an empty snapshot
+func New(client *jira.Client, jql string) *Syncer {
+	return &Syncer{
+		client:  client,
+		JQL:     jql,
+		Overlap: DefaultOverlap,
+		Snapshot: &Snapshot{
+			Issues: map[string]*jira.Issue{},
+		},
+	}
+}
+
+// SyncWithContext searches the issues updated since the last sync, calls f with an event for every changed issue
+// and updates the snapshot. The issues are processed in the order of their update time.
+// If f returns an error, SyncWithContext stops and returns it. The snapshot contains the changes of all events
+// f returned nil for, so the next sync continues with the remaining changes.
+func (s *Syncer) SyncWithContext(ctx context.Context, f func(Event) error) error {
+	if s.Snapshot == nil {
+		s.Snapshot = &Snapshot{}
+	}
+	if s.Snapshot.Issues == nil {
+		s.Snapshot.Issues = map[string]*jira.Issue{}
+	}
+
+	jql, err := s.searchJQL(ctx)
+	if err != nil {
+		return err
+	}
+
+	options := &jira.SearchJQLOptions{MaxResults: 100}
+	if len(s.Fields) > 0 {
+		options.Fields = append([]string{"updated"}, s.Fields...)
+	} else {
+		options.Fields = []string{"*navigable"}
+	}
+
+	return s.client.Issue.SearchJQLPagesWithContext(ctx, jql, options, func(issue jira.Issue) error {
+		return s.process(ctx, issue, f)
+	})
+}
+
+// Sync wraps SyncWithContext using the background context.
+func (s *Syncer) Sync(f func(Event) error) error {
+	return s.SyncWithContext(context.Background(), f)
+}
+
+// searchJQL returns the JQL of the next search, restricted to the issues updated since the watermark
+func (s *Syncer) searchJQL(ctx context.Context) (string, error) {
+	if s.Snapshot.Watermark.IsZero() {
+		return fmt.Sprintf("(%s) ORDER BY updated ASC", s.JQL), nil
+	}
+
+	// JQL dates are interpreted in the time zone of the authenticated user
+	if s.location == nil {
+		s.location = time.UTC
+		user, _, err := s.client.User.GetSelfWithContext(ctx)
+		if err != nil {
+			return "", err
+		}
+		if location, err := time.LoadLocation(user.TimeZone); err == nil && user.TimeZone != "" {
+			s.location = location
+		}
+	}
+
+	since := s.Snapshot.Watermark.Add(-s.Overlap).In(s.location)
+	return fmt.Sprintf("(%s) AND updated >= \"%s\" ORDER BY updated ASC", s.JQL, since.Format("2006/01/02 15:04")), nil
+}
+
+// process compares the issue with the snapshot, emits the event and updates the snapshot
+func (s *Syncer) process(ctx context.Context, issue jira.Issue, f func(Event) error) error {
+	updated := updatedAt(&issue)
+	previous, exists := s.Snapshot.Issues[issue.ID]
+
+	event := Event{Type: EventCreated, Issue: &issue}
+	if exists {
+		if !updated.After(updatedAt(previous)) {
+			// already seen in the overlap of the previous search
+			return nil
+		}
+		event.Type = EventUpdated
+		event.Previous = previous
+		if s.Changelog {
+			changes, err := s.changesSince(ctx, issue.Key, updatedAt(previous))
+			if err != nil {
+				return err
+			}
+			event.Changes = changes
+		}
+	}
+
+	if err := f(event); err != nil {
+		return err
+	}
+
+	s.Snapshot.Issues[issue.ID] = &issue
+	if updated.After(s.Snapshot.Watermark) {
+		s.Snapshot.Watermark = updated
+	}
+	return nil
+}
+
+// changelogPage is one page of the changelog of an issue
+type changelogPage struct {
+	StartAt    int                     `json:"startAt"`
+	MaxResults int                     `json:"maxResults"`
+	Total      int                     `json:"total"`
+	IsLast     bool                    `json:"isLast"`
+	Values     []jira.ChangelogHistory `json:"values"`
+}
+
+// changesSince pages through the changelog of the issue and returns the entries created after since
+func (s *Syncer) changesSince(ctx context.Context, issueKey string, since time.Time) ([]jira.ChangelogHistory, error) {
+	var changes []jira.ChangelogHistory
+	startAt := 0
+	for {
+		apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/changelog?startAt=%d&maxResults=100", issueKey, startAt)
+		req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+		if err != nil {
+			return nil, err
+		}
+		page := new(changelogPage)
+		resp, err := s.client.Do(req, page)
+		if err != nil {
+			return nil, jira.NewJiraError(resp, err)
+		}
+
+		for _, history := range page.Values {
+			created, err := time.Parse("2006-01-02T15:04:05.999-0700", history.Created)
+			if err != nil {
+				return nil, err
+			}
+			if created.After(since) {
+				changes = append(changes, history)
+			}
+		}
+
+		startAt += len(page.Values)
+		if page.IsLast || len(page.Values) == 0 || startAt >= page.Total {
+			return changes, nil
+		}
+	}
+}
+
+func updatedAt(issue *jira.Issue) time.Time {
+	if issue == nil || issue.Fields == nil {
+		return time.Time{}
+	}
+	return time.Time(issue.Fields.Updated)
+}
+
+// FieldChanges returns the changelog items of the event, which changed the given field, e.g. "status"
+func (e Event) FieldChanges(field string) []jira.ChangelogItems {
+	var items []jira.ChangelogItems
+	for _, history := range e.Changes {
+		for _, item := range history.Items {
+			if strings.EqualFold(item.Field, field) {
+				items = append(items, item)
+			}
+		}
+	}
+	return items
+}
@@ -0,0 +1,125 @@
+package issuesync
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	jira "github.com/andygrunwald/go-jira"
+)
+
+func newTestSyncer(t *testing.T, mux *http.ServeMux) (*Syncer, func()) {
+	server := httptest.NewServer(mux)
+	client, err := jira.NewClient(nil, server.URL)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	return New(client, "project = EX"), server.Close
+}
+
+func TestSyncer_Sync(t *testing.T) {
+	var searches []string
+	updated := "2020-05-17T10:00:00.000+0000"
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/api/2/search/jql", func(w http.ResponseWriter, r *http.Request) {
+		searches = append(searches, r.URL.Query().Get("jql"))
+		fmt.Fprintf(w, `{"issues":[{"id":"1","key":"EX-1","fields":{"summary":"One","updated":"2020-05-17T09:00:00.000+0000"}},{"id":"2","key":"EX-2","fields":{"summary":"Two","updated":"%s"}}],"isLast":true}`, updated)
+	})
+	mux.HandleFunc("/rest/api/2/myself", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"accountId":"abc","timeZone":"UTC"}`)
+	})
+	mux.HandleFunc("/rest/api/2/issue/EX-2/changelog", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"startAt":0,"maxResults":100,"total":2,"isLast":true,"values":[{"id":"100","created":"2020-05-17T09:30:00.000+0000","items":[{"field":"summary","toString":"Old"}]},{"id":"101","created":"2020-05-17T10:30:00.000+0000","items":[{"field":"status","fromString":"Open","toString":"Done"}]}]}`)
+	})
+	syncer, teardown := newTestSyncer(t, mux)
+	defer teardown()
+	syncer.Changelog = true
+
+	var events []Event
+	collect := func(e Event) error {
+		events = append(events, e)
+		return nil
+	}
+
+	if err := syncer.Sync(collect); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(events) != 2 || events[0].Type != EventCreated || events[1].Type != EventCreated {
+		t.Fatalf("Expected 2 created events, got %+v", events)
+	}
+	if searches[0] != "(project = EX) ORDER BY updated ASC" {
+		t.Errorf("Unexpected JQL of the full sync: %s", searches[0])
+	}
+
+	// second sync: EX-1 unchanged, EX-2 updated
+	events = nil
+	updated = "2020-05-17T11:00:00.000+0000"
+	if err := syncer.Sync(collect); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if searches[1] != `(project = EX) AND updated >= "2020/05/17 09:58" ORDER BY updated ASC` {
+		t.Errorf("Unexpected JQL of the incremental sync: %s", searches[1])
+	}
+	if len(events) != 1 || events[0].Type != EventUpdated || events[0].Issue.Key != "EX-2" {
+		t.Fatalf("Expected 1 updated event of EX-2, got %+v", events)
+	}
+	if events[0].Previous == nil || len(events[0].Changes) != 1 || events[0].Changes[0].Id != "101" {
+		t.Errorf("Unexpected changes %+v", events[0].Changes)
+	}
+	if items := events[0].FieldChanges("Status"); len(items) != 1 || items[0].ToString != "Done" {
+		t.Errorf("Unexpected status changes %+v", items)
+	}
+	if len(syncer.Snapshot.Issues) != 2 {
+		t.Errorf("Expected 2 issues in the snapshot, got %d", len(syncer.Snapshot.Issues))
+	}
+}
+
+func TestSyncer_Sync_StopOnError(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/api/2/search/jql", func(w http.ResponseWriter, r *http.Request) {
+		if !strings.Contains(r.URL.Query().Get("fields"), "updated") {
+			t.Errorf("Expected updated field to be requested, got %s", r.URL.Query().Get("fields"))
+		}
+		fmt.Fprint(w, `{"issues":[{"id":"1","key":"EX-1","fields":{"updated":"2020-05-17T09:00:00.000+0000"}},{"id":"2","key":"EX-2","fields":{"updated":"2020-05-17T10:00:00.000+0000"}}],"isLast":true}`)
+	})
+	syncer, teardown := newTestSyncer(t, mux)
+	defer teardown()
+	syncer.Fields = []string{"summary"}
+
+	err := syncer.Sync(func(e Event) error {
+		if e.Issue.Key == "EX-2" {
+			return fmt.Errorf("failed")
+		}
+		return nil
+	})
+	if err == nil {
+		t.Fatal("Expected error")
+	}
+	if _, ok := syncer.Snapshot.Issues["2"]; ok {
+		t.Error("Expected failed issue not to be in the snapshot")
+	}
+	if _, ok := syncer.Snapshot.Issues["1"]; !ok {
+		t.Error("Expected processed issue to be in the snapshot")
+	}
+}
+
+func TestSyncer_SyncWithContext_Cancelled(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/api/2/search/jql", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected no search with a cancelled context")
+	})
+	syncer, teardown := newTestSyncer(t, mux)
+	defer teardown()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := syncer.SyncWithContext(ctx, func(e Event) error { return nil }); err == nil {
+		t.Fatal("Expected an error with a cancelled context")
+	}
+	if !syncer.Snapshot.Watermark.IsZero() {
+		t.Error("Expected the snapshot to be unchanged")
+	}
+}
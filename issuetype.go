@@ -0,0 +1,318 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+// IssueTypeService handles issue types for the JIRA instance / API.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issuetype
+type IssueTypeService struct {
+	client *Client
+}
+
+// CreateIssueTypeOptions are passed to the IssueTypeService.Create function to create a new JIRA issue type
+type CreateIssueTypeOptions struct {
+	Name        string `json:"name" structs:"name"`
+	Description string `json:"description,omitempty" structs:"description,omitempty"`
+	// Type is either "standard" or "subtask"
+	Type string `json:"type,omitempty" structs:"type,omitempty"`
+}
+
+// Avatar represents an avatar image which is attached to an entity like an issue type or a project.
+type Avatar struct {
+	ID             string            `json:"id,omitempty" structs:"id,omitempty"`
+	Owner          string            `json:"owner,omitempty" structs:"owner,omitempty"`
+	IsSystemAvatar bool              `json:"isSystemAvatar,omitempty" structs:"isSystemAvatar,omitempty"`
+	IsSelected     bool              `json:"isSelected,omitempty" structs:"isSelected,omitempty"`
+	IsDeletable    bool              `json:"isDeletable,omitempty" structs:"isDeletable,omitempty"`
+	Selected       bool              `json:"selected,omitempty" structs:"selected,omitempty"`
+	URLs           map[string]string `json:"urls,omitempty" structs:"urls,omitempty"`
+}
+
+// AvatarCropping represents the cropping instructions of a temporary avatar.
+// It is returned when a temporary avatar is stored and needs to be sent back
+// (optionally adjusted) to confirm the avatar.
+type AvatarCropping struct {
+	CropperWidth   int    `json:"cropperWidth" structs:"cropperWidth"`
+	CropperOffsetX int    `json:"cropperOffsetX" structs:"cropperOffsetX"`
+	CropperOffsetY int    `json:"cropperOffsetY" structs:"cropperOffsetY"`
+	URL            string `json:"url,omitempty" structs:"url,omitempty"`
+	NeedsCropping  bool   `json:"needsCropping" structs:"needsCropping"`
+}
+
+// These constants are the levels of the default issue type hierarchy
+const (
+	IssueTypeHierarchyLevelSubtask = -1
+	IssueTypeHierarchyLevelBase    = 0
+	IssueTypeHierarchyLevelEpic    = 1
+)
+
+// IssueTypeHierarchyLevel represents a level of the issue type hierarchy, like "Epic", "Story" or a custom level above epics
+type IssueTypeHierarchyLevel struct {
+	ID           string `json:"id,omitempty" structs:"id,omitempty"`
+	Name         string `json:"name,omitempty" structs:"name,omitempty"`
+	Level        int    `json:"level" structs:"level"`
+	AboveLevelID string `json:"aboveLevelId,omitempty" structs:"aboveLevelId,omitempty"`
+	BelowLevelID string `json:"belowLevelId,omitempty" structs:"belowLevelId,omitempty"`
+	// IssueTypeIDs are the IDs of the issue types on this level
+	IssueTypeIDs []string `json:"issueTypeIds,omitempty" structs:"issueTypeIds,omitempty"`
+}
+
+// IssueTypeHierarchy represents the issue type hierarchy of the instance, ordered from the lowest to the highest level
+type IssueTypeHierarchy struct {
+	Levels []IssueTypeHierarchyLevel `json:"levels" structs:"levels"`
+}
+
+// Level returns the hierarchy level of the issue type with the given ID, or nil if the issue type is not part of the hierarchy
+func (h *IssueTypeHierarchy) Level(issueTypeID string) *IssueTypeHierarchyLevel {
+	for i := range h.Levels {
+		for _, id := range h.Levels[i].IssueTypeIDs {
+			if id == issueTypeID {
+				return &h.Levels[i]
+			}
+		}
+	}
+	return nil
+}
+
+// ProjectIssueTypeHierarchyLevel represents a level of the issue type hierarchy of a project
+type ProjectIssueTypeHierarchyLevel struct {
+	EntityID   string      `json:"entityId,omitempty" structs:"entityId,omitempty"`
+	Level      int         `json:"level" structs:"level"`
+	Name       string      `json:"name,omitempty" structs:"name,omitempty"`
+	IssueTypes []IssueType `json:"issueTypes,omitempty" structs:"issueTypes,omitempty"`
+}
+
+// ProjectIssueTypeHierarchy represents the issue type hierarchy of a project
+type ProjectIssueTypeHierarchy struct {
+	ProjectID int                              `json:"projectId" structs:"projectId"`
+	Hierarchy []ProjectIssueTypeHierarchyLevel `json:"hierarchy" structs:"hierarchy"`
+}
+
+// GetListWithContext returns a list of all issue types visible to the user
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issuetype-getIssueAllTypes
+func (s *IssueTypeService) GetListWithContext(ctx context.Context) ([]IssueType, *Response, error) {
+	apiEndpoint := "rest/api/2/issuetype"
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	issueTypeList := []IssueType{}
+	resp, err := s.client.Do(req, &issueTypeList)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return issueTypeList, resp, nil
+}
+
+// GetList wraps GetListWithContext using the background context.
+func (s *IssueTypeService) GetList() ([]IssueType, *Response, error) {
+	return s.GetListWithContext(context.Background())
+}
+
+// GetWithContext returns a full representation of the issue type that has the given id.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issuetype-getIssueType
+func (s *IssueTypeService) GetWithContext(ctx context.Context, issueTypeID string) (*IssueType, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issuetype/%s", issueTypeID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	issueType := new(IssueType)
+	resp, err := s.client.Do(req, issueType)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return issueType, resp, nil
+}
+
+// Get wraps GetWithContext using the background context.
+func (s *IssueTypeService) Get(issueTypeID string) (*IssueType, *Response, error) {
+	return s.GetWithContext(context.Background(), issueTypeID)
+}
+
+// CreateWithContext creates an issue type from a JSON representation and adds the issue type to the default issue type scheme.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issuetype-createIssueType
+func (s *IssueTypeService) CreateWithContext(ctx context.Context, options *CreateIssueTypeOptions) (*IssueType, *Response, error) {
+	apiEndpoint := "rest/api/2/issuetype"
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	issueType := new(IssueType)
+	resp, err := s.client.Do(req, issueType)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return issueType, resp, nil
+}
+
+// Create wraps CreateWithContext using the background context.
+func (s *IssueTypeService) Create(options *CreateIssueTypeOptions) (*IssueType, *Response, error) {
+	return s.CreateWithContext(context.Background(), options)
+}
+
+// UpdateWithContext updates the name, description or avatar of the issue type, identified by issueType.ID.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issuetype-updateIssueType
+func (s *IssueTypeService) UpdateWithContext(ctx context.Context, issueType *IssueType) (*IssueType, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issuetype/%s", issueType.ID)
+	payload := struct {
+		Name        string `json:"name,omitempty"`
+		Description string `json:"description,omitempty"`
+		AvatarID    int    `json:"avatarId,omitempty"`
+	}{
+		Name:        issueType.Name,
+		Description: issueType.Description,
+		AvatarID:    issueType.AvatarID,
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	responseIssueType := new(IssueType)
+	resp, err := s.client.Do(req, responseIssueType)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return responseIssueType, resp, nil
+}
+
+// Update wraps UpdateWithContext using the background context.
+func (s *IssueTypeService) Update(issueType *IssueType) (*IssueType, *Response, error) {
+	return s.UpdateWithContext(context.Background(), issueType)
+}
+
+// StoreTemporaryAvatarWithContext uploads r (io.Reader) as a temporary avatar for the given issue type.
+// This is the first step of the avatar creation flow. The returned AvatarCropping needs
+// to be passed to CreateAvatarFromTemporary to confirm (and optionally crop) the avatar.
+// contentType is the MIME type of the image, e.g. "image/png".
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issuetype-storeTemporaryAvatar
+func (s *IssueTypeService) StoreTemporaryAvatarWithContext(ctx context.Context, issueTypeID string, r io.Reader, filename, contentType string, size int64) (*AvatarCropping, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issuetype/%s/avatar/temporary", issueTypeID)
+	apiEndpoint, err := addOptions(apiEndpoint, &struct {
+		Filename string `url:"filename"`
+		Size     int64  `url:"size,omitempty"`
+	}{filename, size})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRawRequestWithContext(ctx, "POST", apiEndpoint, r)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("X-Atlassian-Token", "no-check")
+
+	cropping := new(AvatarCropping)
+	resp, err := s.client.Do(req, cropping)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return cropping, resp, nil
+}
+
+// StoreTemporaryAvatar wraps StoreTemporaryAvatarWithContext using the background context.
+func (s *IssueTypeService) StoreTemporaryAvatar(issueTypeID string, r io.Reader, filename, contentType string, size int64) (*AvatarCropping, *Response, error) {
+	return s.StoreTemporaryAvatarWithContext(context.Background(), issueTypeID, r, filename, contentType, size)
+}
+
+// CreateAvatarFromTemporaryWithContext converts a temporary avatar into a real avatar of the given issue type.
+// The cropping instructions returned by StoreTemporaryAvatar can be passed unchanged.
+// The created avatar still needs to be selected by updating the issue type with its ID
+// (see SetAvatar).
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issuetype-createAvatarFromTemporary
+func (s *IssueTypeService) CreateAvatarFromTemporaryWithContext(ctx context.Context, issueTypeID string, cropping *AvatarCropping) (*Avatar, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issuetype/%s/avatar", issueTypeID)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, cropping)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	avatar := new(Avatar)
+	resp, err := s.client.Do(req, avatar)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return avatar, resp, nil
+}
+
+// CreateAvatarFromTemporary wraps CreateAvatarFromTemporaryWithContext using the background context.
+func (s *IssueTypeService) CreateAvatarFromTemporary(issueTypeID string, cropping *AvatarCropping) (*Avatar, *Response, error) {
+	return s.CreateAvatarFromTemporaryWithContext(context.Background(), issueTypeID, cropping)
+}
+
+// SetAvatarWithContext selects the avatar with the given avatarID for the issue type.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issuetype-updateIssueType
+func (s *IssueTypeService) SetAvatarWithContext(ctx context.Context, issueTypeID string, avatarID int) (*IssueType, *Response, error) {
+	return s.UpdateWithContext(ctx, &IssueType{ID: issueTypeID, AvatarID: avatarID})
+}
+
+// SetAvatar wraps SetAvatarWithContext using the background context.
+func (s *IssueTypeService) SetAvatar(issueTypeID string, avatarID int) (*IssueType, *Response, error) {
+	return s.SetAvatarWithContext(context.Background(), issueTypeID, avatarID)
+}
+
+// GetHierarchyWithContext returns the issue type hierarchy of the instance,
+// including the custom levels above epics configured in Premium instances.
+// The hierarchy is read only: the custom levels are configured in the settings of Advanced Roadmaps (plans),
+// for which Atlassian documents no REST endpoint, so this library does not provide methods to change them.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-types/
+func (s *IssueTypeService) GetHierarchyWithContext(ctx context.Context) (*IssueTypeHierarchy, *Response, error) {
+	apiEndpoint := "rest/api/2/issuetype/hierarchy"
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hierarchy := new(IssueTypeHierarchy)
+	resp, err := s.client.Do(req, hierarchy)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return hierarchy, resp, nil
+}
+
+// GetHierarchy wraps GetHierarchyWithContext using the background context.
+func (s *IssueTypeService) GetHierarchy() (*IssueTypeHierarchy, *Response, error) {
+	return s.GetHierarchyWithContext(context.Background())
+}
+
+// GetProjectHierarchyWithContext returns the issue type hierarchy of the project, with the issue types of each level
+// which are available in the project.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-projects/#api-rest-api-2-project-projectid-hierarchy-get
+func (s *IssueTypeService) GetProjectHierarchyWithContext(ctx context.Context, projectID string) (*ProjectIssueTypeHierarchy, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/hierarchy", projectID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hierarchy := new(ProjectIssueTypeHierarchy)
+	resp, err := s.client.Do(req, hierarchy)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return hierarchy, resp, nil
+}
+
+// GetProjectHierarchy wraps GetProjectHierarchyWithContext using the background context.
+func (s *IssueTypeService) GetProjectHierarchy(projectID string) (*ProjectIssueTypeHierarchy, *Response, error) {
+	return s.GetProjectHierarchyWithContext(context.Background(), projectID)
+}
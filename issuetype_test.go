@@ -0,0 +1,186 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestIssueTypeService_GetList(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/issuetype"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `[{"self":"https://example.atlassian.net/rest/api/2/issuetype/10000","id":"10000","description":"A task that needs to be done.","name":"Task","subtask":false,"avatarId":10318},{"self":"https://example.atlassian.net/rest/api/2/issuetype/10001","id":"10001","name":"Sub-task","subtask":true,"avatarId":10316}]`)
+	})
+
+	issueTypes, _, err := testClient.IssueType.GetList()
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(issueTypes) != 2 {
+		t.Errorf("Expected 2 issue types. Got %d", len(issueTypes))
+	}
+}
+
+func TestIssueTypeService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/issuetype"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"self":"https://example.atlassian.net/rest/api/2/issuetype/10010","id":"10010","description":"Generated","name":"Incident","subtask":false,"avatarId":10300}`)
+	})
+
+	issueType, _, err := testClient.IssueType.Create(&CreateIssueTypeOptions{Name: "Incident", Type: "standard"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if issueType == nil || issueType.ID != "10010" {
+		t.Errorf("Expected issue type 10010. Got %+v", issueType)
+	}
+}
+
+func TestIssueTypeService_StoreTemporaryAvatar(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/issuetype/10010/avatar/temporary"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEndpoint)
+		testRequestParams(t, r, map[string]string{"filename": "incident.png", "size": "8"})
+
+		if got := r.Header.Get("X-Atlassian-Token"); got != "no-check" {
+			t.Errorf("Expected X-Atlassian-Token no-check. Got %s", got)
+		}
+		if got := r.Header.Get("Content-Type"); got != "image/png" {
+			t.Errorf("Expected Content-Type image/png. Got %s", got)
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != "fakepng!" {
+			t.Errorf("Unexpected body %q", body)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"cropperWidth":120,"cropperOffsetX":50,"cropperOffsetY":50,"url":"https://example.atlassian.net/secure/temporaryavatar?cropped=true","needsCropping":true}`)
+	})
+
+	cropping, _, err := testClient.IssueType.StoreTemporaryAvatar("10010", strings.NewReader("fakepng!"), "incident.png", "image/png", 8)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if cropping == nil || !cropping.NeedsCropping || cropping.CropperWidth != 120 {
+		t.Errorf("Unexpected cropping %+v", cropping)
+	}
+}
+
+func TestIssueTypeService_CreateAvatarFromTemporary(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/issuetype/10010/avatar"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var cropping AvatarCropping
+		if err := json.NewDecoder(r.Body).Decode(&cropping); err != nil {
+			t.Error(err)
+		}
+		if cropping.CropperWidth != 120 {
+			t.Errorf("Expected cropperWidth 120. Got %d", cropping.CropperWidth)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"10500","owner":"10010","isSystemAvatar":false,"isSelected":false,"isDeletable":true,"selected":false}`)
+	})
+
+	avatar, _, err := testClient.IssueType.CreateAvatarFromTemporary("10010", &AvatarCropping{CropperWidth: 120, NeedsCropping: true})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if avatar == nil || avatar.ID != "10500" {
+		t.Errorf("Expected avatar 10500. Got %+v", avatar)
+	}
+}
+
+func TestIssueTypeService_SetAvatar(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/issuetype/10010"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		body, _ := ioutil.ReadAll(r.Body)
+		if !strings.Contains(string(body), `"avatarId":10500`) {
+			t.Errorf("Expected avatarId in body. Got %s", body)
+		}
+		fmt.Fprint(w, `{"id":"10010","name":"Incident","avatarId":10500}`)
+	})
+
+	issueType, _, err := testClient.IssueType.SetAvatar("10010", 10500)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if issueType == nil || issueType.AvatarID != 10500 {
+		t.Errorf("Expected avatar 10500. Got %+v", issueType)
+	}
+}
+
+func TestIssueTypeService_GetHierarchy(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/issuetype/hierarchy"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"levels":[{"id":"1","name":"Sub-task","level":-1,"aboveLevelId":"2","issueTypeIds":["10003"]},{"id":"2","name":"Story","level":0,"aboveLevelId":"3","belowLevelId":"1","issueTypeIds":["10001","10002"]},{"id":"3","name":"Epic","level":1,"aboveLevelId":"4","belowLevelId":"2","issueTypeIds":["10000"]},{"id":"4","name":"Initiative","level":2,"belowLevelId":"3","issueTypeIds":["10100"]}]}`)
+	})
+
+	hierarchy, _, err := testClient.IssueType.GetHierarchy()
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(hierarchy.Levels) != 4 {
+		t.Fatalf("Expected 4 levels. Got %d", len(hierarchy.Levels))
+	}
+	if level := hierarchy.Level("10002"); level == nil || level.Level != IssueTypeHierarchyLevelBase {
+		t.Errorf("Expected issue type 10002 on the base level. Got %+v", level)
+	}
+	if level := hierarchy.Level("10100"); level == nil || level.Name != "Initiative" {
+		t.Errorf("Expected issue type 10100 on the Initiative level. Got %+v", level)
+	}
+	if level := hierarchy.Level("99999"); level != nil {
+		t.Errorf("Expected no level. Got %+v", level)
+	}
+}
+
+func TestIssueTypeService_GetProjectHierarchy(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/project/10030/hierarchy"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"projectId":10030,"hierarchy":[{"entityId":"b7fa8ac4-fbc4-4c41-a9f4-e15ef0ee5c67","level":0,"name":"Base","issueTypes":[{"id":"10001","name":"Story","avatarId":10400,"hierarchyLevel":0}]},{"entityId":"2a3b7d9a-6f4c-4df2-9f5c-1c5e1e2a4c6a","level":1,"name":"Epic","issueTypes":[{"id":"10000","name":"Epic","avatarId":10401,"hierarchyLevel":1}]}]}`)
+	})
+
+	hierarchy, _, err := testClient.IssueType.GetProjectHierarchy("10030")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if hierarchy.ProjectID != 10030 || len(hierarchy.Hierarchy) != 2 {
+		t.Fatalf("Unexpected hierarchy %+v", hierarchy)
+	}
+	if epic := hierarchy.Hierarchy[1].IssueTypes[0]; epic.HierarchyLevel != IssueTypeHierarchyLevelEpic {
+		t.Errorf("Expected epic hierarchy level. Got %+v", epic)
+	}
+}
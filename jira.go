@@ -2,6 +2,7 @@ package jira
 
 import (
 	"bytes"
+	"context"
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
@@ -12,6 +13,7 @@ import (
 	"reflect"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/dgrijalva/jwt-go"
@@ -33,28 +35,54 @@ type Client struct {
 	// Base URL for API requests.
 	baseURL *url.URL
 
-	// Session storage if the user authenticates with a Session cookie
-	session *Session
+	// Session storage if the user authenticates with a Session cookie, guarded by sessionMu
+	session   *Session
+	sessionMu sync.RWMutex
+
+	// Options applied to every request created by the client, see WithRequestOptions
+	requestOptions []func(*http.Request) error
+
+	// Retry policy of the requests sent by the client, see WithRetryPolicy
+	retryPolicy *RetryPolicy
+
+	// Search endpoint of SearchPages and the helpers built on it, see WithSearchAPI
+	searchAPI SearchAPI
 
 	// Services used for talking to different parts of the JIRA API.
-	Authentication   *AuthenticationService
-	Issue            *IssueService
-	Project          *ProjectService
-	Board            *BoardService
-	Sprint           *SprintService
-	User             *UserService
-	Group            *GroupService
-	Version          *VersionService
-	Priority         *PriorityService
-	Field            *FieldService
-	Component        *ComponentService
-	Resolution       *ResolutionService
-	StatusCategory   *StatusCategoryService
-	Filter           *FilterService
-	Role             *RoleService
-	PermissionScheme *PermissionSchemeService
-	Status           *StatusService
-	IssueLinkType    *IssueLinkTypeService
+	Authentication     *AuthenticationService
+	Issue              *IssueService
+	Project            *ProjectService
+	Board              *BoardService
+	Sprint             *SprintService
+	User               *UserService
+	Group              *GroupService
+	Version            *VersionService
+	Priority           *PriorityService
+	Field              *FieldService
+	Component          *ComponentService
+	Resolution         *ResolutionService
+	StatusCategory     *StatusCategoryService
+	Filter             *FilterService
+	Role               *RoleService
+	PermissionScheme   *PermissionSchemeService
+	Status             *StatusService
+	IssueLinkType      *IssueLinkTypeService
+	IssueType          *IssueTypeService
+	DevStatus          *DevStatusService
+	Build              *BuildService
+	Deployment         *DeploymentService
+	Dashboard          *DashboardService
+	Workflow           *WorkflowService
+	Plan               *PlanService
+	Team               *TeamService
+	Label              *LabelService
+	Resolver           *ResolverService
+	SecurityScheme     *SecuritySchemeService
+	NotificationScheme *NotificationSchemeService
+	WorkflowScheme     *WorkflowSchemeService
+	MetadataCache      *MetadataCacheService
+	TimesheetApproval  *TimesheetApprovalService
+	ServiceDesk        *ServiceDeskService
 }
 
 // NewClient returns a new JIRA API client.
@@ -64,7 +92,8 @@ type Client struct {
 // As an alternative you can use Session Cookie based authentication provided by this package as well.
 // See https://docs.atlassian.com/jira/REST/latest/#authentication
 // baseURL is the HTTP endpoint of your JIRA instance and should always be specified with a trailing slash.
-func NewClient(httpClient httpClient, baseURL string) (*Client, error) {
+// options configure the client, e.g. WithRetryPolicy.
+func NewClient(httpClient httpClient, baseURL string, options ...ClientOption) (*Client, error) {
 	if httpClient == nil {
 		httpClient = http.DefaultClient
 	}
@@ -83,7 +112,17 @@ func NewClient(httpClient httpClient, baseURL string) (*Client, error) {
 		client:  httpClient,
 		baseURL: parsedBaseURL,
 	}
-	c.Authentication = &AuthenticationService{client: c}
+	c.initServices()
+	for _, option := range options {
+		option(c)
+	}
+
+	return c, nil
+}
+
+// initServices creates the services talking to the different parts of the JIRA API.
+func (c *Client) initServices() {
+	c.Authentication = &AuthenticationService{client: c, reauth: new(sessionReauth)}
 	c.Issue = &IssueService{client: c}
 	c.Project = &ProjectService{client: c}
 	c.Board = &BoardService{client: c}
@@ -101,14 +140,33 @@ func NewClient(httpClient httpClient, baseURL string) (*Client, error) {
 	c.PermissionScheme = &PermissionSchemeService{client: c}
 	c.Status = &StatusService{client: c}
 	c.IssueLinkType = &IssueLinkTypeService{client: c}
+	c.IssueType = &IssueTypeService{client: c}
+	c.DevStatus = &DevStatusService{client: c}
+	c.Build = &BuildService{client: c}
+	c.Deployment = &DeploymentService{client: c}
+	c.Dashboard = &DashboardService{client: c}
+	c.Workflow = &WorkflowService{client: c}
+	c.Plan = &PlanService{client: c}
+	c.Team = &TeamService{client: c}
+	c.Label = &LabelService{client: c}
+	c.Resolver = &ResolverService{client: c}
+	c.SecurityScheme = &SecuritySchemeService{client: c}
+	c.NotificationScheme = &NotificationSchemeService{client: c}
+	c.WorkflowScheme = &WorkflowSchemeService{client: c}
+	c.MetadataCache = &MetadataCacheService{client: c, TTL: DefaultMetadataCacheTTL}
+	c.TimesheetApproval = &TimesheetApprovalService{client: c}
+	c.ServiceDesk = &ServiceDeskService{client: c}
+}
 
-	return c, nil
+// NewRawRequest wraps NewRawRequestWithContext using the background context.
+func (c *Client) NewRawRequest(method, urlStr string, body io.Reader) (*http.Request, error) {
+	return c.NewRawRequestWithContext(context.Background(), method, urlStr, body)
 }
 
-// NewRawRequest creates an API request.
+// NewRawRequestWithContext creates an API request.
 // A relative URL can be provided in urlStr, in which case it is resolved relative to the baseURL of the Client.
 // Allows using an optional native io.Reader for sourcing the request body.
-func (c *Client) NewRawRequest(method, urlStr string, body io.Reader) (*http.Request, error) {
+func (c *Client) NewRawRequestWithContext(ctx context.Context, method, urlStr string, body io.Reader) (*http.Request, error) {
 	rel, err := url.Parse(urlStr)
 	if err != nil {
 		return nil, err
@@ -122,14 +180,15 @@ func (c *Client) NewRawRequest(method, urlStr string, body io.Reader) (*http.Req
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
 
 	req.Header.Set("Content-Type", "application/json")
 
 	// Set authentication information
 	if c.Authentication.authType == authTypeSession {
 		// Set session cookie if there is one
-		if c.session != nil {
-			for _, cookie := range c.session.Cookies {
+		if session := c.getSession(); session != nil {
+			for _, cookie := range session.Cookies {
 				req.AddCookie(cookie)
 			}
 		}
@@ -140,13 +199,22 @@ func (c *Client) NewRawRequest(method, urlStr string, body io.Reader) (*http.Req
 		}
 	}
 
+	if err := c.applyRequestOptions(req); err != nil {
+		return nil, err
+	}
+
 	return req, nil
 }
 
-// NewRequest creates an API request.
+// NewRequest wraps NewRequestWithContext using the background context.
+func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Request, error) {
+	return c.NewRequestWithContext(context.Background(), method, urlStr, body)
+}
+
+// NewRequestWithContext creates an API request.
 // A relative URL can be provided in urlStr, in which case it is resolved relative to the baseURL of the Client.
 // If specified, the value pointed to by body is JSON encoded and included as the request body.
-func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Request, error) {
+func (c *Client) NewRequestWithContext(ctx context.Context, method, urlStr string, body interface{}) (*http.Request, error) {
 	rel, err := url.Parse(urlStr)
 	if err != nil {
 		return nil, err
@@ -169,14 +237,15 @@ func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Requ
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
 
 	req.Header.Set("Content-Type", "application/json")
 
 	// Set authentication information
 	if c.Authentication.authType == authTypeSession {
 		// Set session cookie if there is one
-		if c.session != nil {
-			for _, cookie := range c.session.Cookies {
+		if session := c.getSession(); session != nil {
+			for _, cookie := range session.Cookies {
 				req.AddCookie(cookie)
 			}
 		}
@@ -187,6 +256,10 @@ func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Requ
 		}
 	}
 
+	if err := c.applyRequestOptions(req); err != nil {
+		return nil, err
+	}
+
 	return req, nil
 }
 
@@ -212,10 +285,15 @@ func addOptions(s string, opt interface{}) (string, error) {
 	return u.String(), nil
 }
 
-// NewMultiPartRequest creates an API request including a multi-part file.
+// NewMultiPartRequest wraps NewMultiPartRequestWithContext using the background context.
+func (c *Client) NewMultiPartRequest(method, urlStr string, buf *bytes.Buffer) (*http.Request, error) {
+	return c.NewMultiPartRequestWithContext(context.Background(), method, urlStr, buf)
+}
+
+// NewMultiPartRequestWithContext creates an API request including a multi-part file.
 // A relative URL can be provided in urlStr, in which case it is resolved relative to the baseURL of the Client.
 // If specified, the value pointed to by buf is a multipart form.
-func (c *Client) NewMultiPartRequest(method, urlStr string, buf *bytes.Buffer) (*http.Request, error) {
+func (c *Client) NewMultiPartRequestWithContext(ctx context.Context, method, urlStr string, buf *bytes.Buffer) (*http.Request, error) {
 	rel, err := url.Parse(urlStr)
 	if err != nil {
 		return nil, err
@@ -229,15 +307,16 @@ func (c *Client) NewMultiPartRequest(method, urlStr string, buf *bytes.Buffer) (
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
 
 	// Set required headers
-	req.Header.Set("X-Atlassian-Token", "nocheck")
+	req.Header.Set("X-Atlassian-Token", "no-check")
 
 	// Set authentication information
 	if c.Authentication.authType == authTypeSession {
 		// Set session cookie if there is one
-		if c.session != nil {
-			for _, cookie := range c.session.Cookies {
+		if session := c.getSession(); session != nil {
+			for _, cookie := range session.Cookies {
 				req.AddCookie(cookie)
 			}
 		}
@@ -248,34 +327,98 @@ func (c *Client) NewMultiPartRequest(method, urlStr string, buf *bytes.Buffer) (
 		}
 	}
 
+	if err := c.applyRequestOptions(req); err != nil {
+		return nil, err
+	}
+
 	return req, nil
 }
 
+// getSession returns the session acquired by AcquireSessionCookie or ImportSession, nil if there is none.
+func (c *Client) getSession() *Session {
+	c.sessionMu.RLock()
+	defer c.sessionMu.RUnlock()
+	return c.session
+}
+
+// setSession replaces the session of the client, nil removes it.
+func (c *Client) setSession(session *Session) {
+	c.sessionMu.Lock()
+	c.session = session
+	c.sessionMu.Unlock()
+}
+
 // Do sends an API request and returns the API response.
 // The API response is JSON decoded and stored in the value pointed to by v, or returned as an error if an API error has occurred.
 func (c *Client) Do(req *http.Request, v interface{}) (*Response, error) {
-	httpResp, err := c.client.Do(req)
+	session := c.getSession()
+	httpResp, err := c.sendWithRetry(req)
 	if err != nil {
 		return nil, err
 	}
 
+	if httpResp.StatusCode == http.StatusUnauthorized {
+		if retry, ok := c.Authentication.reauthenticate(req, session); ok {
+			httpResp.Body.Close()
+			httpResp, err = c.sendWithRetry(retry)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	err = CheckResponse(httpResp)
 	if err != nil {
 		// Even though there was an error, we still return the response
 		// in case the caller wants to inspect it further
-		return newResponse(httpResp, nil), err
+		return newResponse(httpResp), err
 	}
 
+	resp := newResponse(httpResp)
 	if v != nil {
 		// Open a NewDecoder and defer closing the reader only if there is a provided interface to decode to
 		defer httpResp.Body.Close()
-		err = json.NewDecoder(httpResp.Body).Decode(v)
+		if err = json.NewDecoder(httpResp.Body).Decode(v); err != nil {
+			return resp, err
+		}
+		if page, ok := v.(pager); ok {
+			resp.PageInfo = page.pageInfo()
+		}
 	}
 
-	resp := newResponse(httpResp, v)
 	return resp, err
 }
 
+// Call sends a request to an endpoint which has no method in this library yet, e.g. a new Atlassian API.
+// path is resolved relative to the baseURL of the Client and may contain a query string, e.g. "rest/api/2/myself?expand=groups".
+// body is sent as is if it is an io.Reader, otherwise it is JSON encoded, nil sends no body.
+// The response is JSON decoded into v if v is not nil. An empty response body, e.g. of 204 No Content, is no error.
+//
+// The request is sent with Do, like the requests of the services, so it is authenticated
+// and the errors are parsed the same way.
+func (c *Client) Call(ctx context.Context, method, path string, body, v interface{}) (*Response, error) {
+	var req *http.Request
+	var err error
+	if r, ok := body.(io.Reader); ok {
+		req, err = c.NewRawRequestWithContext(ctx, method, path, r)
+	} else {
+		req, err = c.NewRequestWithContext(ctx, method, path, body)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.Do(req, v)
+	if err == io.EOF && resp != nil {
+		// Do could not decode the empty body into v
+		return resp, nil
+	}
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
 // CheckResponse checks the API response for errors, and returns them if present.
 // A response is considered an error if it has a status code outside the 200 range.
 // The caller is responsible to analyze the response body.
@@ -285,6 +428,10 @@ func CheckResponse(r *http.Response) error {
 		return nil
 	}
 
+	if authErr := newAuthError(r); authErr != nil {
+		return authErr
+	}
+
 	err := fmt.Errorf("Request failed. Please analyze the request body for more details. Status code: %d", r.StatusCode)
 	return err
 }
@@ -300,31 +447,80 @@ func (c *Client) GetBaseURL() url.URL {
 type Response struct {
 	*http.Response
 
+	// PageInfo is set by paginated endpoints, see PageInfo
+	PageInfo
+}
+
+// PageInfo contains the pagination metadata of a list response.
+// It is filled for the offset based pages of the platform and Agile APIs (startAt, maxResults, total, isLast),
+// for the token based pages, like IssueService.SearchJQL (nextPageToken, isLast),
+// for the cursor based pages of plans and teams (the cursor of the next page is set as NextPageToken),
+// and for the pages of the JIRA Service Management API (start, limit, isLastPage).
+// Fields the endpoint does not return are left zero, e.g. JIRA Service Management pages have no Total.
+type PageInfo struct {
 	StartAt    int
 	MaxResults int
 	Total      int
+	IsLast     bool
+
+	// NextPageToken is set by token and cursor based paginated endpoints, like IssueService.SearchJQL or PlanService.GetList
+	NextPageToken string
+}
+
+// HasNextPage reports if there are more results after this page.
+func (p PageInfo) HasNextPage() bool {
+	if p.IsLast {
+		return false
+	}
+	if p.NextPageToken != "" {
+		return true
+	}
+	return p.MaxResults > 0 && p.StartAt+p.MaxResults < p.Total
 }
 
-func newResponse(r *http.Response, v interface{}) *Response {
-	resp := &Response{Response: r}
-	resp.populatePageValues(v)
-	return resp
+// NextStartAt returns the StartAt of the next offset based page.
+func (p PageInfo) NextStartAt() int {
+	return p.StartAt + p.MaxResults
 }
 
-// Sets paging values if response json was parsed to searchResult type
-// (can be extended with other types if they also need paging info)
-func (r *Response) populatePageValues(v interface{}) {
-	switch value := v.(type) {
-	case *searchResult:
-		r.StartAt = value.StartAt
-		r.MaxResults = value.MaxResults
-		r.Total = value.Total
-	case *groupMembersResult:
-		r.StartAt = value.StartAt
-		r.MaxResults = value.MaxResults
-		r.Total = value.Total
+func newResponse(r *http.Response) *Response {
+	return &Response{Response: r}
+}
+
+// pager is implemented by the result types of paginated endpoints.
+// Do copies their pagination metadata into the PageInfo of the Response.
+type pager interface {
+	pageInfo() PageInfo
+}
+
+// pageValues holds the pagination metadata of a page.
+// It is embedded into the result types of paginated endpoints, which do not expose the metadata themselves.
+type pageValues struct {
+	StartAt       int    `json:"startAt"`
+	MaxResults    int    `json:"maxResults"`
+	Total         int    `json:"total"`
+	IsLast        bool   `json:"isLast"`
+	NextPageToken string `json:"nextPageToken"`
+
+	// JIRA Service Management
+	Start      int  `json:"start"`
+	Limit      int  `json:"limit"`
+	IsLastPage bool `json:"isLastPage"`
+}
+
+func (p *pageValues) pageInfo() PageInfo {
+	info := PageInfo{
+		StartAt:       p.StartAt,
+		MaxResults:    p.MaxResults,
+		Total:         p.Total,
+		IsLast:        p.IsLast || p.IsLastPage,
+		NextPageToken: p.NextPageToken,
 	}
-	return
+	if info.StartAt == 0 && info.MaxResults == 0 && (p.Start != 0 || p.Limit != 0) {
+		info.StartAt = p.Start
+		info.MaxResults = p.Limit
+	}
+	return info
 }
 
 // BasicAuthTransport is an http.RoundTripper that authenticates all requests
@@ -363,6 +559,42 @@ func (t *BasicAuthTransport) transport() http.RoundTripper {
 	return http.DefaultTransport
 }
 
+// PATAuthTransport is an http.RoundTripper that authenticates all requests
+// using a Personal Access Token, which is sent as bearer token.
+// Personal Access Tokens are supported by JIRA Server / Data Center since version 8.14.
+//
+// JIRA docs: https://confluence.atlassian.com/enterprise/using-personal-access-tokens-1026032365.html
+type PATAuthTransport struct {
+	// Token is the Personal Access Token
+	Token string
+
+	// Transport is the underlying HTTP transport to use when making requests.
+	// It will default to http.DefaultTransport if nil.
+	Transport http.RoundTripper
+}
+
+// RoundTrip implements the RoundTripper interface.  We just add the
+// bearer token and return the RoundTripper for this transport type.
+func (t *PATAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req2 := cloneRequest(req) // per RoundTripper contract
+
+	req2.Header.Set("Authorization", "Bearer "+t.Token)
+	return t.transport().RoundTrip(req2)
+}
+
+// Client returns an *http.Client that makes requests that are authenticated
+// using the Personal Access Token.
+func (t *PATAuthTransport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+func (t *PATAuthTransport) transport() http.RoundTripper {
+	if t.Transport != nil {
+		return t.Transport
+	}
+	return http.DefaultTransport
+}
+
 // CookieAuthTransport is an http.RoundTripper that authenticates all requests
 // using Jira's cookie-based authentication.
 //
@@ -2,6 +2,7 @@ package jira
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -179,6 +180,20 @@ func TestClient_NewRequest(t *testing.T) {
 	}
 }
 
+func TestClient_NewRequestWithContext(t *testing.T) {
+	c, err := NewClient(nil, testJIRAInstanceURL)
+	if err != nil {
+		t.Errorf("An error occurred. Expected nil. Got %+v.", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	req, _ := c.NewRequestWithContext(ctx, "GET", "/rest/api/2/issue/TEST-1", nil)
+	if req.Context() != ctx {
+		t.Error("Expected the request to carry the given context")
+	}
+}
+
 func TestClient_NewRawRequest(t *testing.T) {
 	c, err := NewClient(nil, testJIRAInstanceURL)
 	if err != nil {
@@ -318,8 +333,8 @@ func TestClient_NewMultiPartRequest(t *testing.T) {
 		}
 	}
 
-	if req.Header.Get("X-Atlassian-Token") != "nocheck" {
-		t.Errorf("An error occurred. Unexpected X-Atlassian-Token header value. Expected nocheck, actual %s.", req.Header.Get("X-Atlassian-Token"))
+	if req.Header.Get("X-Atlassian-Token") != "no-check" {
+		t.Errorf("An error occurred. Unexpected X-Atlassian-Token header value. Expected no-check, actual %s.", req.Header.Get("X-Atlassian-Token"))
 	}
 }
 
@@ -344,8 +359,8 @@ func TestClient_NewMultiPartRequest_BasicAuth(t *testing.T) {
 		t.Errorf("An error occurred. Expected basic auth username %s and password %s. Got username %s and password %s.", "test-user", "test-password", username, password)
 	}
 
-	if req.Header.Get("X-Atlassian-Token") != "nocheck" {
-		t.Errorf("An error occurred. Unexpected X-Atlassian-Token header value. Expected nocheck, actual %s.", req.Header.Get("X-Atlassian-Token"))
+	if req.Header.Get("X-Atlassian-Token") != "no-check" {
+		t.Errorf("An error occurred. Unexpected X-Atlassian-Token header value. Expected no-check, actual %s.", req.Header.Get("X-Atlassian-Token"))
 	}
 }
 
@@ -400,6 +415,49 @@ func TestClient_Do_HTTPResponse(t *testing.T) {
 	}
 }
 
+func TestClient_Do_PageInfo(t *testing.T) {
+	tests := []struct {
+		body string
+		v    interface{}
+		want PageInfo
+		next bool
+	}{
+		{`{"startAt":50,"maxResults":50,"total":120,"values":[]}`, new(BoardsList), PageInfo{StartAt: 50, MaxResults: 50, Total: 120}, true},
+		{`{"startAt":0,"maxResults":50,"total":2,"isLast":true,"values":[]}`, new(pageValues), PageInfo{MaxResults: 50, Total: 2, IsLast: true}, false},
+		{`{"issues":[],"nextPageToken":"CAEaAggD","isLast":false}`, new(searchJQLResult), PageInfo{NextPageToken: "CAEaAggD"}, true},
+		{`{"start":10,"limit":10,"size":3,"isLastPage":true,"values":[]}`, new(pageValues), PageInfo{StartAt: 10, MaxResults: 10, IsLast: true}, false},
+		{`{"cursor":"","nextPageCursor":"2","maxResults":1,"total":2,"isLast":false,"values":[]}`, new(PlansList), PageInfo{MaxResults: 1, Total: 2, NextPageToken: "2"}, true},
+		{`{"cursor":"2","maxResults":1,"total":2,"isLast":true,"values":[]}`, new(PlanTeamsList), PageInfo{MaxResults: 1, Total: 2, IsLast: true}, false},
+		{`{"entities":[],"cursor":"abc"}`, new(TeamsList), PageInfo{NextPageToken: "abc"}, true},
+		{`{"entities":[]}`, new(TeamsList), PageInfo{IsLast: true}, false},
+		{`{"results":[],"pageInfo":{"endCursor":"def","hasNextPage":true}}`, new(TeamMembersList), PageInfo{NextPageToken: "def"}, true},
+		{`{"results":[],"pageInfo":{"endCursor":"def","hasNextPage":false}}`, new(TeamMembersList), PageInfo{IsLast: true, NextPageToken: "def"}, false},
+		// Only the result types of paginated endpoints fill the PageInfo
+		{`{"startAt":50,"maxResults":50,"total":120,"values":[]}`, new(interface{}), PageInfo{}, false},
+		{`[{"id":"1"}]`, new([]interface{}), PageInfo{}, false},
+	}
+
+	for _, test := range tests {
+		setup()
+		testMux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, test.body)
+		})
+
+		req, _ := testClient.NewRequest("GET", "/", nil)
+		resp, err := testClient.Do(req, test.v)
+		if err != nil {
+			t.Fatalf("Error given: %s", err)
+		}
+		if resp.PageInfo != test.want {
+			t.Errorf("PageInfo of %s = %+v, want %+v", test.body, resp.PageInfo, test.want)
+		}
+		if resp.HasNextPage() != test.next {
+			t.Errorf("HasNextPage of %s = %v, want %v", test.body, resp.HasNextPage(), test.next)
+		}
+		teardown()
+	}
+}
+
 func TestClient_Do_HTTPError(t *testing.T) {
 	setup()
 	defer teardown()
@@ -485,6 +543,27 @@ func TestBasicAuthTransport(t *testing.T) {
 	basicAuthClient.Do(req, nil)
 }
 
+func TestPATAuthTransport(t *testing.T) {
+	setup()
+	defer teardown()
+
+	token := "NjM4NzU3MDM0NjQ3OmXCT0e8"
+
+	testMux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer "+token {
+			t.Errorf("request contained Authorization %q, want %q", got, "Bearer "+token)
+		}
+	})
+
+	tp := &PATAuthTransport{
+		Token: token,
+	}
+
+	patClient, _ := NewClient(tp.Client(), testServer.URL)
+	req, _ := patClient.NewRequest("GET", ".", nil)
+	patClient.Do(req, nil)
+}
+
 func TestBasicAuthTransport_transport(t *testing.T) {
 	// default transport
 	tp := &BasicAuthTransport{}
@@ -636,3 +715,59 @@ func TestJWTAuthTransport_HeaderContainsJWT(t *testing.T) {
 	jwtClient, _ := NewClient(jwtTransport.Client(), testServer.URL)
 	jwtClient.Issue.Get("TEST-1", nil)
 }
+
+func TestClient_Call(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/3/new-endpoint", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, "/rest/api/3/new-endpoint?expand=all")
+		b, _ := ioutil.ReadAll(r.Body)
+		if string(b) != `{"name":"foo"}`+"\n" {
+			t.Errorf("Unexpected body %q", b)
+		}
+		fmt.Fprint(w, `{"id":"10000","name":"foo"}`)
+	})
+	testMux.HandleFunc("/rest/api/3/new-endpoint/10000", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PUT" {
+			b, _ := ioutil.ReadAll(r.Body)
+			if string(b) != `{"raw":true}` {
+				t.Errorf("Unexpected body %q", b)
+			}
+		}
+		if r.Method == "GET" {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"errorMessages":["Not found"]}`)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	var result struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	}
+	_, err := testClient.Call(context.Background(), "POST", "rest/api/3/new-endpoint?expand=all", map[string]string{"name": "foo"}, &result)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if result.ID != "10000" {
+		t.Errorf("Unexpected result %+v", result)
+	}
+
+	resp, err := testClient.Call(context.Background(), "PUT", "rest/api/3/new-endpoint/10000", strings.NewReader(`{"raw":true}`), &result)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("Expected 204, got %d", resp.StatusCode)
+	}
+
+	resp, err = testClient.Call(context.Background(), "GET", "rest/api/3/new-endpoint/10000", nil, &result)
+	if err == nil || !strings.Contains(err.Error(), "Not found") {
+		t.Errorf("Expected the parsed JIRA error, got %v", err)
+	}
+	if resp == nil || resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected the response of the failed request")
+	}
+}
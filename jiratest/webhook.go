@@ -0,0 +1,326 @@
+// Package jiratest provides helpers to test code using JIRA without a live instance.
+//
+// The webhook generators build the payloads JIRA posts to registered webhooks,
+// so webhook handlers can be tested with the issues of the test:
+//
+//	before := &jira.Issue{ID: "10001", Key: "EX-1", Fields: &jira.IssueFields{Summary: "Old"}}
+//	after := &jira.Issue{ID: "10001", Key: "EX-1", Fields: &jira.IssueFields{Summary: "New"}}
+//	req, _ := jiratest.NewWebhookRequest("/webhook", jiratest.IssueUpdatedEvent(before, after, nil))
+//	handler.ServeHTTP(httptest.NewRecorder(), req)
+package jiratest
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira"
+)
+
+// These constants are the values of WebhookEvent.WebhookEvent
+const (
+	WebhookIssueCreated = "jira:issue_created"
+	WebhookIssueUpdated = "jira:issue_updated"
+	WebhookIssueDeleted = "jira:issue_deleted"
+)
+
+// These constants are the values of WebhookEvent.IssueEventTypeName.
+// JIRA sends a more specific type than "issue_updated" for some updates.
+const (
+	IssueEventCreated  = "issue_created"
+	IssueEventUpdated  = "issue_updated"
+	IssueEventAssigned = "issue_assigned"
+	IssueEventResolved = "issue_resolved"
+	IssueEventGeneric  = "issue_generic"
+	IssueEventDeleted  = "issue_deleted"
+)
+
+// WebhookEvent is the payload of an issue webhook
+type WebhookEvent struct {
+	// Timestamp is the time of the event in milliseconds since the epoch
+	Timestamp          int64             `json:"timestamp"`
+	WebhookEvent       string            `json:"webhookEvent"`
+	IssueEventTypeName string            `json:"issue_event_type_name,omitempty"`
+	User               *jira.User        `json:"user,omitempty"`
+	Issue              *jira.Issue       `json:"issue,omitempty"`
+	Changelog          *WebhookChangelog `json:"changelog,omitempty"`
+}
+
+// WebhookChangelog contains the changes of an issue_updated webhook
+type WebhookChangelog struct {
+	ID    string                `json:"id"`
+	Items []jira.ChangelogItems `json:"items"`
+}
+
+// changelogID is the last ID given to a generated changelog
+var changelogID int64 = 10000
+
+// IssueCreatedEvent returns the webhook payload sent when user creates issue.
+func IssueCreatedEvent(issue *jira.Issue, user *jira.User) *WebhookEvent {
+	return &WebhookEvent{
+		Timestamp:          timestamp(),
+		WebhookEvent:       WebhookIssueCreated,
+		IssueEventTypeName: IssueEventCreated,
+		User:               user,
+		Issue:              issue,
+	}
+}
+
+// IssueUpdatedEvent returns the webhook payload sent when user changes the issue before to after.
+// The changelog is generated with ChangelogItems, the issue of the payload is after.
+func IssueUpdatedEvent(before, after *jira.Issue, user *jira.User) *WebhookEvent {
+	items := ChangelogItems(before, after)
+	return &WebhookEvent{
+		Timestamp:          timestamp(),
+		WebhookEvent:       WebhookIssueUpdated,
+		IssueEventTypeName: issueEventTypeName(items),
+		User:               user,
+		Issue:              after,
+		Changelog: &WebhookChangelog{
+			ID:    strconv.FormatInt(atomic.AddInt64(&changelogID, 1), 10),
+			Items: items,
+		},
+	}
+}
+
+// IssueDeletedEvent returns the webhook payload sent when user deletes issue.
+func IssueDeletedEvent(issue *jira.Issue, user *jira.User) *WebhookEvent {
+	return &WebhookEvent{
+		Timestamp:          timestamp(),
+		WebhookEvent:       WebhookIssueDeleted,
+		IssueEventTypeName: IssueEventDeleted,
+		User:               user,
+		Issue:              issue,
+	}
+}
+
+// NewWebhookRequest returns a POST request of event to url, as sent by JIRA.
+// It can be passed to the ServeHTTP method of the webhook handler.
+func NewWebhookRequest(url string, event *WebhookEvent) (*http.Request, error) {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("POST", url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=UTF-8")
+	req.Header.Set("User-Agent", "Atlassian HttpClient")
+	return req, nil
+}
+
+// ChangelogItems returns the changelog items JIRA writes when the issue before is changed to after.
+// The summary, description, issue type, status, priority, resolution, assignee, reporter,
+// due date, labels, components, fix versions and affects versions are compared.
+// Multi value fields get one item per added or removed value, except labels, which are listed in a single item.
+func ChangelogItems(before, after *jira.Issue) []jira.ChangelogItems {
+	b, a := issueFields(before), issueFields(after)
+	var items []jira.ChangelogItems
+	add := func(field, from, fromString, to, toString string) {
+		if from == to && fromString == toString {
+			return
+		}
+		items = append(items, jira.ChangelogItems{
+			Field:      field,
+			FieldType:  "jira",
+			From:       nullIfEmpty(from),
+			FromString: fromString,
+			To:         nullIfEmpty(to),
+			ToString:   toString,
+		})
+	}
+
+	add("summary", "", b.Summary, "", a.Summary)
+	add("description", "", b.Description, "", a.Description)
+	add("issuetype", b.Type.ID, b.Type.Name, a.Type.ID, a.Type.Name)
+	if b.Status != nil || a.Status != nil {
+		from, fromString := statusValue(b.Status)
+		to, toString := statusValue(a.Status)
+		add("status", from, fromString, to, toString)
+	}
+	if b.Priority != nil || a.Priority != nil {
+		from, fromString := priorityValue(b.Priority)
+		to, toString := priorityValue(a.Priority)
+		add("priority", from, fromString, to, toString)
+	}
+	if b.Resolution != nil || a.Resolution != nil {
+		from, fromString := resolutionValue(b.Resolution)
+		to, toString := resolutionValue(a.Resolution)
+		add("resolution", from, fromString, to, toString)
+	}
+	from, fromString := userValue(b.Assignee)
+	to, toString := userValue(a.Assignee)
+	add("assignee", from, fromString, to, toString)
+	from, fromString = userValue(b.Reporter)
+	to, toString = userValue(a.Reporter)
+	add("reporter", from, fromString, to, toString)
+	from, to = dateValue(b.Duedate), dateValue(a.Duedate)
+	add("duedate", from, dateString(from), to, dateString(to))
+
+	if !sameSet(b.Labels, a.Labels) {
+		add("labels", "", strings.Join(b.Labels, " "), "", strings.Join(a.Labels, " "))
+	}
+
+	multi := func(field string, before, after map[string]string) {
+		for _, id := range sortedKeys(before) {
+			if _, ok := after[id]; !ok {
+				add(field, id, before[id], "", "")
+			}
+		}
+		for _, id := range sortedKeys(after) {
+			if _, ok := before[id]; !ok {
+				add(field, "", "", id, after[id])
+			}
+		}
+	}
+	multi("Component", componentValues(b.Components), componentValues(a.Components))
+	multi("Fix Version", fixVersionValues(b.FixVersions), fixVersionValues(a.FixVersions))
+	multi("Version", affectsVersionValues(b.AffectsVersions), affectsVersionValues(a.AffectsVersions))
+
+	return items
+}
+
+// issueEventTypeName returns the event type JIRA sends for the changes in items
+func issueEventTypeName(items []jira.ChangelogItems) string {
+	fields := map[string]jira.ChangelogItems{}
+	for _, item := range items {
+		fields[item.Field] = item
+	}
+	if item, ok := fields["resolution"]; ok && item.From == nil && item.To != nil {
+		return IssueEventResolved
+	}
+	if _, ok := fields["assignee"]; ok && len(fields) == 1 {
+		return IssueEventAssigned
+	}
+	if _, ok := fields["status"]; ok && len(fields) == 1 {
+		return IssueEventGeneric
+	}
+	return IssueEventUpdated
+}
+
+func timestamp() int64 {
+	return time.Now().UnixNano() / int64(time.Millisecond)
+}
+
+func issueFields(issue *jira.Issue) *jira.IssueFields {
+	if issue == nil || issue.Fields == nil {
+		return &jira.IssueFields{}
+	}
+	return issue.Fields
+}
+
+func nullIfEmpty(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+func statusValue(status *jira.Status) (string, string) {
+	if status == nil {
+		return "", ""
+	}
+	return status.ID, status.Name
+}
+
+func priorityValue(priority *jira.Priority) (string, string) {
+	if priority == nil {
+		return "", ""
+	}
+	return priority.ID, priority.Name
+}
+
+func resolutionValue(resolution *jira.Resolution) (string, string) {
+	if resolution == nil {
+		return "", ""
+	}
+	return resolution.ID, resolution.Name
+}
+
+// userValue returns the account ID (the name on JIRA Server) and the display name of user
+func userValue(user *jira.User) (string, string) {
+	if user == nil {
+		return "", ""
+	}
+	if user.AccountID != "" {
+		return user.AccountID, user.DisplayName
+	}
+	return user.Name, user.DisplayName
+}
+
+func dateValue(date jira.Date) string {
+	t := time.Time(date)
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format("2006-01-02")
+}
+
+// dateString formats a date like the toString values of the due date in the changelog
+func dateString(date string) string {
+	if date == "" {
+		return ""
+	}
+	return date + " 00:00:00.0"
+}
+
+func componentValues(components []*jira.Component) map[string]string {
+	values := map[string]string{}
+	for _, c := range components {
+		if c != nil {
+			values[c.ID] = c.Name
+		}
+	}
+	return values
+}
+
+func fixVersionValues(versions []*jira.FixVersion) map[string]string {
+	values := map[string]string{}
+	for _, v := range versions {
+		if v != nil {
+			values[v.ID] = v.Name
+		}
+	}
+	return values
+}
+
+func affectsVersionValues(versions []*jira.AffectsVersion) map[string]string {
+	values := map[string]string{}
+	for _, v := range versions {
+		if v != nil {
+			values[v.ID] = v.Name
+		}
+	}
+	return values
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sameSet(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	set := map[string]bool{}
+	for _, s := range a {
+		set[s] = true
+	}
+	for _, s := range b {
+		if !set[s] {
+			return false
+		}
+	}
+	return true
+}
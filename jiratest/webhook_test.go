@@ -0,0 +1,137 @@
+package jiratest
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	jira "github.com/andygrunwald/go-jira"
+)
+
+func testIssue() *jira.Issue {
+	return &jira.Issue{
+		ID:  "10001",
+		Key: "EX-1",
+		Fields: &jira.IssueFields{
+			Summary:  "Old summary",
+			Type:     jira.IssueType{ID: "1", Name: "Bug"},
+			Status:   &jira.Status{ID: "1", Name: "Open"},
+			Assignee: &jira.User{AccountID: "abc", DisplayName: "Alice"},
+			Labels:   []string{"a", "b"},
+			Components: []*jira.Component{
+				{ID: "100", Name: "Backend"},
+			},
+		},
+	}
+}
+
+func TestIssueCreatedEvent(t *testing.T) {
+	issue := testIssue()
+	user := &jira.User{AccountID: "xyz"}
+	event := IssueCreatedEvent(issue, user)
+
+	if event.WebhookEvent != WebhookIssueCreated || event.IssueEventTypeName != IssueEventCreated {
+		t.Errorf("Unexpected event types %q, %q", event.WebhookEvent, event.IssueEventTypeName)
+	}
+	if event.Issue != issue || event.User != user || event.Changelog != nil {
+		t.Errorf("Unexpected event %+v", event)
+	}
+	if event.Timestamp == 0 {
+		t.Error("Expected a timestamp")
+	}
+}
+
+func TestIssueUpdatedEvent(t *testing.T) {
+	before := testIssue()
+	after := testIssue()
+	after.Fields.Summary = "New summary"
+	after.Fields.Labels = []string{"b", "c"}
+	after.Fields.Components = []*jira.Component{{ID: "101", Name: "Frontend"}}
+
+	event := IssueUpdatedEvent(before, after, nil)
+	if event.WebhookEvent != WebhookIssueUpdated || event.IssueEventTypeName != IssueEventUpdated {
+		t.Errorf("Unexpected event types %q, %q", event.WebhookEvent, event.IssueEventTypeName)
+	}
+	if event.Issue != after {
+		t.Error("Expected the updated issue in the event")
+	}
+	if event.Changelog == nil || event.Changelog.ID == "" {
+		t.Fatalf("Expected a changelog, got %+v", event.Changelog)
+	}
+
+	expected := []jira.ChangelogItems{
+		{Field: "summary", FieldType: "jira", FromString: "Old summary", ToString: "New summary"},
+		{Field: "labels", FieldType: "jira", FromString: "a b", ToString: "b c"},
+		{Field: "Component", FieldType: "jira", From: "100", FromString: "Backend"},
+		{Field: "Component", FieldType: "jira", To: "101", ToString: "Frontend"},
+	}
+	if !reflect.DeepEqual(event.Changelog.Items, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, event.Changelog.Items)
+	}
+}
+
+func TestIssueUpdatedEvent_EventTypeName(t *testing.T) {
+	tests := []struct {
+		name     string
+		change   func(*jira.IssueFields)
+		expected string
+	}{
+		{"assigned", func(f *jira.IssueFields) { f.Assignee = &jira.User{AccountID: "def"} }, IssueEventAssigned},
+		{"transitioned", func(f *jira.IssueFields) { f.Status = &jira.Status{ID: "3", Name: "In Progress"} }, IssueEventGeneric},
+		{"resolved", func(f *jira.IssueFields) {
+			f.Status = &jira.Status{ID: "5", Name: "Done"}
+			f.Resolution = &jira.Resolution{ID: "1", Name: "Fixed"}
+		}, IssueEventResolved},
+	}
+	for _, test := range tests {
+		after := testIssue()
+		test.change(after.Fields)
+		if got := IssueUpdatedEvent(testIssue(), after, nil).IssueEventTypeName; got != test.expected {
+			t.Errorf("%s: expected %q, got %q", test.name, test.expected, got)
+		}
+	}
+}
+
+func TestChangelogItems_NoChanges(t *testing.T) {
+	if items := ChangelogItems(testIssue(), testIssue()); len(items) != 0 {
+		t.Errorf("Expected no changes, got %+v", items)
+	}
+}
+
+func TestNewWebhookRequest(t *testing.T) {
+	before := testIssue()
+	after := testIssue()
+	after.Fields.Status = &jira.Status{ID: "3", Name: "In Progress"}
+	event := IssueUpdatedEvent(before, after, &jira.User{AccountID: "xyz"})
+
+	var received WebhookEvent
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("Expected a POST request, got %s", r.Method)
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &received); err != nil {
+			t.Errorf("Could not decode the payload: %s", err)
+		}
+	})
+
+	req, err := NewWebhookRequest("/webhook", event)
+	if err != nil {
+		t.Fatalf("No error expected. Got %s", err)
+	}
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	if received.Issue == nil || received.Issue.Key != "EX-1" {
+		t.Errorf("Unexpected issue %+v", received.Issue)
+	}
+	if received.Changelog == nil || len(received.Changelog.Items) != 1 {
+		t.Fatalf("Unexpected changelog %+v", received.Changelog)
+	}
+	item := received.Changelog.Items[0]
+	if item.Field != "status" || item.From != "1" || item.ToString != "In Progress" {
+		t.Errorf("Unexpected changelog item %+v", item)
+	}
+}
@@ -0,0 +1,79 @@
+package jira
+
+import "context"
+
+// LabelService handles the labels of issues for the JIRA instance / API.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-labels/
+type LabelService struct {
+	client *Client
+}
+
+// LabelsList reflects a page of labels
+type LabelsList struct {
+	MaxResults int      `json:"maxResults" structs:"maxResults"`
+	StartAt    int      `json:"startAt" structs:"startAt"`
+	Total      int      `json:"total" structs:"total"`
+	IsLast     bool     `json:"isLast" structs:"isLast"`
+	Values     []string `json:"values" structs:"values"`
+}
+
+func (l *LabelsList) pageInfo() PageInfo {
+	return PageInfo{StartAt: l.StartAt, MaxResults: l.MaxResults, Total: l.Total, IsLast: l.IsLast}
+}
+
+// LabelListOptions specifies the optional parameters to the LabelService.GetList method
+type LabelListOptions struct {
+	StartAt int `url:"startAt,omitempty"`
+	// MaxResults is the maximum number of labels per page. Default: 1000
+	MaxResults int `url:"maxResults,omitempty"`
+}
+
+// GetListWithContext returns a page of the labels of the instance.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-labels/#api-rest-api-2-label-get
+func (s *LabelService) GetListWithContext(ctx context.Context, options *LabelListOptions) (*LabelsList, *Response, error) {
+	apiEndpoint, err := addOptions("rest/api/2/label", options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	labels := new(LabelsList)
+	resp, err := s.client.Do(req, labels)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return labels, resp, nil
+}
+
+// GetList wraps GetListWithContext using the background context.
+func (s *LabelService) GetList(options *LabelListOptions) (*LabelsList, *Response, error) {
+	return s.GetListWithContext(context.Background(), options)
+}
+
+// GetAllWithContext returns all labels of the instance, fetching all pages.
+func (s *LabelService) GetAllWithContext(ctx context.Context) ([]string, error) {
+	labels := []string{}
+	options := &LabelListOptions{}
+	for {
+		page, _, err := s.GetListWithContext(ctx, options)
+		if err != nil {
+			return nil, err
+		}
+		labels = append(labels, page.Values...)
+
+		if page.IsLast || len(page.Values) == 0 {
+			return labels, nil
+		}
+		options.StartAt = page.StartAt + len(page.Values)
+	}
+}
+
+// GetAll wraps GetAllWithContext using the background context.
+func (s *LabelService) GetAll() ([]string, error) {
+	return s.GetAllWithContext(context.Background())
+}
@@ -0,0 +1,51 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestLabelService_GetList(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/label"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint+"?maxResults=2&startAt=4")
+		fmt.Fprint(w, `{"maxResults":2,"startAt":4,"total":6,"isLast":true,"values":["performance","security"]}`)
+	})
+
+	labels, _, err := testClient.Label.GetList(&LabelListOptions{StartAt: 4, MaxResults: 2})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if !reflect.DeepEqual(labels.Values, []string{"performance", "security"}) || labels.Total != 6 {
+		t.Errorf("Unexpected labels %+v", labels)
+	}
+}
+
+func TestLabelService_GetAll(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/label", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.URL.Query().Get("startAt") {
+		case "":
+			fmt.Fprint(w, `{"maxResults":2,"startAt":0,"total":3,"isLast":false,"values":["backend","frontend"]}`)
+		case "2":
+			fmt.Fprint(w, `{"maxResults":2,"startAt":2,"total":3,"isLast":true,"values":["ux"]}`)
+		default:
+			t.Errorf("Unexpected URL %s", r.URL)
+		}
+	})
+
+	labels, err := testClient.Label.GetAll()
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if !reflect.DeepEqual(labels, []string{"backend", "frontend", "ux"}) {
+		t.Errorf("Unexpected labels %v", labels)
+	}
+}
@@ -0,0 +1,144 @@
+// Package mapping translates statuses, priorities and issue types between differently configured JIRA instances.
+//
+// A Table maps the IDs or names of the source instance to the names of the target instance.
+// Unmapped values fall back to a configurable default, or are passed through unchanged,
+// which works for instances sharing most of their configuration.
+// A Mapping combines the tables and can be loaded from JSON, e.g.
+//
+//	{
+//	  "statuses":   {"entries": {"Open": "To Do", "10001": "In Progress"}, "fallback": "To Do"},
+//	  "priorities": {"entries": {"Blocker": "Highest"}, "passThrough": true},
+//	  "issueTypes": {"entries": {"Defect": "Bug"}, "passThrough": true}
+//	}
+//
+// It is used by the migrate package to translate the issues it copies.
+package mapping
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+
+	jira "github.com/andygrunwald/go-jira"
+)
+
+// Table maps IDs or names of a source instance to names of a target instance.
+// Names are matched case-insensitively, IDs take precedence over names.
+type Table struct {
+	// Entries maps a source ID or name to the target name
+	Entries map[string]string `json:"entries,omitempty"`
+	// Fallback is the target name of unmapped values
+	Fallback string `json:"fallback,omitempty"`
+	// PassThrough keeps the source name of unmapped values if there is no Fallback
+	PassThrough bool `json:"passThrough,omitempty"`
+}
+
+// NewTable returns an empty table, which passes unmapped values through if passThrough is true
+func NewTable(passThrough bool) *Table {
+	return &Table{Entries: map[string]string{}, PassThrough: passThrough}
+}
+
+// Set maps the source ID or name to the target name
+func (t *Table) Set(source, target string) *Table {
+	if t.Entries == nil {
+		t.Entries = map[string]string{}
+	}
+	t.Entries[source] = target
+	return t
+}
+
+// Map returns the target name for the source value with the given ID and name.
+// The ID is looked up first, then the name, then the Fallback applies.
+// It returns false if the value is unmapped, has no fallback and is not passed through.
+func (t *Table) Map(id, name string) (string, bool) {
+	if t == nil {
+		return name, name != ""
+	}
+	if id != "" {
+		if target, ok := t.Entries[id]; ok {
+			return target, true
+		}
+	}
+	if name != "" {
+		if target, ok := t.Entries[name]; ok {
+			return target, true
+		}
+		for source, target := range t.Entries {
+			if strings.EqualFold(source, name) {
+				return target, true
+			}
+		}
+	}
+	if t.Fallback != "" {
+		return t.Fallback, true
+	}
+	if t.PassThrough && name != "" {
+		return name, true
+	}
+	return "", false
+}
+
+// Mapping contains the tables of one pair of instances.
+// Nil tables pass all values through.
+type Mapping struct {
+	Statuses   *Table `json:"statuses,omitempty"`
+	Priorities *Table `json:"priorities,omitempty"`
+	IssueTypes *Table `json:"issueTypes,omitempty"`
+}
+
+// Load reads a Mapping in JSON format from r
+func Load(r io.Reader) (*Mapping, error) {
+	m := new(Mapping)
+	if err := json.NewDecoder(r).Decode(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Status returns the target name of the status, or "" if it is unmapped
+func (m *Mapping) Status(status *jira.Status) string {
+	if status == nil {
+		return ""
+	}
+	target, _ := m.statuses().Map(status.ID, status.Name)
+	return target
+}
+
+// Priority returns the target name of the priority, or "" if it is unmapped
+func (m *Mapping) Priority(priority *jira.Priority) string {
+	if priority == nil {
+		return ""
+	}
+	target, _ := m.priorities().Map(priority.ID, priority.Name)
+	return target
+}
+
+// IssueType returns the target name of the issue type, or "" if it is unmapped
+func (m *Mapping) IssueType(issueType *jira.IssueType) string {
+	if issueType == nil {
+		return ""
+	}
+	target, _ := m.issueTypes().Map(issueType.ID, issueType.Name)
+	return target
+}
+
+func (m *Mapping) statuses() *Table {
+	if m == nil {
+		return nil
+	}
+	return m.Statuses
+}
+
+func (m *Mapping) priorities() *Table {
+	if m == nil {
+		return nil
+	}
+	return m.Priorities
+}
+
+func (m *Mapping) issueTypes() *Table {
+	if m == nil {
+		return nil
+	}
+	return m.IssueTypes
+}
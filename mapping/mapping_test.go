@@ -0,0 +1,71 @@
+package mapping
+
+import (
+	"strings"
+	"testing"
+
+	jira "github.com/andygrunwald/go-jira"
+)
+
+func TestTable_Map(t *testing.T) {
+	table := NewTable(false).Set("Open", "To Do").Set("10001", "In Progress")
+
+	tests := []struct {
+		id, name string
+		want     string
+		ok       bool
+	}{
+		{"10001", "Whatever", "In Progress", true},
+		{"", "open", "To Do", true},
+		{"3", "Open", "To Do", true},
+		{"", "Closed", "", false},
+	}
+	for _, test := range tests {
+		got, ok := table.Map(test.id, test.name)
+		if got != test.want || ok != test.ok {
+			t.Errorf("Map(%q, %q) = %q, %v, want %q, %v", test.id, test.name, got, ok, test.want, test.ok)
+		}
+	}
+
+	table.PassThrough = true
+	if got, ok := table.Map("", "Closed"); got != "Closed" || !ok {
+		t.Errorf("Expected the name to be passed through, got %q, %v", got, ok)
+	}
+	table.Fallback = "Backlog"
+	if got, ok := table.Map("", "Closed"); got != "Backlog" || !ok {
+		t.Errorf("Expected the fallback, got %q, %v", got, ok)
+	}
+
+	var nilTable *Table
+	if got, ok := nilTable.Map("1", "Closed"); got != "Closed" || !ok {
+		t.Errorf("Expected a nil table to pass through, got %q, %v", got, ok)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	m, err := Load(strings.NewReader(`{
+		"statuses":   {"entries": {"Open": "To Do"}, "fallback": "To Do"},
+		"priorities": {"entries": {"Blocker": "Highest"}, "passThrough": true}
+	}`))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	if got := m.Status(&jira.Status{Name: "Reopened"}); got != "To Do" {
+		t.Errorf("Status = %q, want the fallback", got)
+	}
+	if got := m.Priority(&jira.Priority{Name: "blocker"}); got != "Highest" {
+		t.Errorf("Priority = %q, want Highest", got)
+	}
+	if got := m.Priority(&jira.Priority{Name: "Low"}); got != "Low" {
+		t.Errorf("Priority = %q, want Low", got)
+	}
+	if got := m.IssueType(&jira.IssueType{Name: "Bug"}); got != "Bug" {
+		t.Errorf("IssueType = %q, want Bug", got)
+	}
+
+	var nilMapping *Mapping
+	if got := nilMapping.Status(&jira.Status{Name: "Open"}); got != "Open" {
+		t.Errorf("Expected a nil mapping to pass through, got %q", got)
+	}
+}
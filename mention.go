@@ -0,0 +1,51 @@
+package jira
+
+import "fmt"
+
+// ADFNode represents a node of the Atlassian Document Format (ADF), which is used
+// by the JIRA Cloud REST API v3 for rich text fields like descriptions and comments.
+type ADFNode struct {
+	Type    string                 `json:"type" structs:"type"`
+	Text    string                 `json:"text,omitempty" structs:"text,omitempty"`
+	Attrs   map[string]interface{} `json:"attrs,omitempty" structs:"attrs,omitempty"`
+	Content []*ADFNode             `json:"content,omitempty" structs:"content,omitempty"`
+}
+
+// Mention returns the wiki markup which mentions the user, e.g. in a comment body.
+// JIRA Cloud identifies users by their account ID ([~accountid:5b10a2844c20165700ede21g]),
+// JIRA Server by their username ([~fred]). The account ID is used if it is set.
+func (u *User) Mention() string {
+	if u.AccountID != "" {
+		return fmt.Sprintf("[~accountid:%s]", u.AccountID)
+	}
+	return fmt.Sprintf("[~%s]", u.Name)
+}
+
+// MentionNode returns the ADF mention node of the user.
+// It can only be used with JIRA Cloud, as it requires the account ID of the user.
+func (u *User) MentionNode() *ADFNode {
+	attrs := map[string]interface{}{
+		"id": u.AccountID,
+	}
+	if u.DisplayName != "" {
+		attrs["text"] = "@" + u.DisplayName
+	}
+	return &ADFNode{Type: "mention", Attrs: attrs}
+}
+
+// NewADFDocument returns an ADF document with a single paragraph built from the given inline nodes,
+// e.g. mention nodes created by User.MentionNode and text nodes created by NewADFText.
+func NewADFDocument(inline ...*ADFNode) *ADFNode {
+	return &ADFNode{
+		Type:  "doc",
+		Attrs: map[string]interface{}{"version": 1},
+		Content: []*ADFNode{
+			{Type: "paragraph", Content: inline},
+		},
+	}
+}
+
+// NewADFText returns an ADF text node
+func NewADFText(text string) *ADFNode {
+	return &ADFNode{Type: "text", Text: text}
+}
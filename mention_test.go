@@ -0,0 +1,32 @@
+package jira
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestUser_Mention(t *testing.T) {
+	cloudUser := &User{AccountID: "5b10a2844c20165700ede21g", Name: "fred"}
+	if got := cloudUser.Mention(); got != "[~accountid:5b10a2844c20165700ede21g]" {
+		t.Errorf("Unexpected mention %s", got)
+	}
+
+	serverUser := &User{Name: "fred"}
+	if got := serverUser.Mention(); got != "[~fred]" {
+		t.Errorf("Unexpected mention %s", got)
+	}
+}
+
+func TestUser_MentionNode(t *testing.T) {
+	user := &User{AccountID: "5b10a2844c20165700ede21g", DisplayName: "Fred F. User"}
+	doc := NewADFDocument(user.MentionNode(), NewADFText(" please review"))
+
+	raw, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"type":"doc","attrs":{"version":1},"content":[{"type":"paragraph","content":[{"type":"mention","attrs":{"id":"5b10a2844c20165700ede21g","text":"@Fred F. User"}},{"type":"text","text":" please review"}]}]}`
+	if string(raw) != expected {
+		t.Errorf("Expected %s. Got %s", expected, raw)
+	}
+}
@@ -0,0 +1,260 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/go-querystring/query"
+)
+
+// DefaultMetadataCacheTTL is the default time the MetadataCacheService caches responses
+const DefaultMetadataCacheTTL = 10 * time.Minute
+
+// These constants are the kinds of metadata cached by the MetadataCacheService
+const (
+	MetadataKindProject    = "project"
+	MetadataKindField      = "field"
+	MetadataKindIssueType  = "issuetype"
+	MetadataKindPriority   = "priority"
+	MetadataKindStatus     = "status"
+	MetadataKindCreateMeta = "createmeta"
+)
+
+// MetadataCacheService caches the responses of the project, field, issue type, priority, status and create meta
+// endpoints for TTL. Long-running services, which resolve field schemas repeatedly, can use it
+// instead of ProjectService.GetList, FieldService.GetList, IssueTypeService.GetList, PriorityService.GetList,
+// StatusService.GetAllStatuses and IssueService.GetCreateMetaWithOptions to reduce the number of API calls.
+// The ResolverService looks up names in the responses of this cache.
+// The cache can be dropped explicitly with Invalidate, e.g. after creating a new field.
+//
+// The returned values are shared between callers and must not be modified.
+type MetadataCacheService struct {
+	client *Client
+
+	// TTL is the time the responses are cached. A TTL <= 0 disables the cache.
+	TTL time.Duration
+
+	mu      sync.Mutex
+	entries map[string]*metadataCacheEntry
+	// generation is incremented by Invalidate, responses loaded before are not cached
+	generation int
+}
+
+// metadataCacheEntry holds one cached response
+type metadataCacheEntry struct {
+	value    interface{}
+	loadedAt time.Time
+	// names is the name to ID mapping of the ResolverService, built from value on the first lookup
+	names *resolverEntry
+}
+
+// ProjectsWithContext returns all projects, see ProjectService.GetList
+func (s *MetadataCacheService) ProjectsWithContext(ctx context.Context) (*ProjectList, error) {
+	value, err := s.get(MetadataKindProject, s.loader(ctx, MetadataKindProject))
+	if err != nil {
+		return nil, err
+	}
+	return value.(*ProjectList), nil
+}
+
+// Projects wraps ProjectsWithContext using the background context.
+func (s *MetadataCacheService) Projects() (*ProjectList, error) {
+	return s.ProjectsWithContext(context.Background())
+}
+
+// FieldsWithContext returns all fields, see FieldService.GetList
+func (s *MetadataCacheService) FieldsWithContext(ctx context.Context) ([]Field, error) {
+	value, err := s.get(MetadataKindField, s.loader(ctx, MetadataKindField))
+	if err != nil {
+		return nil, err
+	}
+	return value.([]Field), nil
+}
+
+// Fields wraps FieldsWithContext using the background context.
+func (s *MetadataCacheService) Fields() ([]Field, error) {
+	return s.FieldsWithContext(context.Background())
+}
+
+// IssueTypesWithContext returns all issue types, see IssueTypeService.GetList
+func (s *MetadataCacheService) IssueTypesWithContext(ctx context.Context) ([]IssueType, error) {
+	value, err := s.get(MetadataKindIssueType, s.loader(ctx, MetadataKindIssueType))
+	if err != nil {
+		return nil, err
+	}
+	return value.([]IssueType), nil
+}
+
+// IssueTypes wraps IssueTypesWithContext using the background context.
+func (s *MetadataCacheService) IssueTypes() ([]IssueType, error) {
+	return s.IssueTypesWithContext(context.Background())
+}
+
+// PrioritiesWithContext returns all priorities, see PriorityService.GetList
+func (s *MetadataCacheService) PrioritiesWithContext(ctx context.Context) ([]Priority, error) {
+	value, err := s.get(MetadataKindPriority, s.loader(ctx, MetadataKindPriority))
+	if err != nil {
+		return nil, err
+	}
+	return value.([]Priority), nil
+}
+
+// Priorities wraps PrioritiesWithContext using the background context.
+func (s *MetadataCacheService) Priorities() ([]Priority, error) {
+	return s.PrioritiesWithContext(context.Background())
+}
+
+// StatusesWithContext returns all statuses, see StatusService.GetAllStatuses
+func (s *MetadataCacheService) StatusesWithContext(ctx context.Context) ([]Status, error) {
+	value, err := s.get(MetadataKindStatus, s.loader(ctx, MetadataKindStatus))
+	if err != nil {
+		return nil, err
+	}
+	return value.([]Status), nil
+}
+
+// Statuses wraps StatusesWithContext using the background context.
+func (s *MetadataCacheService) Statuses() ([]Status, error) {
+	return s.StatusesWithContext(context.Background())
+}
+
+// CreateMetaWithContext returns the create meta information, see IssueService.GetCreateMetaWithOptions.
+// The responses are cached per options.
+func (s *MetadataCacheService) CreateMetaWithContext(ctx context.Context, options *GetQueryOptions) (*CreateMetaInfo, error) {
+	key := MetadataKindCreateMeta
+	if options != nil {
+		q, err := query.Values(options)
+		if err != nil {
+			return nil, err
+		}
+		key += "?" + q.Encode()
+	}
+
+	value, err := s.get(key, func() (interface{}, error) {
+		meta, _, err := s.client.Issue.GetCreateMetaWithOptionsWithContext(ctx, options)
+		return meta, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return value.(*CreateMetaInfo), nil
+}
+
+// CreateMeta wraps CreateMetaWithContext using the background context.
+func (s *MetadataCacheService) CreateMeta(options *GetQueryOptions) (*CreateMetaInfo, error) {
+	return s.CreateMetaWithContext(context.Background(), options)
+}
+
+// Invalidate drops the cached responses of the given kinds (see MetadataKind* constants),
+// or of all kinds if no kind is given. They are loaded again on the next call.
+// This also drops the names looked up by the ResolverService.
+func (s *MetadataCacheService) Invalidate(kinds ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.generation++
+	if len(kinds) == 0 {
+		s.entries = nil
+		return
+	}
+	for key := range s.entries {
+		for _, kind := range kinds {
+			if key == kind || strings.HasPrefix(key, kind+"?") {
+				delete(s.entries, key)
+			}
+		}
+	}
+}
+
+// loader returns the function loading the response of the given kind, or nil for an unknown kind
+func (s *MetadataCacheService) loader(ctx context.Context, kind string) func() (interface{}, error) {
+	switch kind {
+	case MetadataKindProject:
+		return func() (interface{}, error) {
+			projects, _, err := s.client.Project.GetListWithContext(ctx)
+			return projects, err
+		}
+	case MetadataKindField:
+		return func() (interface{}, error) {
+			fields, _, err := s.client.Field.GetListWithContext(ctx)
+			return fields, err
+		}
+	case MetadataKindIssueType:
+		return func() (interface{}, error) {
+			issueTypes, _, err := s.client.IssueType.GetListWithContext(ctx)
+			return issueTypes, err
+		}
+	case MetadataKindPriority:
+		return func() (interface{}, error) {
+			priorities, _, err := s.client.Priority.GetListWithContext(ctx)
+			return priorities, err
+		}
+	case MetadataKindStatus:
+		return func() (interface{}, error) {
+			statuses, _, err := s.client.Status.GetAllStatusesWithContext(ctx)
+			return statuses, err
+		}
+	}
+	return nil
+}
+
+// names returns the name to ID mapping of the given kind for the ResolverService.
+// It is built once per cached response, so it expires and is invalidated together with the response.
+func (s *MetadataCacheService) names(ctx context.Context, kind string) (*resolverEntry, error) {
+	load := s.loader(ctx, kind)
+	if load == nil {
+		return nil, fmt.Errorf("unknown kind %q", kind)
+	}
+	entry, err := s.entry(kind, load)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if entry.names == nil {
+		entry.names = newResolverEntry(entry.value)
+	}
+	return entry.names, nil
+}
+
+// get returns the cached value of key, or loads and caches it if it is missing or expired
+func (s *MetadataCacheService) get(key string, load func() (interface{}, error)) (interface{}, error) {
+	entry, err := s.entry(key, load)
+	if err != nil {
+		return nil, err
+	}
+	return entry.value, nil
+}
+
+// entry returns the cached entry of key, or loads and caches it if it is missing or expired.
+// The lock is not held while loading, so concurrent callers may load the same key at the same time.
+func (s *MetadataCacheService) entry(key string, load func() (interface{}, error)) (*metadataCacheEntry, error) {
+	s.mu.Lock()
+	entry, ok := s.entries[key]
+	if ok && s.TTL > 0 && time.Since(entry.loadedAt) <= s.TTL {
+		s.mu.Unlock()
+		return entry, nil
+	}
+	generation := s.generation
+	s.mu.Unlock()
+
+	value, err := load()
+	if err != nil {
+		return nil, err
+	}
+	entry = &metadataCacheEntry{value: value, loadedAt: time.Now()}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.generation == generation {
+		if s.entries == nil {
+			s.entries = map[string]*metadataCacheEntry{}
+		}
+		s.entries[key] = entry
+	}
+	return entry, nil
+}
@@ -0,0 +1,116 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestMetadataCacheService_Fields(t *testing.T) {
+	setup()
+	defer teardown()
+	calls := 0
+	testMux.HandleFunc("/rest/api/2/field", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		calls++
+		fmt.Fprint(w, `[{"id":"customfield_10001","name":"Story Points","custom":true}]`)
+	})
+
+	for i := 0; i < 3; i++ {
+		fields, err := testClient.MetadataCache.Fields()
+		if err != nil {
+			t.Fatalf("Error given: %s", err)
+		}
+		if len(fields) != 1 || fields[0].ID != "customfield_10001" {
+			t.Errorf("Unexpected fields %+v", fields)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("Expected 1 request, got %d", calls)
+	}
+
+	testClient.MetadataCache.Invalidate(MetadataKindField)
+	if _, err := testClient.MetadataCache.Fields(); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if calls != 2 {
+		t.Errorf("Expected 2 requests after Invalidate, got %d", calls)
+	}
+}
+
+func TestMetadataCacheService_TTL(t *testing.T) {
+	setup()
+	defer teardown()
+	calls := 0
+	testMux.HandleFunc("/rest/api/2/status", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		fmt.Fprint(w, `[{"id":"1","name":"Open"}]`)
+	})
+
+	testClient.MetadataCache.TTL = time.Nanosecond
+	for i := 0; i < 2; i++ {
+		time.Sleep(time.Millisecond)
+		if _, err := testClient.MetadataCache.Statuses(); err != nil {
+			t.Fatalf("Error given: %s", err)
+		}
+	}
+	if calls != 2 {
+		t.Errorf("Expected 2 requests with expired cache, got %d", calls)
+	}
+}
+
+func TestMetadataCacheService_CreateMeta(t *testing.T) {
+	setup()
+	defer teardown()
+	calls := map[string]int{}
+	testMux.HandleFunc("/rest/api/2/issue/createmeta", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		calls[r.URL.Query().Get("projectKeys")]++
+		fmt.Fprintf(w, `{"projects":[{"key":"%s","issuetypes":[]}]}`, r.URL.Query().Get("projectKeys"))
+	})
+
+	for _, key := range []string{"SPN", "ABC", "SPN"} {
+		meta, err := testClient.MetadataCache.CreateMeta(&GetQueryOptions{ProjectKeys: key})
+		if err != nil {
+			t.Fatalf("Error given: %s", err)
+		}
+		if meta.Projects[0].Key != key {
+			t.Errorf("Expected project %s, got %s", key, meta.Projects[0].Key)
+		}
+	}
+	if calls["SPN"] != 1 || calls["ABC"] != 1 {
+		t.Errorf("Expected one request per project, got %v", calls)
+	}
+
+	testClient.MetadataCache.Invalidate(MetadataKindCreateMeta)
+	if _, err := testClient.MetadataCache.CreateMeta(&GetQueryOptions{ProjectKeys: "SPN"}); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if calls["SPN"] != 2 {
+		t.Errorf("Expected 2 requests after Invalidate, got %d", calls["SPN"])
+	}
+}
+
+func TestMetadataCacheService_InvalidateWhileLoading(t *testing.T) {
+	setup()
+	defer teardown()
+	calls := 0
+	testMux.HandleFunc("/rest/api/2/field", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			// the lock is not held while loading, so this does not block
+			testClient.MetadataCache.Invalidate(MetadataKindField)
+		}
+		fmt.Fprint(w, `[{"id":"summary","name":"Summary"}]`)
+	})
+
+	for i := 0; i < 2; i++ {
+		if _, err := testClient.MetadataCache.Fields(); err != nil {
+			t.Fatalf("Error given: %s", err)
+		}
+	}
+	if calls != 2 {
+		t.Errorf("Expected the response loaded during Invalidate not to be cached, got %d requests", calls)
+	}
+}
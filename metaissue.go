@@ -1,7 +1,10 @@
 package jira
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/google/go-querystring/query"
@@ -42,16 +45,50 @@ type MetaIssueType struct {
 	Fields      tcontainer.MarshalMap `json:"fields,omitempty"`
 }
 
-// GetCreateMeta makes the api call to get the meta information required to create a ticket
+// MetaField is the meta information of a field on the create or edit screen of an issue.
+// AllowedValues are the raw JSON objects of the valid values, e.g. priorities, versions or custom field options.
+type MetaField struct {
+	Required        bool          `json:"required" structs:"required"`
+	Name            string        `json:"name,omitempty" structs:"name,omitempty"`
+	Key             string        `json:"key,omitempty" structs:"key,omitempty"`
+	Schema          FieldSchema   `json:"schema,omitempty" structs:"schema,omitempty"`
+	HasDefaultValue bool          `json:"hasDefaultValue,omitempty" structs:"hasDefaultValue,omitempty"`
+	Operations      []string      `json:"operations,omitempty" structs:"operations,omitempty"`
+	AllowedValues   []interface{} `json:"allowedValues,omitempty" structs:"allowedValues,omitempty"`
+	AutoCompleteURL string        `json:"autoCompleteUrl,omitempty" structs:"autoCompleteUrl,omitempty"`
+}
+
+// EditMetaInfo contains the fields of an issue which can be edited, by field ID.
+type EditMetaInfo struct {
+	Fields map[string]*MetaField `json:"fields,omitempty"`
+}
+
+// MetaFieldError describes a field value which does not match the meta information of the field
+type MetaFieldError struct {
+	FieldID string
+	Name    string
+	Message string
+}
+
+func (e *MetaFieldError) Error() string {
+	return fmt.Sprintf("field %s (%s): %s", e.Name, e.FieldID, e.Message)
+}
+
+// GetCreateMetaWithContext makes the api call to get the meta information required to create a ticket
+func (s *IssueService) GetCreateMetaWithContext(ctx context.Context, projectkeys string) (*CreateMetaInfo, *Response, error) {
+	return s.GetCreateMetaWithOptionsWithContext(ctx, &GetQueryOptions{ProjectKeys: projectkeys, Expand: ExpandCreateMetaFields})
+}
+
+// GetCreateMeta wraps GetCreateMetaWithContext using the background context.
 func (s *IssueService) GetCreateMeta(projectkeys string) (*CreateMetaInfo, *Response, error) {
-	return s.GetCreateMetaWithOptions(&GetQueryOptions{ProjectKeys: projectkeys, Expand: "projects.issuetypes.fields"})
+	return s.GetCreateMetaWithContext(context.Background(), projectkeys)
 }
 
-// GetCreateMetaWithOptions makes the api call to get the meta information without requiring to have a projectKey
-func (s *IssueService) GetCreateMetaWithOptions(options *GetQueryOptions) (*CreateMetaInfo, *Response, error) {
+// GetCreateMetaWithOptionsWithContext makes the api call to get the meta information without requiring to have a projectKey
+func (s *IssueService) GetCreateMetaWithOptionsWithContext(ctx context.Context, options *GetQueryOptions) (*CreateMetaInfo, *Response, error) {
 	apiEndpoint := "rest/api/2/issue/createmeta"
 
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -67,12 +104,17 @@ func (s *IssueService) GetCreateMetaWithOptions(options *GetQueryOptions) (*Crea
 	resp, err := s.client.Do(req, meta)
 
 	if err != nil {
-		return nil, resp, err
+		return nil, resp, NewJiraError(resp, err)
 	}
 
 	return meta, resp, nil
 }
 
+// GetCreateMetaWithOptions wraps GetCreateMetaWithOptionsWithContext using the background context.
+func (s *IssueService) GetCreateMetaWithOptions(options *GetQueryOptions) (*CreateMetaInfo, *Response, error) {
+	return s.GetCreateMetaWithOptionsWithContext(context.Background(), options)
+}
+
 // GetProjectWithName returns a project with "name" from the meta information received. If not found, this returns nil.
 // The comparison of the name is case insensitive.
 func (m *CreateMetaInfo) GetProjectWithName(name string) *MetaProject {
@@ -192,3 +234,170 @@ func (t *MetaIssueType) CheckCompleteAndAvailable(config map[string]string) (boo
 
 	return true, nil
 }
+
+// GetEditMetaWithContext returns the meta information of the fields of the issue issueID, which can be edited.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issues/#api-rest-api-2-issue-issueidorkey-editmeta-get
+func (s *IssueService) GetEditMetaWithContext(ctx context.Context, issueID string) (*EditMetaInfo, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/editmeta", issueID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	meta := new(EditMetaInfo)
+	resp, err := s.client.Do(req, meta)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return meta, resp, nil
+}
+
+// GetEditMeta wraps GetEditMetaWithContext using the background context.
+func (s *IssueService) GetEditMeta(issueID string) (*EditMetaInfo, *Response, error) {
+	return s.GetEditMetaWithContext(context.Background(), issueID)
+}
+
+// GetMetaFields returns the fields of the issue type as MetaField by field ID.
+func (t *MetaIssueType) GetMetaFields() (map[string]*MetaField, error) {
+	data, err := json.Marshal(t.Fields)
+	if err != nil {
+		return nil, err
+	}
+	fields := map[string]*MetaField{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// ValidateFields checks the field values of a new issue, by field ID, against the meta information of the issue type.
+// All required fields without a default value must be set, all fields must be on the create screen
+// and values of fields with allowed values must match one of them.
+// It returns an error for every invalid field, or nil if all fields are valid.
+func (t *MetaIssueType) ValidateFields(values map[string]interface{}) ([]*MetaFieldError, error) {
+	fields, err := t.GetMetaFields()
+	if err != nil {
+		return nil, err
+	}
+	return validateMetaFields(fields, values, true), nil
+}
+
+// ValidateFields checks the changed field values of an issue, by field ID, against the edit meta information.
+// All fields must be editable, required fields must not be cleared
+// and values of fields with allowed values must match one of them.
+// It returns an error for every invalid field, or nil if all fields are valid.
+func (m *EditMetaInfo) ValidateFields(values map[string]interface{}) []*MetaFieldError {
+	return validateMetaFields(m.Fields, values, false)
+}
+
+// IsAllowed reports whether value matches one of the allowed values of the field.
+// Strings match the id, key, name or value of an allowed value, objects match if one of these properties is equal
+// and lists match if all of their elements match. It returns true if the field has no allowed values.
+func (f *MetaField) IsAllowed(value interface{}) bool {
+	if len(f.AllowedValues) == 0 {
+		return true
+	}
+
+	var v interface{}
+	data, err := json.Marshal(value)
+	if err != nil || json.Unmarshal(data, &v) != nil {
+		return false
+	}
+	values, ok := v.([]interface{})
+	if !ok {
+		values = []interface{}{v}
+	}
+	for _, v := range values {
+		if !f.isAllowedValue(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// metaValueKeys are the properties which identify an allowed value
+var metaValueKeys = []string{"id", "key", "name", "value"}
+
+// isAllowedValue reports whether the decoded JSON value v matches one of the allowed values
+func (f *MetaField) isAllowedValue(v interface{}) bool {
+	for _, allowed := range f.AllowedValues {
+		a, ok := allowed.(map[string]interface{})
+		if !ok {
+			if allowed == v {
+				return true
+			}
+			continue
+		}
+		for _, key := range metaValueKeys {
+			if a[key] == nil {
+				continue
+			}
+			switch v := v.(type) {
+			case string:
+				if a[key] == v {
+					return true
+				}
+			case map[string]interface{}:
+				if a[key] == v[key] {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// validateMetaFields checks values against fields, see MetaIssueType.ValidateFields and EditMetaInfo.ValidateFields
+func validateMetaFields(fields map[string]*MetaField, values map[string]interface{}, requireAll bool) []*MetaFieldError {
+	var errs []*MetaFieldError
+
+	ids := make([]string, 0, len(fields))
+	for id := range fields {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		field := fields[id]
+		value, ok := values[id]
+		if !field.Required || (!ok && (!requireAll || field.HasDefaultValue)) {
+			continue
+		}
+		if isEmptyMetaValue(value) {
+			errs = append(errs, &MetaFieldError{FieldID: id, Name: field.Name, Message: "is required"})
+		}
+	}
+
+	ids = ids[:0]
+	for id := range values {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		field, ok := fields[id]
+		if !ok {
+			errs = append(errs, &MetaFieldError{FieldID: id, Name: id, Message: "is not available"})
+			continue
+		}
+		if !isEmptyMetaValue(values[id]) && !field.IsAllowed(values[id]) {
+			errs = append(errs, &MetaFieldError{FieldID: id, Name: field.Name, Message: fmt.Sprintf("value %v is not allowed", values[id])})
+		}
+	}
+
+	return errs
+}
+
+// isEmptyMetaValue reports whether value is nil, an empty string or an empty list
+func isEmptyMetaValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case []interface{}:
+		return len(v) == 0
+	case []string:
+		return len(v) == 0
+	}
+	return false
+}
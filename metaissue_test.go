@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+
+	"github.com/trivago/tgo/tcontainer"
 )
 
 func TestIssueService_GetCreateMeta_Success(t *testing.T) {
@@ -998,3 +1000,87 @@ func TestCreateMetaInfo_GetProjectWithKey_NilForNonExistent(t *testing.T) {
 		t.Errorf("Expected nil, received value")
 	}
 }
+
+func TestIssueService_GetEditMeta(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10002/editmeta", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/issue/10002/editmeta")
+		fmt.Fprint(w, `{"fields":{
+			"summary":{"required":true,"schema":{"type":"string","system":"summary"},"name":"Summary","key":"summary","operations":["set"]},
+			"priority":{"required":false,"schema":{"type":"priority","system":"priority"},"name":"Priority","key":"priority","operations":["set"],
+				"allowedValues":[{"id":"1","name":"Highest"},{"id":"3","name":"Medium"}]}}}`)
+	})
+
+	meta, _, err := testClient.Issue.GetEditMeta("10002")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(meta.Fields) != 2 || !meta.Fields["summary"].Required || len(meta.Fields["priority"].AllowedValues) != 2 {
+		t.Errorf("Unexpected edit meta %+v", meta)
+	}
+
+	errs := meta.ValidateFields(map[string]interface{}{
+		"summary":  "",
+		"priority": map[string]interface{}{"name": "Low"},
+		"labels":   []string{"a"},
+	})
+	if len(errs) != 3 {
+		t.Fatalf("Expected 3 errors. Got %v", errs)
+	}
+	if errs[0].FieldID != "summary" || errs[1].FieldID != "labels" || errs[2].FieldID != "priority" {
+		t.Errorf("Unexpected errors %v", errs)
+	}
+	if errs := meta.ValidateFields(map[string]interface{}{"priority": &Priority{ID: "3"}}); errs != nil {
+		t.Errorf("Expected no errors. Got %v", errs)
+	}
+}
+
+func TestMetaIssueType_ValidateFields(t *testing.T) {
+	data := map[string]interface{}{
+		"summary": map[string]interface{}{"required": true, "name": "Summary"},
+		"issuetype": map[string]interface{}{"required": true, "name": "Issue Type", "hasDefaultValue": true,
+			"allowedValues": []interface{}{map[string]interface{}{"id": "10001", "name": "Story"}}},
+		"customfield_10006": map[string]interface{}{"required": true, "name": "Team",
+			"allowedValues": []interface{}{map[string]interface{}{"id": "10300", "value": "Red"}, map[string]interface{}{"id": "10301", "value": "Blue"}}},
+		"labels": map[string]interface{}{"required": false, "name": "Labels"},
+	}
+	issueType := &MetaIssueType{Fields: tcontainer.MarshalMap(data)}
+
+	fields, err := issueType.GetMetaFields()
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if fields["customfield_10006"].Name != "Team" || len(fields["customfield_10006"].AllowedValues) != 2 {
+		t.Errorf("Unexpected fields %+v", fields)
+	}
+
+	errs, err := issueType.ValidateFields(map[string]interface{}{"labels": []string{"a"}})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(errs) != 2 || errs[0].FieldID != "customfield_10006" || errs[1].FieldID != "summary" {
+		t.Errorf("Expected the missing required fields. Got %v", errs)
+	}
+
+	errs, err = issueType.ValidateFields(map[string]interface{}{
+		"summary":           "Import",
+		"issuetype":         "Story",
+		"customfield_10006": []*CustomFieldOption{{Value: "Blue"}, {ID: "10300"}},
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if errs != nil {
+		t.Errorf("Expected no errors. Got %v", errs)
+	}
+
+	errs, _ = issueType.ValidateFields(map[string]interface{}{
+		"summary":           "Import",
+		"customfield_10006": &CustomFieldOption{Value: "Green"},
+	})
+	if len(errs) != 1 || errs[0].FieldID != "customfield_10006" {
+		t.Errorf("Expected an error for the value which is not allowed. Got %v", errs)
+	}
+}
@@ -0,0 +1,341 @@
+// Package migrate copies issues from one JIRA instance to another.
+//
+// An issue is copied with its fields, comments, attachments, worklogs and links,
+// and is transitioned into the status mapped from its source status.
+// Users, custom fields, statuses and the keys of linked issues usually differ between instances,
+// so they are translated by the mapping hooks of Options.
+// In dry-run mode nothing is written to the target, the planned actions are reported in the Result.
+package migrate
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	jira "github.com/andygrunwald/go-jira"
+	"github.com/andygrunwald/go-jira/mapping"
+)
+
+// DryRunKey is the key of the target issue reported in dry-run mode
+const DryRunKey = "DRY-RUN"
+
+// Options configure the migration
+type Options struct {
+	// TargetProject is the key of the project the issues are created in
+	TargetProject string
+
+	// MapUser maps a user of the source to the user of the target, e.g. by email address.
+	// Returning nil drops the user, e.g. leaves the issue unassigned. If nil, users are dropped.
+	MapUser func(user *jira.User) *jira.User
+	// MapField maps a custom field of the source to a custom field of the target.
+	// It returns the target field ID and value, or false to drop the field.
+	// If nil, custom fields are dropped.
+	MapField func(fieldID string, value interface{}) (string, interface{}, bool)
+	// MapStatus returns the name of the target status for a source status.
+	// The target issue is transitioned into it. Returning "" keeps the initial status.
+	// If nil, the status is translated by Mapping.
+	MapStatus func(status *jira.Status) string
+	// MapIssueKey returns the key of the target issue for a linked source issue, e.g. from a previous migration.
+	// Returning "" drops the link. If nil, links are dropped.
+	MapIssueKey func(sourceKey string) string
+
+	// Mapping translates the statuses, priorities and issue types.
+	// If nil, the names of the source are used.
+	Mapping *mapping.Mapping
+
+	SkipComments    bool
+	SkipAttachments bool
+	SkipWorklogs    bool
+
+	// DryRun reports the planned actions without writing to the target
+	DryRun bool
+}
+
+// Result describes the migration of one issue
+type Result struct {
+	SourceKey string
+	TargetKey string
+	// Actions are the performed actions, or the planned actions in dry-run mode
+	Actions []string
+	// Warnings are the parts of the issue, which could not be migrated
+	Warnings []string
+}
+
+func (r *Result) action(format string, args ...interface{}) {
+	r.Actions = append(r.Actions, fmt.Sprintf(format, args...))
+}
+
+func (r *Result) warn(format string, args ...interface{}) {
+	r.Warnings = append(r.Warnings, fmt.Sprintf(format, args...))
+}
+
+// Migrator copies issues from Source to Target
+type Migrator struct {
+	Source  *jira.Client
+	Target  *jira.Client
+	Options Options
+}
+
+// New returns a Migrator copying issues from source to target
+func New(source, target *jira.Client, options Options) *Migrator {
+	return &Migrator{Source: source, Target: target, Options: options}
+}
+
+// MigrateWithContext copies the issue with the given key to the target.
+// It stops at the first failing request and returns the result so far with the error.
+func (m *Migrator) MigrateWithContext(ctx context.Context, issueKey string) (*Result, error) {
+	result := &Result{SourceKey: issueKey}
+
+	issue, _, err := m.Source.Issue.GetWithContext(ctx, issueKey, nil)
+	if err != nil {
+		return result, err
+	}
+	if issue.Fields == nil {
+		return result, fmt.Errorf("issue %s has no fields", issueKey)
+	}
+
+	if err := m.create(ctx, issue, result); err != nil {
+		return result, err
+	}
+	if err := m.transition(ctx, issue, result); err != nil {
+		return result, err
+	}
+	if !m.Options.SkipComments {
+		if err := m.copyComments(ctx, issue, result); err != nil {
+			return result, err
+		}
+	}
+	if !m.Options.SkipAttachments {
+		if err := m.copyAttachments(ctx, issue, result); err != nil {
+			return result, err
+		}
+	}
+	if !m.Options.SkipWorklogs {
+		if err := m.copyWorklogs(ctx, issue, result); err != nil {
+			return result, err
+		}
+	}
+	if err := m.copyLinks(ctx, issue, result); err != nil {
+		return result, err
+	}
+	return result, nil
+}
+
+// Migrate wraps MigrateWithContext using the background context.
+func (m *Migrator) Migrate(issueKey string) (*Result, error) {
+	return m.MigrateWithContext(context.Background(), issueKey)
+}
+
+// targetFields returns the fields of the target issue
+func (m *Migrator) targetFields(source *jira.IssueFields, result *Result) *jira.IssueFields {
+	fields := &jira.IssueFields{
+		Project:     jira.Project{Key: m.Options.TargetProject},
+		Type:        jira.IssueType{Name: m.Options.Mapping.IssueType(&source.Type)},
+		Summary:     source.Summary,
+		Description: source.Description,
+		Labels:      source.Labels,
+		Duedate:     source.Duedate,
+		Unknowns:    map[string]interface{}{},
+	}
+	if fields.Type.Name == "" {
+		result.warn("unmapped issue type %s", source.Type.Name)
+		fields.Type.Name = source.Type.Name
+	}
+	if source.Priority != nil {
+		if priority := m.Options.Mapping.Priority(source.Priority); priority != "" {
+			fields.Priority = &jira.Priority{Name: priority}
+		} else {
+			result.warn("dropped unmapped priority %s", source.Priority.Name)
+		}
+	}
+	for _, component := range source.Components {
+		fields.Components = append(fields.Components, &jira.Component{Name: component.Name})
+	}
+	fields.Assignee = m.mapUser(source.Assignee)
+	fields.Reporter = m.mapUser(source.Reporter)
+
+	for id, value := range source.Unknowns {
+		if !strings.HasPrefix(id, "customfield_") || value == nil {
+			continue
+		}
+		if m.Options.MapField == nil {
+			result.warn("dropped custom field %s", id)
+			continue
+		}
+		targetID, targetValue, ok := m.Options.MapField(id, value)
+		if !ok {
+			result.warn("dropped custom field %s", id)
+			continue
+		}
+		fields.Unknowns[targetID] = targetValue
+	}
+	return fields
+}
+
+func (m *Migrator) mapUser(user *jira.User) *jira.User {
+	if user == nil || m.Options.MapUser == nil {
+		return nil
+	}
+	return m.Options.MapUser(user)
+}
+
+// create creates the target issue
+func (m *Migrator) create(ctx context.Context, issue *jira.Issue, result *Result) error {
+	fields := m.targetFields(issue.Fields, result)
+	result.action("create %s issue %q in project %s", fields.Type.Name, fields.Summary, fields.Project.Key)
+	if m.Options.DryRun {
+		result.TargetKey = DryRunKey
+		return nil
+	}
+
+	created, _, err := m.Target.Issue.CreateWithContext(ctx, &jira.Issue{Fields: fields})
+	if err != nil {
+		return err
+	}
+	result.TargetKey = created.Key
+	return nil
+}
+
+// transition moves the target issue into the status mapped from the source status
+func (m *Migrator) transition(ctx context.Context, issue *jira.Issue, result *Result) error {
+	if issue.Fields.Status == nil {
+		return nil
+	}
+	status := m.Options.Mapping.Status(issue.Fields.Status)
+	if m.Options.MapStatus != nil {
+		status = m.Options.MapStatus(issue.Fields.Status)
+	}
+	if status == "" {
+		return nil
+	}
+	if m.Options.DryRun {
+		result.action("transition to status %s", status)
+		return nil
+	}
+
+	transitions, _, err := m.Target.Issue.GetTransitionsWithContext(ctx, result.TargetKey)
+	if err != nil {
+		return err
+	}
+	for _, transition := range transitions {
+		if strings.EqualFold(transition.To.Name, status) {
+			result.action("transition to status %s", status)
+			if _, err := m.Target.Issue.DoTransitionWithContext(ctx, result.TargetKey, transition.ID); err != nil {
+				return err
+			}
+			return nil
+		}
+	}
+	result.warn("no transition to status %s", status)
+	return nil
+}
+
+// copyComments adds the comments of the source issue, prefixed with their original author and date
+func (m *Migrator) copyComments(ctx context.Context, issue *jira.Issue, result *Result) error {
+	if issue.Fields.Comments == nil {
+		return nil
+	}
+	for _, comment := range issue.Fields.Comments.Comments {
+		if comment == nil {
+			continue
+		}
+		result.action("add comment %s", comment.ID)
+		if m.Options.DryRun {
+			continue
+		}
+		body := fmt.Sprintf("_%s, %s:_\n%s", comment.Author.DisplayName, comment.Created, comment.Body)
+		if _, _, err := m.Target.Issue.AddCommentWithContext(ctx, result.TargetKey, &jira.Comment{Body: body, Visibility: comment.Visibility}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// copyAttachments streams the attachments of the source issue to the target issue
+func (m *Migrator) copyAttachments(ctx context.Context, issue *jira.Issue, result *Result) error {
+	for _, attachment := range issue.Fields.Attachments {
+		if attachment == nil {
+			continue
+		}
+		result.action("copy attachment %s", attachment.Filename)
+		if m.Options.DryRun {
+			continue
+		}
+		resp, err := m.Source.Issue.DownloadAttachmentWithContext(ctx, attachment.ID)
+		if err != nil {
+			return err
+		}
+		_, _, err = m.Target.Issue.PostAttachmentWithContext(ctx, result.TargetKey, resp.Body, attachment.Filename)
+		resp.Body.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// copyWorklogs adds all worklogs of the source issue
+func (m *Migrator) copyWorklogs(ctx context.Context, issue *jira.Issue, result *Result) error {
+	worklog, _, err := m.Source.Issue.GetWorklogsWithContext(ctx, issue.Key)
+	if err != nil {
+		return err
+	}
+	for _, record := range worklog.Worklogs {
+		result.action("add worklog of %ds", record.TimeSpentSeconds)
+		if m.Options.DryRun {
+			continue
+		}
+		copied := &jira.WorklogRecord{
+			Comment:          record.Comment,
+			Started:          record.Started,
+			TimeSpentSeconds: record.TimeSpentSeconds,
+		}
+		if record.Author != nil && record.Author.DisplayName != "" {
+			copied.Comment = fmt.Sprintf("%s: %s", record.Author.DisplayName, record.Comment)
+		}
+		if _, _, err := m.Target.Issue.AddWorklogRecordWithContext(ctx, result.TargetKey, copied); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// copyLinks links the target issue to the target issues of the linked source issues
+func (m *Migrator) copyLinks(ctx context.Context, issue *jira.Issue, result *Result) error {
+	for _, link := range issue.Fields.IssueLinks {
+		if link == nil {
+			continue
+		}
+		var other string
+		if link.OutwardIssue != nil {
+			other = link.OutwardIssue.Key
+		} else if link.InwardIssue != nil {
+			other = link.InwardIssue.Key
+		}
+		target := ""
+		if m.Options.MapIssueKey != nil {
+			target = m.Options.MapIssueKey(other)
+		}
+		if target == "" {
+			result.warn("dropped %s link to %s", link.Type.Name, other)
+			continue
+		}
+
+		result.action("link %s %s", link.Type.Name, target)
+		if m.Options.DryRun {
+			continue
+		}
+		targetLink := &jira.IssueLink{Type: jira.IssueLinkType{Name: link.Type.Name}}
+		if link.OutwardIssue != nil {
+			targetLink.InwardIssue = &jira.Issue{Key: result.TargetKey}
+			targetLink.OutwardIssue = &jira.Issue{Key: target}
+		} else {
+			targetLink.InwardIssue = &jira.Issue{Key: target}
+			targetLink.OutwardIssue = &jira.Issue{Key: result.TargetKey}
+		}
+		if _, err := m.Target.Issue.AddLinkWithContext(ctx, targetLink); err != nil {
+			return err
+		}
+	}
+	return nil
+}
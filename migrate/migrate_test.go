@@ -0,0 +1,225 @@
+package migrate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	jira "github.com/andygrunwald/go-jira"
+	"github.com/andygrunwald/go-jira/mapping"
+)
+
+const sourceIssue = `{"id":"10002","key":"OLD-1","fields":{
+	"summary":"Broken login","description":"It fails","issuetype":{"name":"Bug"},"priority":{"id":"2","name":"High"},
+	"labels":["auth"],"status":{"name":"In Progress"},
+	"assignee":{"name":"fred","emailAddress":"fred@example.com"},
+	"customfield_10001":5,"customfield_10002":"dropped",
+	"comment":{"comments":[{"id":"1","author":{"displayName":"Fred"},"created":"2020-05-17T10:00:00.000+0000","body":"Looking into it"}]},
+	"attachment":[{"id":"100","filename":"log.txt"}],
+	"issuelinks":[{"type":{"name":"Blocks"},"outwardIssue":{"key":"OLD-2"}},{"type":{"name":"Relates"},"inwardIssue":{"key":"OLD-3"}}]
+}}`
+
+func newSource(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/api/2/issue/OLD-1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, sourceIssue)
+	})
+	mux.HandleFunc("/rest/api/2/issue/OLD-1/worklog", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"startAt":0,"maxResults":1,"total":1,"worklogs":[{"author":{"displayName":"Fred"},"comment":"debugging","started":"2020-05-17T10:00:00.000+0000","timeSpentSeconds":3600}]}`)
+	})
+	mux.HandleFunc("/secure/attachment/100/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "log content")
+	})
+	return httptest.NewServer(mux)
+}
+
+func newClient(t *testing.T, server *httptest.Server) *jira.Client {
+	client, err := jira.NewClient(nil, server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func testOptions() Options {
+	return Options{
+		TargetProject: "NEW",
+		MapUser: func(user *jira.User) *jira.User {
+			return &jira.User{AccountID: "account-of-" + user.Name}
+		},
+		MapField: func(id string, value interface{}) (string, interface{}, bool) {
+			if id == "customfield_10001" {
+				return "customfield_20001", value, true
+			}
+			return "", nil, false
+		},
+		MapIssueKey: func(key string) string {
+			if key == "OLD-2" {
+				return "NEW-2"
+			}
+			return ""
+		},
+	}
+}
+
+func TestMigrator_Migrate(t *testing.T) {
+	source := newSource(t)
+	defer source.Close()
+
+	var calls []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&payload)
+		fields := payload["fields"]
+		if fields["summary"] != "Broken login" || fields["customfield_20001"] != 5.0 {
+			t.Errorf("Unexpected fields %v", fields)
+		}
+		if _, ok := fields["customfield_10002"]; ok {
+			t.Error("Expected unmapped custom field to be dropped")
+		}
+		if assignee := fields["assignee"].(map[string]interface{}); assignee["accountId"] != "account-of-fred" {
+			t.Errorf("Unexpected assignee %v", assignee)
+		}
+		calls = append(calls, "create")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"20001","key":"NEW-1"}`)
+	})
+	mux.HandleFunc("/rest/api/2/issue/NEW-1/transitions", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			fmt.Fprint(w, `{"transitions":[{"id":"11","to":{"name":"To Do"}},{"id":"21","to":{"name":"In Progress"}}]}`)
+			return
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		if !strings.Contains(string(body), `"id":"21"`) {
+			t.Errorf("Unexpected transition %s", body)
+		}
+		calls = append(calls, "transition")
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/rest/api/2/issue/NEW-1/comment", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, "comment")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"2"}`)
+	})
+	mux.HandleFunc("/rest/api/2/issue/NEW-1/attachments", func(w http.ResponseWriter, r *http.Request) {
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			t.Fatal(err)
+		}
+		content, _ := ioutil.ReadAll(file)
+		if header.Filename != "log.txt" || string(content) != "log content" {
+			t.Errorf("Unexpected attachment %s: %s", header.Filename, content)
+		}
+		calls = append(calls, "attachment")
+		fmt.Fprint(w, `[{"id":"200","filename":"log.txt"}]`)
+	})
+	mux.HandleFunc("/rest/api/2/issue/NEW-1/worklog", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, "worklog")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"300"}`)
+	})
+	mux.HandleFunc("/rest/api/2/issueLink", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, "link")
+		w.WriteHeader(http.StatusCreated)
+	})
+	target := httptest.NewServer(mux)
+	defer target.Close()
+
+	result, err := New(newClient(t, source), newClient(t, target), testOptions()).Migrate("OLD-1")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if result.TargetKey != "NEW-1" {
+		t.Errorf("Expected NEW-1, got %s", result.TargetKey)
+	}
+	if strings.Join(calls, ",") != "create,transition,comment,attachment,worklog,link" {
+		t.Errorf("Unexpected calls %v", calls)
+	}
+	if len(result.Warnings) != 2 {
+		t.Errorf("Expected warnings for the dropped custom field and link, got %v", result.Warnings)
+	}
+}
+
+func TestMigrator_Migrate_DryRun(t *testing.T) {
+	source := newSource(t)
+	defer source.Close()
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Expected no request to the target in dry-run mode, got %s %s", r.Method, r.URL)
+	}))
+	defer target.Close()
+
+	options := testOptions()
+	options.DryRun = true
+	result, err := New(newClient(t, source), newClient(t, target), options).Migrate("OLD-1")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if result.TargetKey != DryRunKey {
+		t.Errorf("Expected %s, got %s", DryRunKey, result.TargetKey)
+	}
+	expected := []string{
+		`create Bug issue "Broken login" in project NEW`,
+		"transition to status In Progress",
+		"add comment 1",
+		"copy attachment log.txt",
+		"add worklog of 3600s",
+		"link Blocks NEW-2",
+	}
+	if strings.Join(result.Actions, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("Unexpected actions\n%s", strings.Join(result.Actions, "\n"))
+	}
+}
+
+func TestMigrator_MigrateWithContext_Canceled(t *testing.T) {
+	source := newSource(t)
+	defer source.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/rest/api/2/issue" {
+			t.Errorf("Expected no request after the cancellation, got %s %s", r.Method, r.URL)
+			return
+		}
+		cancel()
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"20000","key":"NEW-1"}`)
+	}))
+	defer target.Close()
+
+	if _, err := New(newClient(t, source), newClient(t, target), testOptions()).MigrateWithContext(ctx, "OLD-1"); err == nil {
+		t.Error("Expected an error after the cancellation. Got none")
+	}
+}
+
+func TestMigrator_Migrate_Mapping(t *testing.T) {
+	source := newSource(t)
+	defer source.Close()
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Expected no request to the target in dry-run mode, got %s %s", r.Method, r.URL)
+	}))
+	defer target.Close()
+
+	options := testOptions()
+	options.DryRun = true
+	options.Mapping = &mapping.Mapping{
+		Statuses:   mapping.NewTable(false).Set("in progress", "Doing"),
+		Priorities: mapping.NewTable(false).Set("1", "Highest"),
+		IssueTypes: mapping.NewTable(true).Set("Bug", "Defect"),
+	}
+	result, err := New(newClient(t, source), newClient(t, target), options).Migrate("OLD-1")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if result.Actions[0] != `create Defect issue "Broken login" in project NEW` || result.Actions[1] != "transition to status Doing" {
+		t.Errorf("Unexpected actions\n%s", strings.Join(result.Actions, "\n"))
+	}
+	if !strings.Contains(strings.Join(result.Warnings, "\n"), "dropped unmapped priority High") {
+		t.Errorf("Expected a warning for the unmapped priority, got %v", result.Warnings)
+	}
+}
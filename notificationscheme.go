@@ -0,0 +1,195 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+)
+
+// NotificationSchemeService handles notification schemes for the JIRA instance / API.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-notification-schemes/
+type NotificationSchemeService struct {
+	client *Client
+}
+
+// These constants are the types of the recipients of notifications
+const (
+	NotificationTypeCurrentAssignee  = "CurrentAssignee"
+	NotificationTypeReporter         = "Reporter"
+	NotificationTypeCurrentUser      = "CurrentUser"
+	NotificationTypeProjectLead      = "ProjectLead"
+	NotificationTypeComponentLead    = "ComponentLead"
+	NotificationTypeUser             = "User"
+	NotificationTypeGroup            = "Group"
+	NotificationTypeProjectRole      = "ProjectRole"
+	NotificationTypeEmailAddress     = "EmailAddress"
+	NotificationTypeAllWatchers      = "AllWatchers"
+	NotificationTypeUserCustomField  = "UserCustomField"
+	NotificationTypeGroupCustomField = "GroupCustomField"
+)
+
+// NotificationEvent represents an event which triggers notifications, like "Issue created"
+type NotificationEvent struct {
+	ID          int64  `json:"id" structs:"id"`
+	Name        string `json:"name,omitempty" structs:"name,omitempty"`
+	Description string `json:"description,omitempty" structs:"description,omitempty"`
+}
+
+// EventNotification represents a recipient of the notifications of an event.
+// Parameter identifies the recipient, depending on NotificationType: the account ID of a user,
+// the name of a group, the ID of a project role, an email address or the ID of a custom field.
+type EventNotification struct {
+	ID               int64  `json:"id,omitempty" structs:"id,omitempty"`
+	NotificationType string `json:"notificationType" structs:"notificationType"`
+	Parameter        string `json:"parameter,omitempty" structs:"parameter,omitempty"`
+}
+
+// NotificationSchemeEvent represents the recipients of an event in a notification scheme
+type NotificationSchemeEvent struct {
+	Event         NotificationEvent   `json:"event" structs:"event"`
+	Notifications []EventNotification `json:"notifications" structs:"notifications"`
+}
+
+// NotificationScheme represents a notification scheme
+type NotificationScheme struct {
+	Expand                   string                    `json:"expand,omitempty" structs:"expand,omitempty"`
+	ID                       int64                     `json:"id,omitempty" structs:"id,omitempty"`
+	Self                     string                    `json:"self,omitempty" structs:"self,omitempty"`
+	Name                     string                    `json:"name" structs:"name"`
+	Description              string                    `json:"description,omitempty" structs:"description,omitempty"`
+	NotificationSchemeEvents []NotificationSchemeEvent `json:"notificationSchemeEvents,omitempty" structs:"notificationSchemeEvents,omitempty"`
+}
+
+// NotificationSchemesList reflects a page of notification schemes
+type NotificationSchemesList struct {
+	MaxResults int                  `json:"maxResults" structs:"maxResults"`
+	StartAt    int                  `json:"startAt" structs:"startAt"`
+	Total      int                  `json:"total" structs:"total"`
+	IsLast     bool                 `json:"isLast" structs:"isLast"`
+	Values     []NotificationScheme `json:"values" structs:"values"`
+}
+
+func (l *NotificationSchemesList) pageInfo() PageInfo {
+	return PageInfo{StartAt: l.StartAt, MaxResults: l.MaxResults, Total: l.Total, IsLast: l.IsLast}
+}
+
+// NotificationSchemeListOptions specifies the optional parameters to the NotificationSchemeService.GetList method
+type NotificationSchemeListOptions struct {
+	StartAt    int `url:"startAt,omitempty"`
+	MaxResults int `url:"maxResults,omitempty"`
+	// Expand "all" to include the events and their recipients
+	Expand string `url:"expand,omitempty"`
+}
+
+// GetListWithContext returns a page of the notification schemes
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-notification-schemes/#api-rest-api-2-notificationscheme-get
+func (s *NotificationSchemeService) GetListWithContext(ctx context.Context, options *NotificationSchemeListOptions) (*NotificationSchemesList, *Response, error) {
+	apiEndpoint, err := addOptions("rest/api/2/notificationscheme", options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	schemes := new(NotificationSchemesList)
+	resp, err := s.client.Do(req, schemes)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return schemes, resp, nil
+}
+
+// GetList wraps GetListWithContext using the background context.
+func (s *NotificationSchemeService) GetList(options *NotificationSchemeListOptions) (*NotificationSchemesList, *Response, error) {
+	return s.GetListWithContext(context.Background(), options)
+}
+
+// GetWithContext returns the notification scheme with the given ID, including its events and recipients
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-notification-schemes/#api-rest-api-2-notificationscheme-id-get
+func (s *NotificationSchemeService) GetWithContext(ctx context.Context, schemeID int64) (*NotificationScheme, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/notificationscheme/%d?expand=all", schemeID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scheme := new(NotificationScheme)
+	resp, err := s.client.Do(req, scheme)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return scheme, resp, nil
+}
+
+// Get wraps GetWithContext using the background context.
+func (s *NotificationSchemeService) Get(schemeID int64) (*NotificationScheme, *Response, error) {
+	return s.GetWithContext(context.Background(), schemeID)
+}
+
+// AddNotificationsWithContext adds recipients to events of the notification scheme.
+// Only the event ID is used of each NotificationSchemeEvent.Event.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-notification-schemes/#api-rest-api-2-notificationscheme-id-notification-put
+func (s *NotificationSchemeService) AddNotificationsWithContext(ctx context.Context, schemeID int64, events []NotificationSchemeEvent) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/notificationscheme/%d/notification", schemeID)
+
+	type eventID struct {
+		ID string `json:"id"`
+	}
+	type schemeEvent struct {
+		Event         eventID             `json:"event"`
+		Notifications []EventNotification `json:"notifications"`
+	}
+	payload := struct {
+		NotificationSchemeEvents []schemeEvent `json:"notificationSchemeEvents"`
+	}{}
+	for _, event := range events {
+		payload.NotificationSchemeEvents = append(payload.NotificationSchemeEvents, schemeEvent{
+			Event:         eventID{ID: fmt.Sprintf("%d", event.Event.ID)},
+			Notifications: event.Notifications,
+		})
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// AddNotifications wraps AddNotificationsWithContext using the background context.
+func (s *NotificationSchemeService) AddNotifications(schemeID int64, events []NotificationSchemeEvent) (*Response, error) {
+	return s.AddNotificationsWithContext(context.Background(), schemeID, events)
+}
+
+// RemoveNotificationWithContext removes the recipient with the given notification ID from the notification scheme.
+// The notification IDs are returned by Get in EventNotification.ID.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-notification-schemes/#api-rest-api-2-notificationscheme-notificationschemeid-notification-notificationid-delete
+func (s *NotificationSchemeService) RemoveNotificationWithContext(ctx context.Context, schemeID, notificationID int64) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/notificationscheme/%d/notification/%d", schemeID, notificationID)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// RemoveNotification wraps RemoveNotificationWithContext using the background context.
+func (s *NotificationSchemeService) RemoveNotification(schemeID, notificationID int64) (*Response, error) {
+	return s.RemoveNotificationWithContext(context.Background(), schemeID, notificationID)
+}
@@ -0,0 +1,91 @@
+package jira
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestNotificationSchemeService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/notificationscheme/10100"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint+"?expand=all")
+		fmt.Fprint(w, `{"expand":"notificationSchemeEvents,user,group,projectRole,field,all","id":10100,"self":"https://example.atlassian.net/rest/api/2/notificationscheme/10100","name":"notification scheme name","notificationSchemeEvents":[{"event":{"id":1,"name":"Issue created","description":"Event published when an issue is created"},"notifications":[{"id":1,"notificationType":"Group","parameter":"jira-administrators"},{"id":2,"notificationType":"CurrentAssignee"}]}]}`)
+	})
+
+	scheme, _, err := testClient.NotificationScheme.Get(10100)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(scheme.NotificationSchemeEvents) != 1 || len(scheme.NotificationSchemeEvents[0].Notifications) != 2 {
+		t.Fatalf("Unexpected scheme %+v", scheme)
+	}
+	if n := scheme.NotificationSchemeEvents[0].Notifications[0]; n.NotificationType != NotificationTypeGroup || n.Parameter != "jira-administrators" {
+		t.Errorf("Unexpected notification %+v", n)
+	}
+}
+
+func TestNotificationSchemeService_GetList(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/notificationscheme"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint+"?maxResults=1")
+		fmt.Fprint(w, `{"maxResults":1,"startAt":0,"total":2,"isLast":false,"values":[{"id":10000,"name":"Default Notification Scheme"}]}`)
+	})
+
+	schemes, _, err := testClient.NotificationScheme.GetList(&NotificationSchemeListOptions{MaxResults: 1})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(schemes.Values) != 1 || schemes.Total != 2 {
+		t.Errorf("Unexpected schemes %+v", schemes)
+	}
+}
+
+func TestNotificationSchemeService_AddNotifications(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/notificationscheme/10100/notification"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, testAPIEndpoint)
+		body, _ := ioutil.ReadAll(r.Body)
+		expected := `{"notificationSchemeEvents":[{"event":{"id":"1"},"notifications":[{"notificationType":"Group","parameter":"jira-developers"},{"notificationType":"AllWatchers"}]}]}` + "\n"
+		if string(body) != expected {
+			t.Errorf("Unexpected body %s", body)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.NotificationScheme.AddNotifications(10100, []NotificationSchemeEvent{{
+		Event: NotificationEvent{ID: 1},
+		Notifications: []EventNotification{
+			{NotificationType: NotificationTypeGroup, Parameter: "jira-developers"},
+			{NotificationType: NotificationTypeAllWatchers},
+		},
+	}})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestNotificationSchemeService_RemoveNotification(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/notificationscheme/10100/notification/2"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, testAPIEndpoint)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.NotificationScheme.RemoveNotification(10100, 2); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
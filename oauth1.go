@@ -0,0 +1,336 @@
+package jira
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// OAuth1AuthTransport is an http.RoundTripper that authenticates all requests
+// using OAuth 1.0a with RSA-SHA1 signatures, as used by the application links of JIRA Server / Data Center.
+//
+// The access token is acquired once with an OAuth1Config, see OAuth1Config.RequestToken.
+//
+// JIRA docs: https://developer.atlassian.com/server/jira/platform/oauth/
+type OAuth1AuthTransport struct {
+	// ConsumerKey is the consumer key of the application link
+	ConsumerKey string
+	// PrivateKey is the private key whose public key is configured in the application link
+	PrivateKey *rsa.PrivateKey
+	// Token is the access token
+	Token string
+
+	// Transport is the underlying HTTP transport to use when making requests.
+	// It will default to http.DefaultTransport if nil.
+	Transport http.RoundTripper
+
+	// now and nonce can be replaced in tests
+	now   func() time.Time
+	nonce func() string
+}
+
+// Client returns an *http.Client that makes requests that are authenticated
+// using OAuth 1.0a.
+func (t *OAuth1AuthTransport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+func (t *OAuth1AuthTransport) transport() http.RoundTripper {
+	if t.Transport != nil {
+		return t.Transport
+	}
+	return http.DefaultTransport
+}
+
+// RoundTrip signs the request and adds the OAuth Authorization header.
+func (t *OAuth1AuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req2 := cloneRequest(req) // per RoundTripper contract
+
+	params := map[string]string{}
+	if t.Token != "" {
+		params["oauth_token"] = t.Token
+	}
+	header, err := t.authorizationHeader(req2, params)
+	if err != nil {
+		return nil, err
+	}
+
+	req2.Header.Set("Authorization", header)
+	return t.transport().RoundTrip(req2)
+}
+
+// authorizationHeader signs req with the OAuth parameters params, the common parameters are added
+func (t *OAuth1AuthTransport) authorizationHeader(req *http.Request, params map[string]string) (string, error) {
+	if t.PrivateKey == nil {
+		return "", fmt.Errorf("oauth1: no private key has been set")
+	}
+
+	now := time.Now
+	if t.now != nil {
+		now = t.now
+	}
+	nonce := oauth1Nonce
+	if t.nonce != nil {
+		nonce = t.nonce
+	}
+
+	params["oauth_consumer_key"] = t.ConsumerKey
+	params["oauth_nonce"] = nonce()
+	params["oauth_signature_method"] = "RSA-SHA1"
+	params["oauth_timestamp"] = strconv.FormatInt(now().Unix(), 10)
+	params["oauth_version"] = "1.0"
+
+	base, err := oauth1SignatureBase(req, params)
+	if err != nil {
+		return "", err
+	}
+	h := sha1.Sum([]byte(base))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, t.PrivateKey, crypto.SHA1, h[:])
+	if err != nil {
+		return "", errors.Wrap(err, "oauth1: error signing request")
+	}
+	params["oauth_signature"] = base64.StdEncoding.EncodeToString(signature)
+
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	parts := make([]string, len(keys))
+	for i, k := range keys {
+		parts[i] = fmt.Sprintf(`%s="%s"`, oauth1Escape(k), oauth1Escape(params[k]))
+	}
+	return "OAuth " + strings.Join(parts, ", "), nil
+}
+
+// oauth1SignatureBase returns the signature base string of the request.
+// The query parameters and form encoded body parameters are signed together with the OAuth parameters.
+//
+// Spec: https://tools.ietf.org/html/rfc5849#section-3.4.1
+func oauth1SignatureBase(req *http.Request, oauthParams map[string]string) (string, error) {
+	var pairs []string
+	add := func(values url.Values) {
+		for k, vs := range values {
+			for _, v := range vs {
+				pairs = append(pairs, oauth1Escape(k)+"="+oauth1Escape(v))
+			}
+		}
+	}
+	add(req.URL.Query())
+	for k, v := range oauthParams {
+		pairs = append(pairs, oauth1Escape(k)+"="+oauth1Escape(v))
+	}
+
+	if req.Body != nil && strings.HasPrefix(req.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		body, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return "", err
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+		form, err := url.ParseQuery(string(body))
+		if err != nil {
+			return "", err
+		}
+		add(form)
+	}
+	sort.Strings(pairs)
+
+	u := *req.URL
+	u.RawQuery = ""
+	u.Fragment = ""
+	u.Scheme = strings.ToLower(u.Scheme)
+	u.Host = strings.ToLower(u.Host)
+	if (u.Scheme == "http" && strings.HasSuffix(u.Host, ":80")) || (u.Scheme == "https" && strings.HasSuffix(u.Host, ":443")) {
+		u.Host = u.Host[:strings.LastIndex(u.Host, ":")]
+	}
+
+	return strings.Join([]string{
+		strings.ToUpper(req.Method),
+		oauth1Escape(u.String()),
+		oauth1Escape(strings.Join(pairs, "&")),
+	}, "&"), nil
+}
+
+// oauth1Escape percent encodes s as required by OAuth, only the unreserved characters are kept.
+//
+// Spec: https://tools.ietf.org/html/rfc5849#section-3.6
+func oauth1Escape(s string) string {
+	var buf bytes.Buffer
+	for _, b := range []byte(s) {
+		switch {
+		case 'A' <= b && b <= 'Z', 'a' <= b && b <= 'z', '0' <= b && b <= '9', b == '-', b == '.', b == '_', b == '~':
+			buf.WriteByte(b)
+		default:
+			fmt.Fprintf(&buf, "%%%02X", b)
+		}
+	}
+	return buf.String()
+}
+
+func oauth1Nonce() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return strconv.FormatInt(time.Now().UnixNano(), 10)
+	}
+	return hex.EncodeToString(b)
+}
+
+// OAuth1Config contains the settings to acquire an OAuth 1.0a access token from JIRA.
+//
+// The "dance" consists of three steps:
+//  1. RequestToken gets a temporary request token.
+//  2. The user opens AuthorizationURL in the browser and allows the access.
+//     JIRA redirects to the CallbackURL or shows the verification code if there is no callback.
+//  3. AccessToken exchanges the request token and the verification code for the access token.
+//
+// The access token is used with Transport for all further requests.
+type OAuth1Config struct {
+	// BaseURL is the URL of the JIRA instance, e.g. "https://jira.example.com/"
+	BaseURL     string
+	ConsumerKey string
+	PrivateKey  *rsa.PrivateKey
+	// CallbackURL is the URL JIRA redirects to after the authorization.
+	// It defaults to "oob" (out of band), JIRA shows the verification code to the user instead.
+	CallbackURL string
+
+	// HTTPClient is used for the token requests, http.DefaultClient if nil
+	HTTPClient *http.Client
+}
+
+// RequestToken gets a new request token and its secret.
+func (c *OAuth1Config) RequestToken() (token, secret string, err error) {
+	callback := c.CallbackURL
+	if callback == "" {
+		callback = "oob"
+	}
+	values, err := c.tokenRequest("plugins/servlet/oauth/request-token", map[string]string{"oauth_callback": callback})
+	if err != nil {
+		return "", "", err
+	}
+	return values.Get("oauth_token"), values.Get("oauth_token_secret"), nil
+}
+
+// AuthorizationURL returns the URL the user has to open to authorize the request token.
+func (c *OAuth1Config) AuthorizationURL(requestToken string) (string, error) {
+	u, err := c.endpoint("plugins/servlet/oauth/authorize")
+	if err != nil {
+		return "", err
+	}
+	u.RawQuery = url.Values{"oauth_token": {requestToken}}.Encode()
+	return u.String(), nil
+}
+
+// AccessToken exchanges the authorized request token and the verification code for an access token.
+func (c *OAuth1Config) AccessToken(requestToken, verifier string) (string, error) {
+	values, err := c.tokenRequest("plugins/servlet/oauth/access-token", map[string]string{
+		"oauth_token":    requestToken,
+		"oauth_verifier": verifier,
+	})
+	if err != nil {
+		return "", err
+	}
+	return values.Get("oauth_token"), nil
+}
+
+// Transport returns an OAuth1AuthTransport using the given access token.
+func (c *OAuth1Config) Transport(accessToken string) *OAuth1AuthTransport {
+	return &OAuth1AuthTransport{
+		ConsumerKey: c.ConsumerKey,
+		PrivateKey:  c.PrivateKey,
+		Token:       accessToken,
+	}
+}
+
+func (c *OAuth1Config) endpoint(path string) (*url.URL, error) {
+	base, err := url.Parse(c.BaseURL)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasSuffix(base.Path, "/") {
+		base.Path += "/"
+	}
+	return base.ResolveReference(&url.URL{Path: path}), nil
+}
+
+// tokenRequest makes a signed POST request to a token endpoint and parses the form encoded response
+func (c *OAuth1Config) tokenRequest(path string, params map[string]string) (url.Values, error) {
+	u, err := c.endpoint(path)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	t := c.Transport("")
+	header, err := t.authorizationHeader(req, params)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", header)
+
+	client := c.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("oauth1: token request failed with status %d: %s", resp.StatusCode, body)
+	}
+	values, err := url.ParseQuery(string(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "oauth1: could not parse the token response")
+	}
+	if values.Get("oauth_token") == "" {
+		return nil, fmt.Errorf("oauth1: no token in the response: %s", body)
+	}
+	return values, nil
+}
+
+// ParseRSAPrivateKey parses a PEM encoded RSA private key in PKCS #1 or PKCS #8 format,
+// e.g. generated by "openssl genrsa -out jira_privatekey.pem 2048".
+func ParseRSAPrivateKey(data []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("oauth1: no PEM encoded key found")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "oauth1: could not parse the private key")
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("oauth1: the private key is no RSA key")
+	}
+	return rsaKey, nil
+}
@@ -0,0 +1,205 @@
+package jira
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testOAuth1Key(t *testing.T) *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("Error generating key: %s", err)
+	}
+	return key
+}
+
+// parseOAuth1Header parses the parameters of an OAuth Authorization header
+func parseOAuth1Header(t *testing.T, header string) map[string]string {
+	if !strings.HasPrefix(header, "OAuth ") {
+		t.Fatalf("Expected an OAuth header, got %q", header)
+	}
+	params := map[string]string{}
+	for _, part := range strings.Split(strings.TrimPrefix(header, "OAuth "), ", ") {
+		kv := strings.SplitN(part, "=", 2)
+		v, err := url.PathUnescape(strings.Trim(kv[1], `"`))
+		if err != nil {
+			t.Fatalf("Error unescaping %q: %s", kv[1], err)
+		}
+		params[kv[0]] = v
+	}
+	return params
+}
+
+// verifyOAuth1Request checks the signature of r, as received by the test server
+func verifyOAuth1Request(t *testing.T, r *http.Request, key *rsa.PublicKey) map[string]string {
+	params := parseOAuth1Header(t, r.Header.Get("Authorization"))
+	signature, _ := base64.StdEncoding.DecodeString(params["oauth_signature"])
+	delete(params, "oauth_signature")
+
+	r2 := cloneRequest(r)
+	u, _ := url.Parse(testServer.URL + r.URL.RequestURI())
+	r2.URL = u
+	base, err := oauth1SignatureBase(r2, params)
+	if err != nil {
+		t.Fatalf("Error building the signature base: %s", err)
+	}
+	h := sha1.Sum([]byte(base))
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA1, h[:], signature); err != nil {
+		t.Errorf("Invalid signature: %s", err)
+	}
+	return params
+}
+
+func TestOAuth1Escape(t *testing.T) {
+	if got := oauth1Escape("Ladies + Gentlemen"); got != "Ladies%20%2B%20Gentlemen" {
+		t.Errorf("Unexpected escaping %q", got)
+	}
+	if got := oauth1Escape("An encoded string!~-._"); got != "An%20encoded%20string%21~-._" {
+		t.Errorf("Unexpected escaping %q", got)
+	}
+}
+
+func TestOAuth1SignatureBase(t *testing.T) {
+	req, _ := http.NewRequest("GET", "https://Jira.Example.com:443/rest/api/2/issue/TEST-1?b=2&a=x%20y", nil)
+	base, err := oauth1SignatureBase(req, map[string]string{"oauth_token": "tok"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	want := "GET&https%3A%2F%2Fjira.example.com%2Frest%2Fapi%2F2%2Fissue%2FTEST-1&a%3Dx%2520y%26b%3D2%26oauth_token%3Dtok"
+	if base != want {
+		t.Errorf("Signature base = %q, want %q", base, want)
+	}
+}
+
+func TestOAuth1AuthTransport(t *testing.T) {
+	setup()
+	defer teardown()
+
+	key := testOAuth1Key(t)
+	testMux.HandleFunc("/rest/api/2/issue/TEST-1", func(w http.ResponseWriter, r *http.Request) {
+		params := verifyOAuth1Request(t, r, &key.PublicKey)
+		if params["oauth_token"] != "access-token" || params["oauth_consumer_key"] != "go-jira" {
+			t.Errorf("Unexpected OAuth parameters %v", params)
+		}
+		if params["oauth_signature_method"] != "RSA-SHA1" || params["oauth_nonce"] != "nonce" || params["oauth_timestamp"] != "1500000000" {
+			t.Errorf("Unexpected OAuth parameters %v", params)
+		}
+		fmt.Fprint(w, `{"key":"TEST-1"}`)
+	})
+
+	tp := &OAuth1AuthTransport{
+		ConsumerKey: "go-jira",
+		PrivateKey:  key,
+		Token:       "access-token",
+		now:         func() time.Time { return time.Unix(1500000000, 0) },
+		nonce:       func() string { return "nonce" },
+	}
+	client, _ := NewClient(tp.Client(), testServer.URL)
+	issue, _, err := client.Issue.Get("TEST-1", &GetQueryOptions{Fields: "summary,status"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if issue.Key != "TEST-1" {
+		t.Errorf("Unexpected issue %+v", issue)
+	}
+}
+
+func TestOAuth1Config_Dance(t *testing.T) {
+	setup()
+	defer teardown()
+
+	key := testOAuth1Key(t)
+	testMux.HandleFunc("/plugins/servlet/oauth/request-token", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		params := verifyOAuth1Request(t, r, &key.PublicKey)
+		if params["oauth_callback"] != "oob" {
+			t.Errorf("Expected the oob callback, got %q", params["oauth_callback"])
+		}
+		fmt.Fprint(w, "oauth_token=request-token&oauth_token_secret=secret")
+	})
+	testMux.HandleFunc("/plugins/servlet/oauth/access-token", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		params := verifyOAuth1Request(t, r, &key.PublicKey)
+		if params["oauth_token"] != "request-token" || params["oauth_verifier"] != "verifier" {
+			t.Errorf("Unexpected OAuth parameters %v", params)
+		}
+		fmt.Fprint(w, "oauth_token=access-token&oauth_token_secret=secret")
+	})
+
+	config := &OAuth1Config{BaseURL: testServer.URL, ConsumerKey: "go-jira", PrivateKey: key}
+	requestToken, secret, err := config.RequestToken()
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if requestToken != "request-token" || secret != "secret" {
+		t.Errorf("Unexpected request token %q, %q", requestToken, secret)
+	}
+
+	authURL, err := config.AuthorizationURL(requestToken)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if want := testServer.URL + "/plugins/servlet/oauth/authorize?oauth_token=request-token"; authURL != want {
+		t.Errorf("AuthorizationURL = %q, want %q", authURL, want)
+	}
+
+	accessToken, err := config.AccessToken(requestToken, "verifier")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if accessToken != "access-token" {
+		t.Errorf("Unexpected access token %q", accessToken)
+	}
+	if tp := config.Transport(accessToken); tp.Token != "access-token" || tp.PrivateKey != key {
+		t.Errorf("Unexpected transport %+v", tp)
+	}
+}
+
+func TestOAuth1Config_RequestToken_Failure(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/plugins/servlet/oauth/request-token", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, "oauth_problem=consumer_key_unknown")
+	})
+
+	config := &OAuth1Config{BaseURL: testServer.URL, ConsumerKey: "unknown", PrivateKey: testOAuth1Key(t)}
+	if _, _, err := config.RequestToken(); err == nil {
+		t.Error("Expected an error")
+	}
+}
+
+func TestParseRSAPrivateKey(t *testing.T) {
+	key := testOAuth1Key(t)
+
+	pkcs1 := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	parsed, err := ParseRSAPrivateKey(pkcs1)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if parsed.N.Cmp(key.N) != 0 {
+		t.Error("Expected the parsed key to equal the original key")
+	}
+
+	pkcs8Bytes, _ := x509.MarshalPKCS8PrivateKey(key)
+	pkcs8 := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8Bytes})
+	if _, err := ParseRSAPrivateKey(pkcs8); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+
+	if _, err := ParseRSAPrivateKey([]byte("no key")); err == nil {
+		t.Error("Expected an error")
+	}
+}
@@ -0,0 +1,339 @@
+package jira
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Default endpoints of the Atlassian Cloud OAuth 2.0 (3LO) authorization server and API gateway
+const (
+	DefaultOAuth2AuthURL  = "https://auth.atlassian.com/authorize"
+	DefaultOAuth2TokenURL = "https://auth.atlassian.com/oauth/token"
+	DefaultOAuth2APIURL   = "https://api.atlassian.com/"
+)
+
+// oauth2ExpiryDelta is subtracted from the expiry of an access token, so it is refreshed before it expires in flight
+const oauth2ExpiryDelta = 30 * time.Second
+
+// OAuth2Token is an access token of the Atlassian authorization server.
+// RefreshToken is only set if the "offline_access" scope was requested.
+type OAuth2Token struct {
+	AccessToken  string    `json:"access_token"`
+	RefreshToken string    `json:"refresh_token,omitempty"`
+	TokenType    string    `json:"token_type,omitempty"`
+	Scope        string    `json:"scope,omitempty"`
+	Expiry       time.Time `json:"expiry,omitempty"`
+}
+
+// Valid reports if the token has an access token which is not (about to be) expired.
+func (t *OAuth2Token) Valid() bool {
+	if t == nil || t.AccessToken == "" {
+		return false
+	}
+	return t.Expiry.IsZero() || time.Now().Add(oauth2ExpiryDelta).Before(t.Expiry)
+}
+
+// OAuth2Resource is a site the access token grants access to
+type OAuth2Resource struct {
+	ID        string   `json:"id"`
+	URL       string   `json:"url"`
+	Name      string   `json:"name"`
+	Scopes    []string `json:"scopes"`
+	AvatarURL string   `json:"avatarUrl,omitempty"`
+}
+
+// OAuth2Config contains the settings of an OAuth 2.0 (3LO) app of the Atlassian developer console.
+//
+// The flow consists of three steps:
+//  1. The user opens AuthCodeURL in the browser and allows the access.
+//     Atlassian redirects to the RedirectURL with the authorization code.
+//  2. Exchange exchanges the authorization code for a token.
+//  3. CloudID looks up the cloud ID of the JIRA site.
+//
+// The token and cloud ID are used with Transport for all further requests.
+//
+// Atlassian docs: https://developer.atlassian.com/cloud/jira/platform/oauth-2-3lo-apps/
+type OAuth2Config struct {
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	// Scopes are e.g. "read:jira-work", "write:jira-work" and "offline_access" to get a refresh token
+	Scopes []string
+
+	// AuthURL, TokenURL and APIURL default to DefaultOAuth2AuthURL, DefaultOAuth2TokenURL and DefaultOAuth2APIURL
+	AuthURL  string
+	TokenURL string
+	APIURL   string
+
+	// HTTPClient is used for the token requests, http.DefaultClient if nil
+	HTTPClient *http.Client
+}
+
+// AuthCodeURL returns the URL the user has to open to authorize the app.
+// state is passed back to the RedirectURL and should be checked to prevent CSRF attacks.
+func (c *OAuth2Config) AuthCodeURL(state string) string {
+	authURL := c.AuthURL
+	if authURL == "" {
+		authURL = DefaultOAuth2AuthURL
+	}
+	v := url.Values{
+		"audience":      {"api.atlassian.com"},
+		"client_id":     {c.ClientID},
+		"scope":         {strings.Join(c.Scopes, " ")},
+		"redirect_uri":  {c.RedirectURL},
+		"state":         {state},
+		"response_type": {"code"},
+		"prompt":        {"consent"},
+	}
+	return authURL + "?" + v.Encode()
+}
+
+// Exchange exchanges the authorization code for a token.
+func (c *OAuth2Config) Exchange(code string) (*OAuth2Token, error) {
+	return c.tokenRequest(map[string]string{
+		"grant_type":    "authorization_code",
+		"client_id":     c.ClientID,
+		"client_secret": c.ClientSecret,
+		"code":          code,
+		"redirect_uri":  c.RedirectURL,
+	})
+}
+
+// Refresh gets a new access token with the refresh token of token.
+// Atlassian rotates refresh tokens, the returned token contains the new refresh token.
+func (c *OAuth2Config) Refresh(token *OAuth2Token) (*OAuth2Token, error) {
+	if token == nil || token.RefreshToken == "" {
+		return nil, fmt.Errorf("oauth2: no refresh token, request the offline_access scope")
+	}
+	newToken, err := c.tokenRequest(map[string]string{
+		"grant_type":    "refresh_token",
+		"client_id":     c.ClientID,
+		"client_secret": c.ClientSecret,
+		"refresh_token": token.RefreshToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if newToken.RefreshToken == "" {
+		newToken.RefreshToken = token.RefreshToken
+	}
+	return newToken, nil
+}
+
+// AccessibleResources returns the sites the token grants access to.
+//
+// Atlassian docs: https://developer.atlassian.com/cloud/jira/platform/oauth-2-3lo-apps/#3-1-get-the-cloudid-for-your-site
+func (c *OAuth2Config) AccessibleResources(token *OAuth2Token) ([]OAuth2Resource, error) {
+	req, err := http.NewRequest("GET", c.apiURL()+"oauth/token/accessible-resources", nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token.AccessToken)
+	req.Header.Set("Accept", "application/json")
+
+	body, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+	resources := []OAuth2Resource{}
+	if err := json.Unmarshal(body, &resources); err != nil {
+		return nil, errors.Wrap(err, "oauth2: could not parse the accessible resources")
+	}
+	return resources, nil
+}
+
+// CloudID returns the cloud ID of the site with the given URL, e.g. "https://your-domain.atlassian.net".
+// If siteURL is empty and the token grants access to exactly one site, its cloud ID is returned.
+func (c *OAuth2Config) CloudID(token *OAuth2Token, siteURL string) (string, error) {
+	resources, err := c.AccessibleResources(token)
+	if err != nil {
+		return "", err
+	}
+	if siteURL == "" {
+		if len(resources) != 1 {
+			return "", fmt.Errorf("oauth2: the token grants access to %d sites, the site URL is required", len(resources))
+		}
+		return resources[0].ID, nil
+	}
+	for _, r := range resources {
+		if strings.TrimSuffix(r.URL, "/") == strings.TrimSuffix(siteURL, "/") {
+			return r.ID, nil
+		}
+	}
+	return "", fmt.Errorf("oauth2: the token grants no access to %s", siteURL)
+}
+
+// BaseURL returns the base URL of the JIRA API of the site with the given cloud ID, to be used with NewClient.
+func (c *OAuth2Config) BaseURL(cloudID string) string {
+	return fmt.Sprintf("%sex/jira/%s/", c.apiURL(), cloudID)
+}
+
+// Transport returns an OAuth2Transport for the given token, which rewrites all requests to the site with the given cloud ID.
+// cloudID may be empty if the client is created with BaseURL.
+func (c *OAuth2Config) Transport(token *OAuth2Token, cloudID string) *OAuth2Transport {
+	return &OAuth2Transport{Config: c, Token: token, CloudID: cloudID}
+}
+
+func (c *OAuth2Config) apiURL() string {
+	if c.APIURL == "" {
+		return DefaultOAuth2APIURL
+	}
+	if !strings.HasSuffix(c.APIURL, "/") {
+		return c.APIURL + "/"
+	}
+	return c.APIURL
+}
+
+// tokenRequest requests a token from the token endpoint with the given parameters
+func (c *OAuth2Config) tokenRequest(params map[string]string) (*OAuth2Token, error) {
+	tokenURL := c.TokenURL
+	if tokenURL == "" {
+		tokenURL = DefaultOAuth2TokenURL
+	}
+	payload, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("POST", tokenURL, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	body, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+	var result struct {
+		OAuth2Token
+		ExpiresIn int64 `json:"expires_in"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, errors.Wrap(err, "oauth2: could not parse the token response")
+	}
+	if result.AccessToken == "" {
+		return nil, fmt.Errorf("oauth2: no access token in the response: %s", body)
+	}
+	token := result.OAuth2Token
+	if result.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(result.ExpiresIn) * time.Second)
+	}
+	return &token, nil
+}
+
+func (c *OAuth2Config) do(req *http.Request) ([]byte, error) {
+	client := c.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("oauth2: request to %s failed with status %d: %s", req.URL, resp.StatusCode, body)
+	}
+	return body, nil
+}
+
+// OAuth2Transport is an http.RoundTripper that authenticates all requests
+// with an OAuth 2.0 (3LO) access token of Atlassian Cloud.
+// The access token is refreshed automatically before it expires, if the token has a refresh token.
+//
+// If CloudID is set, the requests are rewritten to the API gateway of the site,
+// so the Client can be created with the URL of the site, e.g. "https://your-domain.atlassian.net".
+type OAuth2Transport struct {
+	Config  *OAuth2Config
+	Token   *OAuth2Token
+	CloudID string
+
+	// OnTokenRefresh is called with the new token after each refresh, e.g. to persist it.
+	// The refresh token is rotated, so the new token has to be stored to be able to refresh again later.
+	OnTokenRefresh func(*OAuth2Token)
+
+	// Transport is the underlying HTTP transport to use when making requests.
+	// It will default to http.DefaultTransport if nil.
+	Transport http.RoundTripper
+
+	mu sync.Mutex
+}
+
+// Client returns an *http.Client that makes requests that are authenticated
+// using the OAuth 2.0 access token.
+func (t *OAuth2Transport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+func (t *OAuth2Transport) transport() http.RoundTripper {
+	if t.Transport != nil {
+		return t.Transport
+	}
+	return http.DefaultTransport
+}
+
+// token returns a valid access token, refreshing it if needed
+func (t *OAuth2Transport) token() (*OAuth2Token, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.Token.Valid() {
+		return t.Token, nil
+	}
+	if t.Token == nil || t.Token.RefreshToken == "" || t.Config == nil {
+		return nil, fmt.Errorf("oauth2: the access token is expired and can not be refreshed")
+	}
+	token, err := t.Config.Refresh(t.Token)
+	if err != nil {
+		return nil, errors.Wrap(err, "oauth2: could not refresh the access token")
+	}
+	t.Token = token
+	if t.OnTokenRefresh != nil {
+		t.OnTokenRefresh(token)
+	}
+	return token, nil
+}
+
+// RoundTrip adds the access token to the request and rewrites it to the API gateway if CloudID is set.
+func (t *OAuth2Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.token()
+	if err != nil {
+		return nil, err
+	}
+
+	req2 := cloneRequest(req) // per RoundTripper contract
+	if t.CloudID != "" {
+		config := t.Config
+		if config == nil {
+			config = &OAuth2Config{}
+		}
+		base, err := url.Parse(config.BaseURL(t.CloudID))
+		if err != nil {
+			return nil, err
+		}
+		u := *req.URL
+		u.Scheme = base.Scheme
+		u.Host = base.Host
+		u.Path = strings.TrimSuffix(base.Path, "/") + "/" + strings.TrimPrefix(req.URL.Path, "/")
+		u.RawPath = ""
+		req2.URL = &u
+		req2.Host = ""
+	}
+
+	req2.Header.Set("Authorization", "Bearer "+token.AccessToken)
+	return t.transport().RoundTrip(req2)
+}
@@ -0,0 +1,154 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testOAuth2Config() *OAuth2Config {
+	return &OAuth2Config{
+		ClientID:     "client",
+		ClientSecret: "secret",
+		RedirectURL:  "https://app.example.com/callback",
+		Scopes:       []string{"read:jira-work", "offline_access"},
+		AuthURL:      testServer.URL + "/authorize",
+		TokenURL:     testServer.URL + "/oauth/token",
+		APIURL:       testServer.URL,
+	}
+}
+
+func TestOAuth2Config_AuthCodeURL(t *testing.T) {
+	setup()
+	defer teardown()
+
+	u, err := url.Parse(testOAuth2Config().AuthCodeURL("xyz"))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	q := u.Query()
+	if q.Get("audience") != "api.atlassian.com" || q.Get("client_id") != "client" || q.Get("state") != "xyz" || q.Get("response_type") != "code" {
+		t.Errorf("Unexpected query %v", q)
+	}
+	if q.Get("scope") != "read:jira-work offline_access" {
+		t.Errorf("Unexpected scope %q", q.Get("scope"))
+	}
+}
+
+func TestOAuth2Config_Exchange(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/oauth/token", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		var params map[string]string
+		json.NewDecoder(r.Body).Decode(&params)
+		if params["grant_type"] != "authorization_code" || params["code"] != "code" || params["client_secret"] != "secret" {
+			t.Errorf("Unexpected parameters %v", params)
+		}
+		fmt.Fprint(w, `{"access_token":"access","refresh_token":"refresh","expires_in":3600,"scope":"read:jira-work offline_access","token_type":"Bearer"}`)
+	})
+
+	token, err := testOAuth2Config().Exchange("code")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if token.AccessToken != "access" || token.RefreshToken != "refresh" || !token.Valid() {
+		t.Errorf("Unexpected token %+v", token)
+	}
+	if d := time.Until(token.Expiry); d < 59*time.Minute || d > time.Hour {
+		t.Errorf("Unexpected expiry %s", token.Expiry)
+	}
+}
+
+func TestOAuth2Config_CloudID(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/oauth/token/accessible-resources", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if r.Header.Get("Authorization") != "Bearer access" {
+			t.Errorf("Unexpected Authorization header %q", r.Header.Get("Authorization"))
+		}
+		fmt.Fprint(w, `[{"id":"1324a887-45db-1bf4-1e99-ef0ff456d421","url":"https://your-domain.atlassian.net","name":"your-domain","scopes":["read:jira-work"]},
+			{"id":"2","url":"https://other.atlassian.net","name":"other","scopes":["read:jira-work"]}]`)
+	})
+
+	config := testOAuth2Config()
+	token := &OAuth2Token{AccessToken: "access"}
+	cloudID, err := config.CloudID(token, "https://your-domain.atlassian.net/")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if cloudID != "1324a887-45db-1bf4-1e99-ef0ff456d421" {
+		t.Errorf("Unexpected cloud ID %q", cloudID)
+	}
+	if _, err := config.CloudID(token, ""); err == nil {
+		t.Error("Expected an error for multiple sites without a site URL")
+	}
+	if _, err := config.CloudID(token, "https://unknown.atlassian.net"); err == nil {
+		t.Error("Expected an error for an unknown site")
+	}
+	if want := testServer.URL + "/ex/jira/" + cloudID + "/"; config.BaseURL(cloudID) != want {
+		t.Errorf("BaseURL = %q, want %q", config.BaseURL(cloudID), want)
+	}
+}
+
+func TestOAuth2Transport_RefreshAndRewrite(t *testing.T) {
+	setup()
+	defer teardown()
+
+	refreshes := 0
+	testMux.HandleFunc("/oauth/token", func(w http.ResponseWriter, r *http.Request) {
+		refreshes++
+		var params map[string]string
+		json.NewDecoder(r.Body).Decode(&params)
+		if params["grant_type"] != "refresh_token" || params["refresh_token"] != "old-refresh" {
+			t.Errorf("Unexpected parameters %v", params)
+		}
+		fmt.Fprint(w, `{"access_token":"new-access","refresh_token":"new-refresh","expires_in":3600}`)
+	})
+	testMux.HandleFunc("/ex/jira/cloud-id/rest/api/2/issue/TEST-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if r.Header.Get("Authorization") != "Bearer new-access" {
+			t.Errorf("Unexpected Authorization header %q", r.Header.Get("Authorization"))
+		}
+		if r.URL.Query().Get("fields") != "summary" {
+			t.Errorf("Expected the query to be kept, got %q", r.URL.RawQuery)
+		}
+		fmt.Fprint(w, `{"key":"TEST-1"}`)
+	})
+
+	var stored *OAuth2Token
+	expired := &OAuth2Token{AccessToken: "old-access", RefreshToken: "old-refresh", Expiry: time.Now().Add(-time.Minute)}
+	tp := testOAuth2Config().Transport(expired, "cloud-id")
+	tp.OnTokenRefresh = func(token *OAuth2Token) { stored = token }
+
+	client, _ := NewClient(tp.Client(), "https://your-domain.atlassian.net")
+	for i := 0; i < 2; i++ {
+		issue, _, err := client.Issue.Get("TEST-1", &GetQueryOptions{Fields: "summary"})
+		if err != nil {
+			t.Fatalf("Error given: %s", err)
+		}
+		if issue.Key != "TEST-1" {
+			t.Errorf("Unexpected issue %+v", issue)
+		}
+	}
+	if refreshes != 1 {
+		t.Errorf("Expected 1 refresh, got %d", refreshes)
+	}
+	if stored == nil || stored.RefreshToken != "new-refresh" {
+		t.Errorf("Expected the new token to be passed to OnTokenRefresh, got %+v", stored)
+	}
+}
+
+func TestOAuth2Transport_ExpiredWithoutRefreshToken(t *testing.T) {
+	tp := &OAuth2Transport{Token: &OAuth2Token{AccessToken: "access", Expiry: time.Now().Add(-time.Minute)}}
+	client, _ := NewClient(tp.Client(), "https://your-domain.atlassian.net")
+	_, _, err := client.Issue.Get("TEST-1", nil)
+	if err == nil || !strings.Contains(err.Error(), "can not be refreshed") {
+		t.Errorf("Expected a refresh error, got %v", err)
+	}
+}
@@ -0,0 +1,157 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+)
+
+// Organization represents a customer organization of JIRA Service Management
+type Organization struct {
+	ID    string            `json:"id" structs:"id"`
+	Name  string            `json:"name" structs:"name"`
+	Links map[string]string `json:"_links,omitempty" structs:"_links,omitempty"`
+}
+
+// GetOrganizationsWithContext returns a page of the customer organizations.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-organization/#api-rest-servicedeskapi-organization-get
+func (s *ServiceDeskService) GetOrganizationsWithContext(ctx context.Context, options *ServiceDeskPageOptions) ([]Organization, *Response, error) {
+	apiEndpoint, err := addOptions("rest/servicedeskapi/organization", options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(struct {
+		pageValues
+		Values []Organization `json:"values"`
+	})
+	resp, err := s.client.Do(req, page)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return page.Values, resp, nil
+}
+
+// GetOrganizations wraps GetOrganizationsWithContext using the background context.
+func (s *ServiceDeskService) GetOrganizations(options *ServiceDeskPageOptions) ([]Organization, *Response, error) {
+	return s.GetOrganizationsWithContext(context.Background(), options)
+}
+
+// GetOrganizationWithContext returns the customer organization with the given ID.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-organization/#api-rest-servicedeskapi-organization-organizationid-get
+func (s *ServiceDeskService) GetOrganizationWithContext(ctx context.Context, organizationID string) (*Organization, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/servicedeskapi/organization/%s", organizationID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	organization := new(Organization)
+	resp, err := s.client.Do(req, organization)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return organization, resp, nil
+}
+
+// GetOrganization wraps GetOrganizationWithContext using the background context.
+func (s *ServiceDeskService) GetOrganization(organizationID string) (*Organization, *Response, error) {
+	return s.GetOrganizationWithContext(context.Background(), organizationID)
+}
+
+// GetOrganizationPropertyKeysWithContext returns the keys of all properties of the customer organization.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-organization/#api-rest-servicedeskapi-organization-organizationid-property-get
+func (s *ServiceDeskService) GetOrganizationPropertyKeysWithContext(ctx context.Context, organizationID string) (*EntityPropertyKeys, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/servicedeskapi/organization/%s/property", organizationID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keys := new(EntityPropertyKeys)
+	resp, err := s.client.Do(req, keys)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return keys, resp, nil
+}
+
+// GetOrganizationPropertyKeys wraps GetOrganizationPropertyKeysWithContext using the background context.
+func (s *ServiceDeskService) GetOrganizationPropertyKeys(organizationID string) (*EntityPropertyKeys, *Response, error) {
+	return s.GetOrganizationPropertyKeysWithContext(context.Background(), organizationID)
+}
+
+// GetOrganizationPropertyWithContext returns the property with the given key of the customer organization.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-organization/#api-rest-servicedeskapi-organization-organizationid-property-propertykey-get
+func (s *ServiceDeskService) GetOrganizationPropertyWithContext(ctx context.Context, organizationID, propertyKey string) (*EntityProperty, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/servicedeskapi/organization/%s/property/%s", organizationID, propertyKey)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	property := new(EntityProperty)
+	resp, err := s.client.Do(req, property)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return property, resp, nil
+}
+
+// GetOrganizationProperty wraps GetOrganizationPropertyWithContext using the background context.
+func (s *ServiceDeskService) GetOrganizationProperty(organizationID, propertyKey string) (*EntityProperty, *Response, error) {
+	return s.GetOrganizationPropertyWithContext(context.Background(), organizationID, propertyKey)
+}
+
+// SetOrganizationPropertyWithContext sets the value of the property with the given key of the customer organization.
+// value is marshalled to JSON, e.g. a struct or map holding the account ID and tier of the customer in the CRM.
+// The property is created if it does not exist yet.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-organization/#api-rest-servicedeskapi-organization-organizationid-property-propertykey-put
+func (s *ServiceDeskService) SetOrganizationPropertyWithContext(ctx context.Context, organizationID, propertyKey string, value interface{}) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/servicedeskapi/organization/%s/property/%s", organizationID, propertyKey)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, value)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// SetOrganizationProperty wraps SetOrganizationPropertyWithContext using the background context.
+func (s *ServiceDeskService) SetOrganizationProperty(organizationID, propertyKey string, value interface{}) (*Response, error) {
+	return s.SetOrganizationPropertyWithContext(context.Background(), organizationID, propertyKey, value)
+}
+
+// DeleteOrganizationPropertyWithContext deletes the property with the given key of the customer organization.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-organization/#api-rest-servicedeskapi-organization-organizationid-property-propertykey-delete
+func (s *ServiceDeskService) DeleteOrganizationPropertyWithContext(ctx context.Context, organizationID, propertyKey string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/servicedeskapi/organization/%s/property/%s", organizationID, propertyKey)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// DeleteOrganizationProperty wraps DeleteOrganizationPropertyWithContext using the background context.
+func (s *ServiceDeskService) DeleteOrganizationProperty(organizationID, propertyKey string) (*Response, error) {
+	return s.DeleteOrganizationPropertyWithContext(context.Background(), organizationID, propertyKey)
+}
@@ -0,0 +1,118 @@
+package jira
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestServiceDeskService_GetOrganizations(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/servicedeskapi/organization", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/servicedeskapi/organization?limit=1")
+		fmt.Fprint(w, `{"size":1,"start":0,"limit":1,"isLastPage":false,"values":[{"id":"1","name":"Charlie Cakes Franchises",
+			"_links":{"self":"https://your-domain.atlassian.net/rest/servicedeskapi/organization/1"}}]}`)
+	})
+
+	organizations, resp, err := testClient.ServiceDesk.GetOrganizations(&ServiceDeskPageOptions{Limit: 1})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(organizations) != 1 || organizations[0].Name != "Charlie Cakes Franchises" {
+		t.Errorf("Unexpected organizations %+v", organizations)
+	}
+	if resp.IsLast {
+		t.Error("Expected more pages")
+	}
+}
+
+func TestServiceDeskService_GetOrganization(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/servicedeskapi/organization/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/servicedeskapi/organization/1")
+		fmt.Fprint(w, `{"id":"1","name":"Charlie Cakes Franchises","_links":{"self":"https://your-domain.atlassian.net/rest/servicedeskapi/organization/1"}}`)
+	})
+
+	organization, _, err := testClient.ServiceDesk.GetOrganization("1")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if organization.ID != "1" || organization.Links["self"] == "" {
+		t.Errorf("Unexpected organization %+v", organization)
+	}
+}
+
+func TestServiceDeskService_GetOrganizationPropertyKeys(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/servicedeskapi/organization/1/property", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/servicedeskapi/organization/1/property")
+		fmt.Fprint(w, `{"keys":[{"self":"https://your-domain.atlassian.net/rest/servicedeskapi/organization/1/property/crm","key":"crm"}]}`)
+	})
+
+	keys, _, err := testClient.ServiceDesk.GetOrganizationPropertyKeys("1")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(keys.Keys) != 1 || keys.Keys[0].Key != "crm" {
+		t.Errorf("Unexpected keys %+v", keys)
+	}
+}
+
+func TestServiceDeskService_GetOrganizationProperty(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/servicedeskapi/organization/1/property/crm", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/servicedeskapi/organization/1/property/crm")
+		fmt.Fprint(w, `{"key":"crm","value":{"accountId":"0015800000abc","tier":"gold"}}`)
+	})
+
+	property, _, err := testClient.ServiceDesk.GetOrganizationProperty("1", "crm")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	value, ok := property.Value.(map[string]interface{})
+	if property.Key != "crm" || !ok || value["tier"] != "gold" {
+		t.Errorf("Unexpected property %+v", property)
+	}
+}
+
+func TestServiceDeskService_SetOrganizationProperty(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/servicedeskapi/organization/1/property/crm", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, "/rest/servicedeskapi/organization/1/property/crm")
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"accountId":"0015800000abc","tier":"gold"}`+"\n" {
+			t.Errorf("Unexpected body %s", body)
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	value := map[string]string{"accountId": "0015800000abc", "tier": "gold"}
+	if _, err := testClient.ServiceDesk.SetOrganizationProperty("1", "crm", value); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestServiceDeskService_DeleteOrganizationProperty(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/servicedeskapi/organization/1/property/crm", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, "/rest/servicedeskapi/organization/1/property/crm")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.ServiceDesk.DeleteOrganizationProperty("1", "crm"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
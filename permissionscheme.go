@@ -1,6 +1,9 @@
 package jira
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // PermissionSchemeService handles permissionschemes for the JIRA instance / API.
 //
@@ -25,12 +28,12 @@ type Holder struct {
 	Expand    string `json:"expand" structs:"expand"`
 }
 
-// GetList returns a list of all permission schemes
+// GetListWithContext returns a list of all permission schemes
 //
 // JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-api-3-permissionscheme-get
-func (s *PermissionSchemeService) GetList() (*PermissionSchemes, *Response, error) {
+func (s *PermissionSchemeService) GetListWithContext(ctx context.Context) (*PermissionSchemes, *Response, error) {
 	apiEndpoint := "/rest/api/3/permissionscheme"
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -45,12 +48,17 @@ func (s *PermissionSchemeService) GetList() (*PermissionSchemes, *Response, erro
 	return pss, resp, nil
 }
 
-// Get returns a full representation of the permission scheme for the schemeID
+// GetList wraps GetListWithContext using the background context.
+func (s *PermissionSchemeService) GetList() (*PermissionSchemes, *Response, error) {
+	return s.GetListWithContext(context.Background())
+}
+
+// GetWithContext returns a full representation of the permission scheme for the schemeID
 //
 // JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-api-3-permissionscheme-schemeId-get
-func (s *PermissionSchemeService) Get(schemeID int) (*PermissionScheme, *Response, error) {
+func (s *PermissionSchemeService) GetWithContext(ctx context.Context, schemeID int) (*PermissionScheme, *Response, error) {
 	apiEndpoint := fmt.Sprintf("/rest/api/3/permissionscheme/%d", schemeID)
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -67,3 +75,161 @@ func (s *PermissionSchemeService) Get(schemeID int) (*PermissionScheme, *Respons
 
 	return ps, resp, nil
 }
+
+// Get wraps GetWithContext using the background context.
+func (s *PermissionSchemeService) Get(schemeID int) (*PermissionScheme, *Response, error) {
+	return s.GetWithContext(context.Background(), schemeID)
+}
+
+// These constants are the types of the holder of a permission grant
+const (
+	HolderTypeGroup           = "group"
+	HolderTypeProjectRole     = "projectRole"
+	HolderTypeUser            = "user"
+	HolderTypeApplicationRole = "applicationRole"
+	HolderTypeAnyone          = "anyone"
+	HolderTypeProjectLead     = "projectLead"
+	HolderTypeReporter        = "reporter"
+	HolderTypeAssignee        = "assignee"
+)
+
+// PermissionGrant is passed to PermissionSchemeService.AddGrant to grant a permission to a holder.
+// Parameter identifies the holder, depending on its type: the group name, the ID of the project role,
+// the account ID of the user or the key of the application role.
+type PermissionGrant struct {
+	Holder struct {
+		Type      string `json:"type" structs:"type"`
+		Parameter string `json:"parameter,omitempty" structs:"parameter,omitempty"`
+	} `json:"holder" structs:"holder"`
+	// Permission is the key of the permission, e.g. "BROWSE_PROJECTS"
+	Permission string `json:"permission" structs:"permission"`
+}
+
+// NewPermissionGrant returns the grant of permission to the holder of the given type, identified by parameter
+func NewPermissionGrant(permission, holderType, parameter string) *PermissionGrant {
+	grant := &PermissionGrant{Permission: permission}
+	grant.Holder.Type = holderType
+	grant.Holder.Parameter = parameter
+	return grant
+}
+
+// GetGrantsWithContext returns all permission grants of the permission scheme
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-permission-schemes/#api-rest-api-3-permissionscheme-schemeid-permission-get
+func (s *PermissionSchemeService) GetGrantsWithContext(ctx context.Context, schemeID int) ([]Permission, *Response, error) {
+	apiEndpoint := fmt.Sprintf("/rest/api/3/permissionscheme/%d/permission", schemeID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(struct {
+		Permissions []Permission `json:"permissions"`
+	})
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return nil, resp, jerr
+	}
+
+	return result.Permissions, resp, nil
+}
+
+// GetGrants wraps GetGrantsWithContext using the background context.
+func (s *PermissionSchemeService) GetGrants(schemeID int) ([]Permission, *Response, error) {
+	return s.GetGrantsWithContext(context.Background(), schemeID)
+}
+
+// GetGrantWithContext returns the permission grant with the given ID of the permission scheme
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-permission-schemes/#api-rest-api-3-permissionscheme-schemeid-permission-permissionid-get
+func (s *PermissionSchemeService) GetGrantWithContext(ctx context.Context, schemeID, permissionID int) (*Permission, *Response, error) {
+	apiEndpoint := fmt.Sprintf("/rest/api/3/permissionscheme/%d/permission/%d", schemeID, permissionID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	permission := new(Permission)
+	resp, err := s.client.Do(req, permission)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return nil, resp, jerr
+	}
+
+	return permission, resp, nil
+}
+
+// GetGrant wraps GetGrantWithContext using the background context.
+func (s *PermissionSchemeService) GetGrant(schemeID, permissionID int) (*Permission, *Response, error) {
+	return s.GetGrantWithContext(context.Background(), schemeID, permissionID)
+}
+
+// AddGrantWithContext creates a permission grant in the permission scheme
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-permission-schemes/#api-rest-api-3-permissionscheme-schemeid-permission-post
+func (s *PermissionSchemeService) AddGrantWithContext(ctx context.Context, schemeID int, grant *PermissionGrant) (*Permission, *Response, error) {
+	apiEndpoint := fmt.Sprintf("/rest/api/3/permissionscheme/%d/permission", schemeID)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, grant)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	permission := new(Permission)
+	resp, err := s.client.Do(req, permission)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return nil, resp, jerr
+	}
+
+	return permission, resp, nil
+}
+
+// AddGrant wraps AddGrantWithContext using the background context.
+func (s *PermissionSchemeService) AddGrant(schemeID int, grant *PermissionGrant) (*Permission, *Response, error) {
+	return s.AddGrantWithContext(context.Background(), schemeID, grant)
+}
+
+// DeleteGrantWithContext deletes the permission grant with the given ID from the permission scheme
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-permission-schemes/#api-rest-api-3-permissionscheme-schemeid-permission-permissionid-delete
+func (s *PermissionSchemeService) DeleteGrantWithContext(ctx context.Context, schemeID, permissionID int) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("/rest/api/3/permissionscheme/%d/permission/%d", schemeID, permissionID)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return resp, jerr
+	}
+
+	return resp, nil
+}
+
+// DeleteGrant wraps DeleteGrantWithContext using the background context.
+func (s *PermissionSchemeService) DeleteGrant(schemeID, permissionID int) (*Response, error) {
+	return s.DeleteGrantWithContext(context.Background(), schemeID, permissionID)
+}
+
+// UpdateGrantWithContext replaces the permission grant with the given ID by grant.
+// JIRA has no endpoint to update a grant, so the new grant is created before the old one is deleted.
+// The returned permission has a new ID.
+// If the old grant can not be deleted, the new permission is returned together with the error,
+// so the caller can delete either of them.
+func (s *PermissionSchemeService) UpdateGrantWithContext(ctx context.Context, schemeID, permissionID int, grant *PermissionGrant) (*Permission, *Response, error) {
+	permission, resp, err := s.AddGrantWithContext(ctx, schemeID, grant)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resp, err = s.DeleteGrantWithContext(ctx, schemeID, permissionID)
+	return permission, resp, err
+}
+
+// UpdateGrant wraps UpdateGrantWithContext using the background context.
+func (s *PermissionSchemeService) UpdateGrant(schemeID, permissionID int, grant *PermissionGrant) (*Permission, *Response, error) {
+	return s.UpdateGrantWithContext(context.Background(), schemeID, permissionID, grant)
+}
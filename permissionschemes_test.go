@@ -103,3 +103,110 @@ func TestPermissionSchemeService_Get_NoScheme(t *testing.T) {
 		t.Errorf("No error given")
 	}
 }
+
+func TestPermissionSchemeService_GetGrants(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEdpoint := "/rest/api/3/permissionscheme/10000/permission"
+	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEdpoint)
+		fmt.Fprint(w, `{"permissions":[{"id":10000,"holder":{"type":"group","parameter":"jira-core-users"},"permission":"ADMINISTER_PROJECTS"},{"id":10001,"holder":{"type":"projectRole","parameter":"10002"},"permission":"BROWSE_PROJECTS"}]}`)
+	})
+
+	grants, _, err := testClient.PermissionScheme.GetGrants(10000)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(grants) != 2 || grants[1].Holder.Type != HolderTypeProjectRole || grants[1].Name != "BROWSE_PROJECTS" {
+		t.Errorf("Unexpected grants %+v", grants)
+	}
+}
+
+func TestPermissionSchemeService_AddGrant(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEdpoint := "/rest/api/3/permissionscheme/10000/permission"
+	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEdpoint)
+		body, _ := ioutil.ReadAll(r.Body)
+		expected := `{"holder":{"type":"group","parameter":"jira-developers"},"permission":"EDIT_ISSUES"}` + "\n"
+		if string(body) != expected {
+			t.Errorf("Unexpected body %s", body)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":10005,"holder":{"type":"group","parameter":"jira-developers"},"permission":"EDIT_ISSUES"}`)
+	})
+
+	grant, _, err := testClient.PermissionScheme.AddGrant(10000, NewPermissionGrant("EDIT_ISSUES", HolderTypeGroup, "jira-developers"))
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if grant == nil || grant.ID != 10005 {
+		t.Errorf("Unexpected grant %+v", grant)
+	}
+}
+
+func TestPermissionSchemeService_UpdateGrant(t *testing.T) {
+	setup()
+	defer teardown()
+	calls := []string{}
+	testMux.HandleFunc("/rest/api/3/permissionscheme/10000/permission", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		calls = append(calls, "add")
+		fmt.Fprint(w, `{"id":10006,"holder":{"type":"applicationRole","parameter":"jira-software"},"permission":"EDIT_ISSUES"}`)
+	})
+	testMux.HandleFunc("/rest/api/3/permissionscheme/10000/permission/10005", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		calls = append(calls, "delete")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	grant, _, err := testClient.PermissionScheme.UpdateGrant(10000, 10005, NewPermissionGrant("EDIT_ISSUES", HolderTypeApplicationRole, "jira-software"))
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if grant == nil || grant.ID != 10006 {
+		t.Errorf("Unexpected grant %+v", grant)
+	}
+	if len(calls) != 2 || calls[0] != "add" || calls[1] != "delete" {
+		t.Errorf("Expected add before delete. Got %v", calls)
+	}
+}
+
+func TestPermissionSchemeService_UpdateGrant_DeleteFailed(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/3/permissionscheme/10000/permission", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"id":10006,"holder":{"type":"applicationRole","parameter":"jira-software"},"permission":"EDIT_ISSUES"}`)
+	})
+	testMux.HandleFunc("/rest/api/3/permissionscheme/10000/permission/10005", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	grant, _, err := testClient.PermissionScheme.UpdateGrant(10000, 10005, NewPermissionGrant("EDIT_ISSUES", HolderTypeApplicationRole, "jira-software"))
+	if err == nil {
+		t.Error("Expected an error. Got none")
+	}
+	if grant == nil || grant.ID != 10006 {
+		t.Errorf("Expected the new grant with the error. Got %+v", grant)
+	}
+}
+
+func TestPermissionSchemeService_DeleteGrant(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEdpoint := "/rest/api/3/permissionscheme/10000/permission/10005"
+	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, testAPIEdpoint)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.PermissionScheme.DeleteGrant(10000, 10005); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
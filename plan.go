@@ -0,0 +1,225 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+)
+
+// PlanService handles the plans of Advanced Roadmaps for the JIRA instance / API.
+// Plans are only available in JIRA Premium and Enterprise.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-plans/
+type PlanService struct {
+	client *Client
+}
+
+// PlanIssueSource is a source of the issues of a plan, like a board, a project or a filter
+type PlanIssueSource struct {
+	// Type is one of "Board", "Project" or "Filter"
+	Type  string `json:"type" structs:"type"`
+	Value int64  `json:"value" structs:"value"`
+}
+
+// Plan represents a plan of Advanced Roadmaps
+type Plan struct {
+	ID            int64             `json:"id" structs:"id"`
+	Name          string            `json:"name" structs:"name"`
+	Status        string            `json:"status,omitempty" structs:"status,omitempty"`
+	ScenarioID    string            `json:"scenarioId,omitempty" structs:"scenarioId,omitempty"`
+	LastSaved     string            `json:"lastSaved,omitempty" structs:"lastSaved,omitempty"`
+	IssueSources  []PlanIssueSource `json:"issueSources,omitempty" structs:"issueSources,omitempty"`
+	LeadAccountID string            `json:"leadAccountId,omitempty" structs:"leadAccountId,omitempty"`
+}
+
+// PlansList reflects a page of plans.
+// Plans are paged by cursor, NextPageCursor has to be passed as Cursor to fetch the next page.
+type PlansList struct {
+	Cursor         string `json:"cursor" structs:"cursor"`
+	NextPageCursor string `json:"nextPageCursor" structs:"nextPageCursor"`
+	MaxResults     int    `json:"maxResults" structs:"maxResults"`
+	Total          int    `json:"total" structs:"total"`
+	IsLast         bool   `json:"isLast" structs:"isLast"`
+	Values         []Plan `json:"values" structs:"values"`
+}
+
+func (l *PlansList) pageInfo() PageInfo {
+	return PageInfo{MaxResults: l.MaxResults, Total: l.Total, IsLast: l.IsLast || l.NextPageCursor == "", NextPageToken: l.NextPageCursor}
+}
+
+// PlanListOptions specifies the optional parameters to the PlanService.GetList method
+type PlanListOptions struct {
+	IncludeTrashed  bool   `url:"includeTrashed,omitempty"`
+	IncludeArchived bool   `url:"includeArchived,omitempty"`
+	Cursor          string `url:"cursor,omitempty"`
+	MaxResults      int    `url:"maxResults,omitempty"`
+}
+
+// PlanTeam represents a team of a plan.
+// Atlassian teams are shared with the organization, plan-only teams only exist in the plan.
+type PlanTeam struct {
+	ID   string `json:"id" structs:"id"`
+	Name string `json:"name,omitempty" structs:"name,omitempty"`
+	// Type is either "Atlassian" or "PlanOnly"
+	Type             string   `json:"type" structs:"type"`
+	PlanningStyle    string   `json:"planningStyle,omitempty" structs:"planningStyle,omitempty"`
+	Capacity         float64  `json:"capacity,omitempty" structs:"capacity,omitempty"`
+	IssueSourceID    int64    `json:"issueSourceId,omitempty" structs:"issueSourceId,omitempty"`
+	SprintLength     int64    `json:"sprintLength,omitempty" structs:"sprintLength,omitempty"`
+	MemberAccountIDs []string `json:"memberAccountIds,omitempty" structs:"memberAccountIds,omitempty"`
+}
+
+// PlanTeamsList reflects a page of teams of a plan
+type PlanTeamsList struct {
+	Cursor         string     `json:"cursor" structs:"cursor"`
+	NextPageCursor string     `json:"nextPageCursor" structs:"nextPageCursor"`
+	MaxResults     int        `json:"maxResults" structs:"maxResults"`
+	Total          int        `json:"total" structs:"total"`
+	IsLast         bool       `json:"isLast" structs:"isLast"`
+	Values         []PlanTeam `json:"values" structs:"values"`
+}
+
+func (l *PlanTeamsList) pageInfo() PageInfo {
+	return PageInfo{MaxResults: l.MaxResults, Total: l.Total, IsLast: l.IsLast || l.NextPageCursor == "", NextPageToken: l.NextPageCursor}
+}
+
+// PlanTeamListOptions specifies the optional parameters to the PlanService.GetTeams method
+type PlanTeamListOptions struct {
+	Cursor     string `url:"cursor,omitempty"`
+	MaxResults int    `url:"maxResults,omitempty"`
+}
+
+// GetListWithContext returns a page of the plans of the instance.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-plans/#api-rest-api-2-plans-plan-get
+func (s *PlanService) GetListWithContext(ctx context.Context, options *PlanListOptions) (*PlansList, *Response, error) {
+	apiEndpoint, err := addOptions("rest/api/2/plans/plan", options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	plans := new(PlansList)
+	resp, err := s.client.Do(req, plans)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return plans, resp, nil
+}
+
+// GetList wraps GetListWithContext using the background context.
+func (s *PlanService) GetList(options *PlanListOptions) (*PlansList, *Response, error) {
+	return s.GetListWithContext(context.Background(), options)
+}
+
+// GetWithContext returns the plan with the given ID.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-plans/#api-rest-api-2-plans-plan-planid-get
+func (s *PlanService) GetWithContext(ctx context.Context, planID int64) (*Plan, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/plans/plan/%d", planID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	plan := new(Plan)
+	resp, err := s.client.Do(req, plan)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return plan, resp, nil
+}
+
+// Get wraps GetWithContext using the background context.
+func (s *PlanService) Get(planID int64) (*Plan, *Response, error) {
+	return s.GetWithContext(context.Background(), planID)
+}
+
+// GetTeamsWithContext returns a page of the teams of the plan.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-teams-in-plan/#api-rest-api-2-plans-plan-planid-team-get
+func (s *PlanService) GetTeamsWithContext(ctx context.Context, planID int64, options *PlanTeamListOptions) (*PlanTeamsList, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/api/2/plans/plan/%d/team", planID), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	teams := new(PlanTeamsList)
+	resp, err := s.client.Do(req, teams)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return teams, resp, nil
+}
+
+// GetTeams wraps GetTeamsWithContext using the background context.
+func (s *PlanService) GetTeams(planID int64, options *PlanTeamListOptions) (*PlanTeamsList, *Response, error) {
+	return s.GetTeamsWithContext(context.Background(), planID, options)
+}
+
+// GetScenarioIssuesWithContext returns the issues of the plan, as seen in its scenario.
+// The issues are searched with the issue sources of the plan, i.e. its boards, projects and filters.
+// Changes which are only saved in the scenario and not yet committed to JIRA are not included.
+func (s *PlanService) GetScenarioIssuesWithContext(ctx context.Context, planID int64, options *SearchOptions) ([]Issue, error) {
+	plan, _, err := s.GetWithContext(ctx, planID)
+	if err != nil {
+		return nil, err
+	}
+
+	jql, err := s.issueSourcesJQL(ctx, plan.IssueSources)
+	if err != nil {
+		return nil, err
+	}
+	if jql == "" {
+		return []Issue{}, nil
+	}
+
+	issues := []Issue{}
+	err = s.client.Issue.SearchPagesWithContext(ctx, jql, options, func(issue Issue) error {
+		issues = append(issues, issue)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return issues, nil
+}
+
+// GetScenarioIssues wraps GetScenarioIssuesWithContext using the background context.
+func (s *PlanService) GetScenarioIssues(planID int64, options *SearchOptions) ([]Issue, error) {
+	return s.GetScenarioIssuesWithContext(context.Background(), planID, options)
+}
+
+// issueSourcesJQL combines the issue sources of a plan into a single JQL query
+func (s *PlanService) issueSourcesJQL(ctx context.Context, sources []PlanIssueSource) (string, error) {
+	jql := ""
+	for _, source := range sources {
+		var clause string
+		switch source.Type {
+		case "Project":
+			clause = fmt.Sprintf("project = %d", source.Value)
+		case "Filter":
+			clause = fmt.Sprintf("filter = %d", source.Value)
+		case "Board":
+			board, _, err := s.client.Board.GetBoardConfigurationWithContext(ctx, int(source.Value))
+			if err != nil {
+				return "", err
+			}
+			clause = fmt.Sprintf("filter = %s", board.Filter.ID)
+		default:
+			continue
+		}
+
+		if jql != "" {
+			jql += " OR "
+		}
+		jql += clause
+	}
+	return jql, nil
+}
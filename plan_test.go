@@ -0,0 +1,73 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestPlanService_GetList(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/plans/plan"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/plans/plan?cursor=1&maxResults=2")
+		fmt.Fprint(w, `{"cursor":"1","isLast":false,"maxResults":2,"nextPageCursor":"3","total":10,"values":[{"id":10000,"issueSources":[{"type":"Project","value":10000}],"name":"Plan 1","scenarioId":"200","status":"Active"},{"id":10001,"issueSources":[{"type":"Board","value":20000}],"name":"Plan 2","scenarioId":"201","status":"Trashed"}]}`)
+	})
+
+	plans, _, err := testClient.Plan.GetList(&PlanListOptions{Cursor: "1", MaxResults: 2})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(plans.Values) != 2 || plans.NextPageCursor != "3" || plans.Values[1].IssueSources[0].Type != "Board" {
+		t.Errorf("Unexpected plans %+v", plans)
+	}
+}
+
+func TestPlanService_GetTeams(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/plans/plan/10000/team"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"cursor":"","isLast":true,"maxResults":50,"values":[{"id":"1","name":"Team 1","type":"PlanOnly"},{"id":"2","type":"Atlassian"}]}`)
+	})
+
+	teams, _, err := testClient.Plan.GetTeams(10000, nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(teams.Values) != 2 || teams.Values[0].Type != "PlanOnly" || !teams.IsLast {
+		t.Errorf("Unexpected teams %+v", teams)
+	}
+}
+
+func TestPlanService_GetScenarioIssues(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/plans/plan/10000", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":10000,"name":"Plan 1","issueSources":[{"type":"Project","value":10000},{"type":"Board","value":20},{"type":"Filter","value":10100}]}`)
+	})
+	testMux.HandleFunc("/rest/agile/1.0/board/20/configuration", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":20,"name":"Board","filter":{"id":"10200"}}`)
+	})
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if jql := r.URL.Query().Get("jql"); jql != "project = 10000 OR filter = 10200 OR filter = 10100" {
+			t.Errorf("Unexpected jql %s", jql)
+		}
+		fmt.Fprint(w, `{"startAt":0,"maxResults":50,"total":2,"issues":[{"key":"TEST-1"},{"key":"TEST-2"}]}`)
+	})
+
+	issues, err := testClient.Plan.GetScenarioIssues(10000, nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(issues) != 2 {
+		t.Errorf("Expected 2 issues. Got %d", len(issues))
+	}
+}
@@ -1,6 +1,7 @@
 package jira
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/google/go-querystring/query"
@@ -80,20 +81,71 @@ type PermissionScheme struct {
 	Permissions []Permission `json:"permissions" structs:"permissions,omitempty"`
 }
 
-// GetList gets all projects form JIRA
+// ProjectEmailAddress represents the sender email address of the notifications of a project
+type ProjectEmailAddress struct {
+	EmailAddress string `json:"emailAddress,omitempty" structs:"emailAddress,omitempty"`
+	// EmailAddressStatus contains the problems of the address, e.g. if its domain is not verified
+	EmailAddressStatus []string `json:"emailAddressStatus,omitempty" structs:"emailAddressStatus,omitempty"`
+}
+
+// These constants are the types of the projects, used as ProjectInput.ProjectTypeKey
+const (
+	ProjectTypeSoftware    = "software"
+	ProjectTypeBusiness    = "business"
+	ProjectTypeServiceDesk = "service_desk"
+)
+
+// These constants are the default assignees of the issues of a project, used as ProjectInput.AssigneeType
+const (
+	ProjectAssigneeTypeLead       = "PROJECT_LEAD"
+	ProjectAssigneeTypeUnassigned = "UNASSIGNED"
+)
+
+// ProjectInput is the payload to create a project with ProjectService.Create.
+// The lead is given by Lead on JIRA Server / Data Center and by LeadAccountID on JIRA Cloud.
+type ProjectInput struct {
+	Key                 string `json:"key" structs:"key"`
+	Name                string `json:"name" structs:"name"`
+	ProjectTypeKey      string `json:"projectTypeKey" structs:"projectTypeKey"`
+	ProjectTemplateKey  string `json:"projectTemplateKey,omitempty" structs:"projectTemplateKey,omitempty"`
+	Description         string `json:"description,omitempty" structs:"description,omitempty"`
+	Lead                string `json:"lead,omitempty" structs:"lead,omitempty"`
+	LeadAccountID       string `json:"leadAccountId,omitempty" structs:"leadAccountId,omitempty"`
+	URL                 string `json:"url,omitempty" structs:"url,omitempty"`
+	AssigneeType        string `json:"assigneeType,omitempty" structs:"assigneeType,omitempty"`
+	AvatarID            int64  `json:"avatarId,omitempty" structs:"avatarId,omitempty"`
+	IssueSecurityScheme int64  `json:"issueSecurityScheme,omitempty" structs:"issueSecurityScheme,omitempty"`
+	PermissionScheme    int64  `json:"permissionScheme,omitempty" structs:"permissionScheme,omitempty"`
+	NotificationScheme  int64  `json:"notificationScheme,omitempty" structs:"notificationScheme,omitempty"`
+	CategoryID          int64  `json:"categoryId,omitempty" structs:"categoryId,omitempty"`
+}
+
+// ProjectIdentifiers is the reference to a project returned by ProjectService.Create
+type ProjectIdentifiers struct {
+	Self string `json:"self" structs:"self"`
+	ID   int64  `json:"id" structs:"id"`
+	Key  string `json:"key" structs:"key"`
+}
+
+// GetListWithContext gets all projects form JIRA
 //
 // JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/project-getAllProjects
+func (s *ProjectService) GetListWithContext(ctx context.Context) (*ProjectList, *Response, error) {
+	return s.ListWithOptionsWithContext(ctx, &GetQueryOptions{})
+}
+
+// GetList wraps GetListWithContext using the background context.
 func (s *ProjectService) GetList() (*ProjectList, *Response, error) {
-	return s.ListWithOptions(&GetQueryOptions{})
+	return s.GetListWithContext(context.Background())
 }
 
-// ListWithOptions gets all projects form JIRA with optional query params, like &GetQueryOptions{Expand: "issueTypes"} to get
+// ListWithOptionsWithContext gets all projects form JIRA with optional query params, like &GetQueryOptions{Expand: ExpandProjectIssueTypes} to get
 // a list of all projects and their supported issuetypes
 //
 // JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/project-getAllProjects
-func (s *ProjectService) ListWithOptions(options *GetQueryOptions) (*ProjectList, *Response, error) {
+func (s *ProjectService) ListWithOptionsWithContext(ctx context.Context, options *GetQueryOptions) (*ProjectList, *Response, error) {
 	apiEndpoint := "rest/api/2/project"
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -116,14 +168,19 @@ func (s *ProjectService) ListWithOptions(options *GetQueryOptions) (*ProjectList
 	return projectList, resp, nil
 }
 
-// Get returns a full representation of the project for the given issue key.
+// ListWithOptions wraps ListWithOptionsWithContext using the background context.
+func (s *ProjectService) ListWithOptions(options *GetQueryOptions) (*ProjectList, *Response, error) {
+	return s.ListWithOptionsWithContext(context.Background(), options)
+}
+
+// GetWithContext returns a full representation of the project for the given issue key.
 // JIRA will attempt to identify the project by the projectIdOrKey path parameter.
 // This can be an project id, or an project key.
 //
 // JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/project-getProject
-func (s *ProjectService) Get(projectID string) (*Project, *Response, error) {
+func (s *ProjectService) GetWithContext(ctx context.Context, projectID string) (*Project, *Response, error) {
 	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s", projectID)
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -138,14 +195,19 @@ func (s *ProjectService) Get(projectID string) (*Project, *Response, error) {
 	return project, resp, nil
 }
 
-// GetPermissionScheme returns a full representation of the permission scheme for the project
+// Get wraps GetWithContext using the background context.
+func (s *ProjectService) Get(projectID string) (*Project, *Response, error) {
+	return s.GetWithContext(context.Background(), projectID)
+}
+
+// GetPermissionSchemeWithContext returns a full representation of the permission scheme for the project
 // JIRA will attempt to identify the project by the projectIdOrKey path parameter.
 // This can be an project id, or an project key.
 //
 // JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/project-getProject
-func (s *ProjectService) GetPermissionScheme(projectID string) (*PermissionScheme, *Response, error) {
+func (s *ProjectService) GetPermissionSchemeWithContext(ctx context.Context, projectID string) (*PermissionScheme, *Response, error) {
 	apiEndpoint := fmt.Sprintf("/rest/api/2/project/%s/permissionscheme", projectID)
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -159,3 +221,192 @@ func (s *ProjectService) GetPermissionScheme(projectID string) (*PermissionSchem
 
 	return ps, resp, nil
 }
+
+// GetPermissionScheme wraps GetPermissionSchemeWithContext using the background context.
+func (s *ProjectService) GetPermissionScheme(projectID string) (*PermissionScheme, *Response, error) {
+	return s.GetPermissionSchemeWithContext(context.Background(), projectID)
+}
+
+// GetEmailWithContext returns the sender email address used for the notifications of the project.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-project-email/#api-rest-api-2-project-projectid-email-get
+func (s *ProjectService) GetEmailWithContext(ctx context.Context, projectID string) (*ProjectEmailAddress, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/email", projectID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	email := new(ProjectEmailAddress)
+	resp, err := s.client.Do(req, email)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return nil, resp, jerr
+	}
+
+	return email, resp, nil
+}
+
+// GetEmail wraps GetEmailWithContext using the background context.
+func (s *ProjectService) GetEmail(projectID string) (*ProjectEmailAddress, *Response, error) {
+	return s.GetEmailWithContext(context.Background(), projectID)
+}
+
+// SetEmailWithContext sets the sender email address used for the notifications of the project.
+// An empty emailAddress resets it to the default address of the instance.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-project-email/#api-rest-api-2-project-projectid-email-put
+func (s *ProjectService) SetEmailWithContext(ctx context.Context, projectID, emailAddress string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/email", projectID)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, &ProjectEmailAddress{EmailAddress: emailAddress})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return resp, jerr
+	}
+
+	return resp, nil
+}
+
+// SetEmail wraps SetEmailWithContext using the background context.
+func (s *ProjectService) SetEmail(projectID, emailAddress string) (*Response, error) {
+	return s.SetEmailWithContext(context.Background(), projectID, emailAddress)
+}
+
+// GetComponentsWithContext returns all components of the project, including their assignee types and leads.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/project-getProjectComponents
+func (s *ProjectService) GetComponentsWithContext(ctx context.Context, projectID string) ([]ProjectComponent, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/components", projectID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	components := []ProjectComponent{}
+	resp, err := s.client.Do(req, &components)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return nil, resp, jerr
+	}
+
+	return components, resp, nil
+}
+
+// GetComponents wraps GetComponentsWithContext using the background context.
+func (s *ProjectService) GetComponents(projectID string) ([]ProjectComponent, *Response, error) {
+	return s.GetComponentsWithContext(context.Background(), projectID)
+}
+
+// CreateWithContext creates a project from project.
+// The user needs the administer JIRA permission.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-projects/#api-rest-api-2-project-post
+func (s *ProjectService) CreateWithContext(ctx context.Context, project *ProjectInput) (*ProjectIdentifiers, *Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "POST", "rest/api/2/project", project)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	created := new(ProjectIdentifiers)
+	resp, err := s.client.Do(req, created)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return created, resp, nil
+}
+
+// Create wraps CreateWithContext using the background context.
+func (s *ProjectService) Create(project *ProjectInput) (*ProjectIdentifiers, *Response, error) {
+	return s.CreateWithContext(context.Background(), project)
+}
+
+// ArchiveWithContext archives the project with the given ID or key.
+// Archived projects are read only and their issues are hidden from the search.
+// JIRA Cloud Premium and Enterprise, and JIRA Data Center only.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-projects/#api-rest-api-2-project-projectidorkey-archive-post
+func (s *ProjectService) ArchiveWithContext(ctx context.Context, projectID string) (*Response, error) {
+	return s.projectAction(ctx, fmt.Sprintf("rest/api/2/project/%s/archive", projectID))
+}
+
+// Archive wraps ArchiveWithContext using the background context.
+func (s *ProjectService) Archive(projectID string) (*Response, error) {
+	return s.ArchiveWithContext(context.Background(), projectID)
+}
+
+// RestoreWithContext restores the archived or deleted project with the given ID or key.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-projects/#api-rest-api-2-project-projectidorkey-restore-post
+func (s *ProjectService) RestoreWithContext(ctx context.Context, projectID string) (*Response, error) {
+	return s.projectAction(ctx, fmt.Sprintf("rest/api/2/project/%s/restore", projectID))
+}
+
+// Restore wraps RestoreWithContext using the background context.
+func (s *ProjectService) Restore(projectID string) (*Response, error) {
+	return s.RestoreWithContext(context.Background(), projectID)
+}
+
+// GetRolesWithContext returns the roles of the project with the given ID or key, as map of the role names to the URLs of the roles.
+// Use GetRole to get the actors of a role.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-project-roles/#api-rest-api-2-project-projectidorkey-role-get
+func (s *ProjectService) GetRolesWithContext(ctx context.Context, projectID string) (map[string]string, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/role", projectID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	roles := map[string]string{}
+	resp, err := s.client.Do(req, &roles)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return roles, resp, nil
+}
+
+// GetRoles wraps GetRolesWithContext using the background context.
+func (s *ProjectService) GetRoles(projectID string) (map[string]string, *Response, error) {
+	return s.GetRolesWithContext(context.Background(), projectID)
+}
+
+// GetRoleWithContext returns the role with roleID of the project with the given ID or key, including its actors in the project.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-project-roles/#api-rest-api-2-project-projectidorkey-role-id-get
+func (s *ProjectService) GetRoleWithContext(ctx context.Context, projectID string, roleID int) (*Role, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/role/%d", projectID, roleID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	role := new(Role)
+	resp, err := s.client.Do(req, role)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return role, resp, nil
+}
+
+// GetRole wraps GetRoleWithContext using the background context.
+func (s *ProjectService) GetRole(projectID string, roleID int) (*Role, *Response, error) {
+	return s.GetRoleWithContext(context.Background(), projectID, roleID)
+}
+
+func (s *ProjectService) projectAction(ctx context.Context, apiEndpoint string) (*Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
@@ -1,9 +1,11 @@
 package jira
 
 import (
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"reflect"
 	"testing"
 )
 
@@ -159,3 +161,171 @@ func TestProjectService_GetPermissionScheme_Success(t *testing.T) {
 		t.Errorf("Error given: %s", err)
 	}
 }
+
+func TestProjectService_GetEmail(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEdpoint := "/rest/api/2/project/10000/email"
+
+	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEdpoint)
+		fmt.Fprint(w, `{"emailAddress":"jira@example.atlassian.net","emailAddressStatus":["Email address or domain not verified."]}`)
+	})
+
+	email, _, err := testClient.Project.GetEmail("10000")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if email == nil || email.EmailAddress != "jira@example.atlassian.net" || len(email.EmailAddressStatus) != 1 {
+		t.Errorf("Unexpected email address %+v", email)
+	}
+}
+
+func TestProjectService_SetEmail(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEdpoint := "/rest/api/2/project/10000/email"
+
+	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, testAPIEdpoint)
+
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != "{\"emailAddress\":\"support@example.com\"}\n" {
+			t.Errorf("Unexpected body %q", body)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.Project.SetEmail("10000", "support@example.com")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestProjectService_GetComponents(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/project/TEST/components", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/project/TEST/components")
+
+		fmt.Fprint(w, `[{"id":"10000","name":"Backend","assigneeType":"COMPONENT_LEAD","lead":{"name":"fred"},"realAssigneeType":"COMPONENT_LEAD","realAssignee":{"name":"fred"},"isAssigneeTypeValid":true}]`)
+	})
+
+	components, _, err := testClient.Project.GetComponents("TEST")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(components) != 1 || components[0].RealAssignee.Name != "fred" || components[0].AssigneeType != "COMPONENT_LEAD" {
+		t.Errorf("Unexpected components %+v", components)
+	}
+}
+
+func TestProjectService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/project", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, "/rest/api/2/project")
+
+		var payload map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("Error given: %s", err)
+		}
+		expected := map[string]interface{}{
+			"key":            "TEAM",
+			"name":           "Team",
+			"projectTypeKey": "software",
+			"leadAccountId":  "5b10a2844c20165700ede21g",
+			"assigneeType":   "PROJECT_LEAD",
+		}
+		if !reflect.DeepEqual(payload, expected) {
+			t.Errorf("Expected %v, got %v", expected, payload)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"self":"https://your-domain.atlassian.net/rest/api/2/project/10042","id":10042,"key":"TEAM"}`)
+	})
+
+	project, _, err := testClient.Project.Create(&ProjectInput{
+		Key:            "TEAM",
+		Name:           "Team",
+		ProjectTypeKey: ProjectTypeSoftware,
+		LeadAccountID:  "5b10a2844c20165700ede21g",
+		AssigneeType:   ProjectAssigneeTypeLead,
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if project.ID != 10042 || project.Key != "TEAM" {
+		t.Errorf("Unexpected project %+v", project)
+	}
+}
+
+func TestProjectService_Archive(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/project/TEAM/archive", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, "/rest/api/2/project/TEAM/archive")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Project.Archive("TEAM"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestProjectService_Restore(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/project/TEAM/restore", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, "/rest/api/2/project/TEAM/restore")
+		fmt.Fprint(w, `{"id":"10042","key":"TEAM"}`)
+	})
+
+	if _, err := testClient.Project.Restore("TEAM"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestProjectService_GetRoles(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/project/TEAM/role", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/project/TEAM/role")
+		fmt.Fprint(w, `{"Administrators":"https://your-domain.atlassian.net/rest/api/2/project/TEAM/role/10002",
+			"Developers":"https://your-domain.atlassian.net/rest/api/2/project/TEAM/role/10001"}`)
+	})
+
+	roles, _, err := testClient.Project.GetRoles("TEAM")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(roles) != 2 || roles["Developers"] != "https://your-domain.atlassian.net/rest/api/2/project/TEAM/role/10001" {
+		t.Errorf("Unexpected roles %v", roles)
+	}
+}
+
+func TestProjectService_GetRole(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/project/TEAM/role/10001", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/project/TEAM/role/10001")
+		fmt.Fprint(w, `{"self":"https://your-domain.atlassian.net/rest/api/2/project/TEAM/role/10001","name":"Developers","id":10001,
+			"actors":[{"id":10240,"displayName":"jira-developers","type":"atlassian-group-role-actor","name":"jira-developers"}]}`)
+	})
+
+	role, _, err := testClient.Project.GetRole("TEAM", 10001)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if role.Name != "Developers" || len(role.Actors) != 1 || role.Actors[0].Name != "jira-developers" {
+		t.Errorf("Unexpected role %+v", role)
+	}
+}
@@ -0,0 +1,118 @@
+package jira
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"text/template"
+)
+
+// ReleaseNotes represents the resolved issues of a version, grouped by their issue type.
+// It can be rendered into Markdown or HTML, or with a custom template.
+type ReleaseNotes struct {
+	// BaseURL is the URL of the JIRA instance, with a trailing slash. It can be used to link issues.
+	BaseURL string
+	Project string
+	Version *Version
+	Groups  []ReleaseNotesGroup
+}
+
+// ReleaseNotesGroup represents all issues of one issue type in the release notes
+type ReleaseNotesGroup struct {
+	IssueType IssueType
+	Issues    []Issue
+}
+
+// ReleaseNotesMarkdownTemplate is the default template used by ReleaseNotes.RenderMarkdown
+const ReleaseNotesMarkdownTemplate = `# Release notes - {{.Project}} - {{.Version.Name}}
+{{range .Groups}}
+## {{.IssueType.Name}}
+{{range .Issues}}
+* [{{.Key}}]({{$.BaseURL}}browse/{{.Key}}) {{.Fields.Summary}}{{end}}
+{{end}}`
+
+// ReleaseNotesHTMLTemplate is the default template used by ReleaseNotes.RenderHTML
+const ReleaseNotesHTMLTemplate = `<h1>Release notes - {{.Project}} - {{.Version.Name}}</h1>
+{{range .Groups}}<h2>{{.IssueType.Name}}</h2>
+<ul>
+{{range .Issues}}<li>[<a href="{{$.BaseURL}}browse/{{.Key}}">{{.Key}}</a>] - {{.Fields.Summary}}</li>
+{{end}}</ul>
+{{end}}`
+
+// GetReleaseNotesWithContext gathers all resolved issues of the project with the fixVersion versionID and
+// groups them by issue type. The groups are ordered by the first appearance of the issue type
+// in the search result, the issues are ordered by key.
+func (s *VersionService) GetReleaseNotesWithContext(ctx context.Context, projectKey string, versionID int) (*ReleaseNotes, error) {
+	version, _, err := s.GetWithContext(ctx, versionID)
+	if err != nil {
+		return nil, err
+	}
+
+	baseURL := s.client.GetBaseURL()
+	notes := &ReleaseNotes{
+		BaseURL: baseURL.String(),
+		Project: projectKey,
+		Version: version,
+	}
+
+	jql := fmt.Sprintf(`project = %s AND fixVersion = %s AND resolution IS NOT EMPTY ORDER BY issuetype ASC, key ASC`, quoteJQL(projectKey), version.ID)
+	options := &SearchOptions{
+		MaxResults: 100,
+		Fields:     []string{"summary", "issuetype", "status", "resolution"},
+	}
+	groups := map[string]int{}
+	err = s.client.Issue.SearchPagesWithContext(ctx, jql, options, func(issue Issue) error {
+		if issue.Fields == nil {
+			return nil
+		}
+		name := issue.Fields.Type.Name
+		idx, ok := groups[name]
+		if !ok {
+			idx = len(notes.Groups)
+			groups[name] = idx
+			notes.Groups = append(notes.Groups, ReleaseNotesGroup{IssueType: issue.Fields.Type})
+		}
+		notes.Groups[idx].Issues = append(notes.Groups[idx].Issues, issue)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return notes, nil
+}
+
+// GetReleaseNotes wraps GetReleaseNotesWithContext using the background context.
+func (s *VersionService) GetReleaseNotes(projectKey string, versionID int) (*ReleaseNotes, error) {
+	return s.GetReleaseNotesWithContext(context.Background(), projectKey, versionID)
+}
+
+// RenderMarkdown writes the release notes as Markdown into w.
+func (r *ReleaseNotes) RenderMarkdown(w io.Writer) error {
+	return r.Render(w, template.Must(template.New("releasenotes").Parse(ReleaseNotesMarkdownTemplate)))
+}
+
+// RenderHTML writes the release notes as HTML into w.
+// Issue summaries and names are escaped.
+func (r *ReleaseNotes) RenderHTML(w io.Writer) error {
+	return r.Render(w, htmltemplate.Must(htmltemplate.New("releasenotes").Parse(ReleaseNotesHTMLTemplate)))
+}
+
+// Render writes the release notes into w with a custom template.
+// Both text/template and html/template templates can be used.
+func (r *ReleaseNotes) Render(w io.Writer, tmpl interface {
+	Execute(io.Writer, interface{}) error
+}) error {
+	return tmpl.Execute(w, r)
+}
+
+// String returns the release notes as Markdown
+func (r *ReleaseNotes) String() string {
+	var b bytes.Buffer
+	if err := r.RenderMarkdown(&b); err != nil {
+		return err.Error()
+	}
+	return b.String()
+}
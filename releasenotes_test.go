@@ -0,0 +1,99 @@
+package jira
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func setupReleaseNotesMocks(t *testing.T) {
+	testMux.HandleFunc("/rest/api/2/version/10002", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/2/version/10002","id":"10002","name":"1.2.0","released":false,"projectId":10000}`)
+	})
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got := r.URL.Query().Get("jql"); got != `project = "EX" AND fixVersion = 10002 AND resolution IS NOT EMPTY ORDER BY issuetype ASC, key ASC` {
+			t.Errorf("Unexpected jql: %s", got)
+		}
+		fmt.Fprint(w, `{"startAt":0,"maxResults":100,"total":3,"issues":[
+			{"key":"EX-1","fields":{"summary":"Crash on <save>","issuetype":{"id":"1","name":"Bug"}}},
+			{"key":"EX-3","fields":{"summary":"Export as CSV","issuetype":{"id":"2","name":"Story"}}},
+			{"key":"EX-4","fields":{"summary":"Wrong totals","issuetype":{"id":"1","name":"Bug"}}}
+		]}`)
+	})
+}
+
+func TestVersionService_GetReleaseNotes(t *testing.T) {
+	setup()
+	defer teardown()
+	setupReleaseNotesMocks(t)
+
+	notes, err := testClient.Version.GetReleaseNotes("EX", 10002)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+		return
+	}
+	if notes.Version.Name != "1.2.0" {
+		t.Errorf("Expected version 1.2.0. Got %s", notes.Version.Name)
+	}
+	if len(notes.Groups) != 2 {
+		t.Errorf("Expected 2 groups. Got %d", len(notes.Groups))
+		return
+	}
+	if notes.Groups[0].IssueType.Name != "Bug" || len(notes.Groups[0].Issues) != 2 {
+		t.Errorf("Unexpected first group: %+v", notes.Groups[0])
+	}
+	if notes.Groups[1].IssueType.Name != "Story" || len(notes.Groups[1].Issues) != 1 {
+		t.Errorf("Unexpected second group: %+v", notes.Groups[1])
+	}
+}
+
+func TestReleaseNotes_RenderMarkdown(t *testing.T) {
+	setup()
+	defer teardown()
+	setupReleaseNotesMocks(t)
+
+	notes, err := testClient.Version.GetReleaseNotes("EX", 10002)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+		return
+	}
+
+	var b bytes.Buffer
+	if err := notes.RenderMarkdown(&b); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	out := b.String()
+	for _, want := range []string{"# Release notes - EX - 1.2.0", "## Bug", "## Story", "* [EX-1](" + testServer.URL + "/browse/EX-1) Crash on <save>"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected %q in markdown:\n%s", want, out)
+		}
+	}
+}
+
+func TestReleaseNotes_RenderHTML(t *testing.T) {
+	setup()
+	defer teardown()
+	setupReleaseNotesMocks(t)
+
+	notes, err := testClient.Version.GetReleaseNotes("EX", 10002)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+		return
+	}
+
+	var b bytes.Buffer
+	if err := notes.RenderHTML(&b); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	out := b.String()
+	if !strings.Contains(out, "<h2>Bug</h2>") {
+		t.Errorf("Expected issue type heading in html:\n%s", out)
+	}
+	if !strings.Contains(out, "Crash on &lt;save&gt;") {
+		t.Errorf("Expected escaped summary in html:\n%s", out)
+	}
+}
@@ -0,0 +1,77 @@
+package jira
+
+import "net/http"
+
+// WithRequestOptions returns a copy of the client, which applies the options to every request it creates.
+// It is meant to set headers for a single call or a group of calls, e.g.
+//
+//	client.WithRequestOptions(jira.WithAtlassianTokenNoCheck()).Issue.PostAttachment(...)
+//
+// The copy shares the HTTP client and the base URL with c,
+// and starts with the authentication c has at the time of the call.
+// Options of c are applied before the given options.
+func (c *Client) WithRequestOptions(options ...func(*http.Request) error) *Client {
+	derived := &Client{
+		client:         c.client,
+		baseURL:        c.baseURL,
+		session:        c.getSession(),
+		requestOptions: append(append([]func(*http.Request) error{}, c.requestOptions...), options...),
+		retryPolicy:    c.retryPolicy,
+		searchAPI:      c.searchAPI,
+	}
+	derived.initServices()
+
+	auth := *c.Authentication
+	auth.client = derived
+	auth.reauth = c.Authentication.reauth.copy()
+	derived.Authentication = &auth
+	derived.MetadataCache.TTL = c.MetadataCache.TTL
+
+	return derived
+}
+
+// applyRequestOptions applies the request options of the client to req.
+func (c *Client) applyRequestOptions(req *http.Request) error {
+	for _, option := range c.requestOptions {
+		if err := option(req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WithHeader sets the header key to value on the request.
+// This helper can be used with all methods accepting request options and with Client.WithRequestOptions.
+func WithHeader(key, value string) func(*http.Request) error {
+	return func(r *http.Request) error {
+		r.Header.Set(key, value)
+		return nil
+	}
+}
+
+// WithAtlassianTokenNoCheck disables the XSRF check of JIRA for the request.
+// It is required by endpoints accepting multipart or form data, like uploading attachments or avatars.
+func WithAtlassianTokenNoCheck() func(*http.Request) error {
+	return WithHeader("X-Atlassian-Token", "no-check")
+}
+
+// WithForceAcceptLanguage makes JIRA respect the Accept-Language header of the request,
+// instead of responding in the language of the authenticated user.
+func WithForceAcceptLanguage() func(*http.Request) error {
+	return WithHeader("X-Force-Accept-Language", "true")
+}
+
+// WithAcceptLanguage requests the response in the given language, e.g. "en" or "de-DE, en;q=0.8".
+// Names of statuses, priorities, issue types and fields are returned in this language,
+// regardless of the language in the profile of the authenticated user.
+// This makes name-based matching reliable on localized instances:
+//
+//	english := client.WithRequestOptions(jira.WithAcceptLanguage("en"))
+//	statuses, _, err := english.Status.GetAllStatuses()
+func WithAcceptLanguage(language string) func(*http.Request) error {
+	return func(r *http.Request) error {
+		r.Header.Set("Accept-Language", language)
+		r.Header.Set("X-Force-Accept-Language", "true")
+		return nil
+	}
+}
@@ -0,0 +1,84 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestClient_WithRequestOptions(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/priority", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got := r.Header.Get("X-Atlassian-Token"); got != "no-check" {
+			t.Errorf("Expected X-Atlassian-Token no-check, got %q", got)
+		}
+		if got := r.Header.Get("X-Force-Accept-Language"); got != "true" {
+			t.Errorf("Expected X-Force-Accept-Language true, got %q", got)
+		}
+		if got := r.Header.Get("X-Custom"); got != "value" {
+			t.Errorf("Expected X-Custom value, got %q", got)
+		}
+		if user, _, _ := r.BasicAuth(); user != "fred" {
+			t.Errorf("Expected basic auth of the parent client, got %q", user)
+		}
+		fmt.Fprint(w, `[]`)
+	})
+
+	testClient.Authentication.SetBasicAuth("fred", "secret")
+	derived := testClient.WithRequestOptions(WithAtlassianTokenNoCheck(), WithForceAcceptLanguage())
+	derived = derived.WithRequestOptions(WithHeader("X-Custom", "value"))
+	if _, _, err := derived.Priority.GetList(); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestClient_WithRequestOptions_ParentUnchanged(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/priority", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("X-Custom"); got != "" {
+			t.Errorf("Expected no X-Custom header on the parent client, got %q", got)
+		}
+		fmt.Fprint(w, `[]`)
+	})
+
+	testClient.WithRequestOptions(WithHeader("X-Custom", "value"))
+	if _, _, err := testClient.Priority.GetList(); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestWithHeader(t *testing.T) {
+	req, _ := http.NewRequest("GET", "http://example.com", nil)
+	if err := WithHeader("X-Test", "1")(req); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if req.Header.Get("X-Test") != "1" {
+		t.Errorf("Expected header to be set, got %v", req.Header)
+	}
+}
+
+func TestWithAcceptLanguage(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/status", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got := r.Header.Get("Accept-Language"); got != "en" {
+			t.Errorf("Expected Accept-Language en, got %q", got)
+		}
+		if got := r.Header.Get("X-Force-Accept-Language"); got != "true" {
+			t.Errorf("Expected X-Force-Accept-Language true, got %q", got)
+		}
+		fmt.Fprint(w, `[{"id":"1","name":"Open"}]`)
+	})
+
+	statuses, _, err := testClient.WithRequestOptions(WithAcceptLanguage("en")).Status.GetAllStatuses()
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(statuses) != 1 || statuses[0].Name != "Open" {
+		t.Errorf("Unexpected statuses %+v", statuses)
+	}
+}
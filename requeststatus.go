@@ -0,0 +1,118 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+)
+
+// RequestStatus is a status of a customer request in its status history
+type RequestStatus struct {
+	Status         string       `json:"status" structs:"status"`
+	StatusCategory string       `json:"statusCategory,omitempty" structs:"statusCategory,omitempty"`
+	StatusDate     *SLADateTime `json:"statusDate,omitempty" structs:"statusDate,omitempty"`
+}
+
+// CustomerTransition is a transition of a customer request, which the customer can perform on the portal
+type CustomerTransition struct {
+	ID   string `json:"id" structs:"id"`
+	Name string `json:"name" structs:"name"`
+}
+
+// customerTransitionPayload is the request payload of PerformCustomerTransition
+type customerTransitionPayload struct {
+	ID                string                     `json:"id"`
+	AdditionalComment *customerTransitionComment `json:"additionalComment,omitempty"`
+}
+
+// customerTransitionComment is the public comment added with a customer transition
+type customerTransitionComment struct {
+	Body string `json:"body"`
+}
+
+// GetRequestStatusHistoryWithContext returns a page of the statuses of the customer request issueIDOrKey,
+// the latest status first.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-request/#api-rest-servicedeskapi-request-issueidorkey-status-get
+func (s *ServiceDeskService) GetRequestStatusHistoryWithContext(ctx context.Context, issueIDOrKey string, options *ServiceDeskPageOptions) ([]RequestStatus, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/servicedeskapi/request/%s/status", issueIDOrKey), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(struct {
+		pageValues
+		Values []RequestStatus `json:"values"`
+	})
+	resp, err := s.client.Do(req, page)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return page.Values, resp, nil
+}
+
+// GetRequestStatusHistory wraps GetRequestStatusHistoryWithContext using the background context.
+func (s *ServiceDeskService) GetRequestStatusHistory(issueIDOrKey string, options *ServiceDeskPageOptions) ([]RequestStatus, *Response, error) {
+	return s.GetRequestStatusHistoryWithContext(context.Background(), issueIDOrKey, options)
+}
+
+// GetCustomerTransitionsWithContext returns a page of the transitions of the customer request issueIDOrKey,
+// which the user can perform on the customer portal.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-request/#api-rest-servicedeskapi-request-issueidorkey-transition-get
+func (s *ServiceDeskService) GetCustomerTransitionsWithContext(ctx context.Context, issueIDOrKey string, options *ServiceDeskPageOptions) ([]CustomerTransition, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/servicedeskapi/request/%s/transition", issueIDOrKey), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(struct {
+		pageValues
+		Values []CustomerTransition `json:"values"`
+	})
+	resp, err := s.client.Do(req, page)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return page.Values, resp, nil
+}
+
+// GetCustomerTransitions wraps GetCustomerTransitionsWithContext using the background context.
+func (s *ServiceDeskService) GetCustomerTransitions(issueIDOrKey string, options *ServiceDeskPageOptions) ([]CustomerTransition, *Response, error) {
+	return s.GetCustomerTransitionsWithContext(context.Background(), issueIDOrKey, options)
+}
+
+// PerformCustomerTransitionWithContext performs the customer transition transitionID on the customer request issueIDOrKey.
+// comment is added as a public comment to the request, if it is not empty.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-request/#api-rest-servicedeskapi-request-issueidorkey-transition-post
+func (s *ServiceDeskService) PerformCustomerTransitionWithContext(ctx context.Context, issueIDOrKey, transitionID, comment string) (*Response, error) {
+	payload := customerTransitionPayload{ID: transitionID}
+	if comment != "" {
+		payload.AdditionalComment = &customerTransitionComment{Body: comment}
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/servicedeskapi/request/%s/transition", issueIDOrKey)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// PerformCustomerTransition wraps PerformCustomerTransitionWithContext using the background context.
+func (s *ServiceDeskService) PerformCustomerTransition(issueIDOrKey, transitionID, comment string) (*Response, error) {
+	return s.PerformCustomerTransitionWithContext(context.Background(), issueIDOrKey, transitionID, comment)
+}
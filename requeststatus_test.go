@@ -0,0 +1,67 @@
+package jira
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestServiceDeskService_GetRequestStatusHistory(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/servicedeskapi/request/SD-1/status", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/servicedeskapi/request/SD-1/status?limit=10")
+		fmt.Fprint(w, `{"size":2,"start":0,"limit":10,"isLastPage":true,"values":[
+			{"status":"Waiting for support","statusCategory":"NEW","statusDate":{"iso8601":"2021-02-25T12:19:28+0700","epochMillis":1614230368000}},
+			{"status":"Open","statusCategory":"NEW","statusDate":{"iso8601":"2021-02-24T12:19:28+0700","epochMillis":1614143968000}}]}`)
+	})
+
+	statuses, resp, err := testClient.ServiceDesk.GetRequestStatusHistory("SD-1", &ServiceDeskPageOptions{Limit: 10})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(statuses) != 2 || statuses[0].Status != "Waiting for support" || statuses[1].StatusDate.Time().Unix() != 1614143968 {
+		t.Errorf("Unexpected statuses %+v", statuses)
+	}
+	if !resp.IsLast {
+		t.Error("Expected the last page")
+	}
+}
+
+func TestServiceDeskService_GetCustomerTransitions(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/servicedeskapi/request/SD-1/transition", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/servicedeskapi/request/SD-1/transition")
+		fmt.Fprint(w, `{"size":1,"start":0,"limit":50,"isLastPage":true,"values":[{"id":"1","name":"Cancel request"}]}`)
+	})
+
+	transitions, _, err := testClient.ServiceDesk.GetCustomerTransitions("SD-1", nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(transitions) != 1 || transitions[0].ID != "1" || transitions[0].Name != "Cancel request" {
+		t.Errorf("Unexpected transitions %+v", transitions)
+	}
+}
+
+func TestServiceDeskService_PerformCustomerTransition(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/servicedeskapi/request/SD-1/transition", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, "/rest/servicedeskapi/request/SD-1/transition")
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"id":"1","additionalComment":{"body":"No longer needed"}}`+"\n" {
+			t.Errorf("Unexpected body %s", body)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.ServiceDesk.PerformCustomerTransition("SD-1", "1", "No longer needed"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
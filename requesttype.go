@@ -0,0 +1,123 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+)
+
+// RequestType represents a customer request type of a service desk
+type RequestType struct {
+	ID            string            `json:"id" structs:"id"`
+	Name          string            `json:"name" structs:"name"`
+	Description   string            `json:"description,omitempty" structs:"description,omitempty"`
+	HelpText      string            `json:"helpText,omitempty" structs:"helpText,omitempty"`
+	IssueTypeID   string            `json:"issueTypeId,omitempty" structs:"issueTypeId,omitempty"`
+	ServiceDeskID string            `json:"serviceDeskId,omitempty" structs:"serviceDeskId,omitempty"`
+	PortalID      string            `json:"portalId,omitempty" structs:"portalId,omitempty"`
+	GroupIDs      []string          `json:"groupIds,omitempty" structs:"groupIds,omitempty"`
+	Links         map[string]string `json:"_links,omitempty" structs:"_links,omitempty"`
+}
+
+// RequestTypeFieldValue is a valid value of a field of a request type.
+// The values of the second level of a cascading select list are its Children.
+type RequestTypeFieldValue struct {
+	Value    string                  `json:"value" structs:"value"`
+	Label    string                  `json:"label" structs:"label"`
+	Children []RequestTypeFieldValue `json:"children,omitempty" structs:"children,omitempty"`
+}
+
+// RequestTypeField is a field of the form of a request type, as it is shown on the customer portal
+type RequestTypeField struct {
+	FieldID       string                  `json:"fieldId" structs:"fieldId"`
+	Name          string                  `json:"name" structs:"name"`
+	Description   string                  `json:"description,omitempty" structs:"description,omitempty"`
+	Required      bool                    `json:"required" structs:"required"`
+	Visible       bool                    `json:"visible" structs:"visible"`
+	DefaultValues []RequestTypeFieldValue `json:"defaultValues,omitempty" structs:"defaultValues,omitempty"`
+	ValidValues   []RequestTypeFieldValue `json:"validValues,omitempty" structs:"validValues,omitempty"`
+	PresetValues  []string                `json:"presetValues,omitempty" structs:"presetValues,omitempty"`
+	JiraSchema    FieldSchema             `json:"jiraSchema" structs:"jiraSchema"`
+}
+
+// RequestTypeFields are the fields of a request type and whether the user can add other people to the request
+type RequestTypeFields struct {
+	RequestTypeFields         []RequestTypeField `json:"requestTypeFields" structs:"requestTypeFields"`
+	CanRaiseOnBehalfOf        bool               `json:"canRaiseOnBehalfOf" structs:"canRaiseOnBehalfOf"`
+	CanAddRequestParticipants bool               `json:"canAddRequestParticipants" structs:"canAddRequestParticipants"`
+}
+
+// GetRequestTypesWithContext returns a page of the request types of the service desk serviceDeskID.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-servicedesk/#api-rest-servicedeskapi-servicedesk-servicedeskid-requesttype-get
+func (s *ServiceDeskService) GetRequestTypesWithContext(ctx context.Context, serviceDeskID string, options *ServiceDeskPageOptions) ([]RequestType, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/servicedeskapi/servicedesk/%s/requesttype", serviceDeskID), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(struct {
+		pageValues
+		Values []RequestType `json:"values"`
+	})
+	resp, err := s.client.Do(req, page)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return page.Values, resp, nil
+}
+
+// GetRequestTypes wraps GetRequestTypesWithContext using the background context.
+func (s *ServiceDeskService) GetRequestTypes(serviceDeskID string, options *ServiceDeskPageOptions) ([]RequestType, *Response, error) {
+	return s.GetRequestTypesWithContext(context.Background(), serviceDeskID, options)
+}
+
+// GetRequestTypeWithContext returns the request type requestTypeID of the service desk serviceDeskID.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-servicedesk/#api-rest-servicedeskapi-servicedesk-servicedeskid-requesttype-requesttypeid-get
+func (s *ServiceDeskService) GetRequestTypeWithContext(ctx context.Context, serviceDeskID, requestTypeID string) (*RequestType, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/servicedeskapi/servicedesk/%s/requesttype/%s", serviceDeskID, requestTypeID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	requestType := new(RequestType)
+	resp, err := s.client.Do(req, requestType)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return requestType, resp, nil
+}
+
+// GetRequestType wraps GetRequestTypeWithContext using the background context.
+func (s *ServiceDeskService) GetRequestType(serviceDeskID, requestTypeID string) (*RequestType, *Response, error) {
+	return s.GetRequestTypeWithContext(context.Background(), serviceDeskID, requestTypeID)
+}
+
+// GetRequestTypeFieldsWithContext returns the fields of the request type requestTypeID of the service desk serviceDeskID,
+// with the required fields and the valid values, as the customer portal shows them.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-servicedesk/#api-rest-servicedeskapi-servicedesk-servicedeskid-requesttype-requesttypeid-field-get
+func (s *ServiceDeskService) GetRequestTypeFieldsWithContext(ctx context.Context, serviceDeskID, requestTypeID string) (*RequestTypeFields, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/servicedeskapi/servicedesk/%s/requesttype/%s/field", serviceDeskID, requestTypeID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fields := new(RequestTypeFields)
+	resp, err := s.client.Do(req, fields)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return fields, resp, nil
+}
+
+// GetRequestTypeFields wraps GetRequestTypeFieldsWithContext using the background context.
+func (s *ServiceDeskService) GetRequestTypeFields(serviceDeskID, requestTypeID string) (*RequestTypeFields, *Response, error) {
+	return s.GetRequestTypeFieldsWithContext(context.Background(), serviceDeskID, requestTypeID)
+}
@@ -0,0 +1,79 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestServiceDeskService_GetRequestTypes(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/servicedeskapi/servicedesk/28/requesttype", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/servicedeskapi/servicedesk/28/requesttype?limit=1&start=1")
+		fmt.Fprint(w, `{"size":1,"start":1,"limit":1,"isLastPage":true,"values":[{"id":"11001","name":"Get IT Help",
+			"description":"Get IT help","helpText":"Please tell us clearly the problem you have.","issueTypeId":"12345",
+			"serviceDeskId":"28","portalId":"2","groupIds":["12"]}]}`)
+	})
+
+	requestTypes, resp, err := testClient.ServiceDesk.GetRequestTypes("28", &ServiceDeskPageOptions{Start: 1, Limit: 1})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(requestTypes) != 1 || requestTypes[0].Name != "Get IT Help" || requestTypes[0].GroupIDs[0] != "12" {
+		t.Errorf("Unexpected request types %+v", requestTypes)
+	}
+	if !resp.IsLast {
+		t.Error("Expected the last page")
+	}
+}
+
+func TestServiceDeskService_GetRequestType(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/servicedeskapi/servicedesk/28/requesttype/11001", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/servicedeskapi/servicedesk/28/requesttype/11001")
+		fmt.Fprint(w, `{"id":"11001","name":"Get IT Help","issueTypeId":"12345","serviceDeskId":"28",
+			"_links":{"self":"https://your-domain.atlassian.net/rest/servicedeskapi/servicedesk/28/requesttype/11001"}}`)
+	})
+
+	requestType, _, err := testClient.ServiceDesk.GetRequestType("28", "11001")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if requestType.ID != "11001" || requestType.IssueTypeID != "12345" || requestType.Links["self"] == "" {
+		t.Errorf("Unexpected request type %+v", requestType)
+	}
+}
+
+func TestServiceDeskService_GetRequestTypeFields(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/servicedeskapi/servicedesk/28/requesttype/11001/field", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/servicedeskapi/servicedesk/28/requesttype/11001/field")
+		fmt.Fprint(w, `{"canAddRequestParticipants":true,"canRaiseOnBehalfOf":false,"requestTypeFields":[
+			{"fieldId":"summary","name":"What do you need?","required":true,"visible":true,"validValues":[],
+				"jiraSchema":{"type":"string","system":"summary"}},
+			{"fieldId":"customfield_10006","name":"Location","required":false,"visible":true,
+				"validValues":[{"value":"10300","label":"Europe","children":[{"value":"10301","label":"Germany","children":[]}]}],
+				"jiraSchema":{"type":"option-with-child","custom":"com.atlassian.jira.plugin.system.customfieldtypes:cascadingselect","customId":10006}}]}`)
+	})
+
+	fields, _, err := testClient.ServiceDesk.GetRequestTypeFields("28", "11001")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if !fields.CanAddRequestParticipants || fields.CanRaiseOnBehalfOf || len(fields.RequestTypeFields) != 2 {
+		t.Fatalf("Unexpected fields %+v", fields)
+	}
+	if summary := fields.RequestTypeFields[0]; !summary.Required || summary.JiraSchema.System != "summary" {
+		t.Errorf("Unexpected summary field %+v", summary)
+	}
+	location := fields.RequestTypeFields[1]
+	if location.Required || len(location.ValidValues) != 1 || location.ValidValues[0].Children[0].Label != "Germany" {
+		t.Errorf("Unexpected location field %+v", location)
+	}
+}
@@ -0,0 +1,166 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// These constants are the kinds of entities resolved by the ResolverService.
+// They are the same as the corresponding MetadataKind* constants.
+const (
+	ResolverKindProject   = MetadataKindProject
+	ResolverKindField     = MetadataKindField
+	ResolverKindIssueType = MetadataKindIssueType
+	ResolverKindPriority  = MetadataKindPriority
+	ResolverKindStatus    = MetadataKindStatus
+)
+
+// ResolverService maps names (and project keys) to the IDs of projects, fields, issue types,
+// priorities and statuses. The entities of each kind are loaded once with a single request and
+// cached by the MetadataCacheService of the client, see its TTL. Names are compared case-insensitively.
+// The cache can be dropped explicitly with Invalidate or MetadataCacheService.Invalidate, e.g. after creating a new field.
+// Names are returned in the language of the authenticated user. On localized instances,
+// use the resolver of a client with a fixed language, e.g. client.WithRequestOptions(WithAcceptLanguage("en")).Resolver.
+type ResolverService struct {
+	client *Client
+}
+
+// resolverEntry holds the name to ID mapping of one kind of entities
+type resolverEntry struct {
+	ids map[string]string
+	// names maps the IDs back to the first name (or project key) they were added with
+	names map[string]string
+}
+
+// ProjectIDWithContext returns the ID of the project with the given key (or name)
+func (s *ResolverService) ProjectIDWithContext(ctx context.Context, keyOrName string) (string, error) {
+	return s.resolve(ctx, ResolverKindProject, keyOrName)
+}
+
+// ProjectID wraps ProjectIDWithContext using the background context.
+func (s *ResolverService) ProjectID(keyOrName string) (string, error) {
+	return s.ProjectIDWithContext(context.Background(), keyOrName)
+}
+
+// FieldIDWithContext returns the ID of the field with the given name, e.g. "customfield_10001" for "Story Points"
+func (s *ResolverService) FieldIDWithContext(ctx context.Context, name string) (string, error) {
+	return s.resolve(ctx, ResolverKindField, name)
+}
+
+// FieldID wraps FieldIDWithContext using the background context.
+func (s *ResolverService) FieldID(name string) (string, error) {
+	return s.FieldIDWithContext(context.Background(), name)
+}
+
+// FieldNameWithContext returns the name of the field with the given ID, e.g. "Story Points" for "customfield_10001".
+// It shares the cached fields with FieldIDWithContext.
+func (s *ResolverService) FieldNameWithContext(ctx context.Context, id string) (string, error) {
+	entry, err := s.client.MetadataCache.names(ctx, ResolverKindField)
+	if err != nil {
+		return "", err
+	}
+
+	name, ok := entry.names[id]
+	if !ok {
+		return "", fmt.Errorf("no %s found with ID %q", ResolverKindField, id)
+	}
+	return name, nil
+}
+
+// FieldName wraps FieldNameWithContext using the background context.
+func (s *ResolverService) FieldName(id string) (string, error) {
+	return s.FieldNameWithContext(context.Background(), id)
+}
+
+// IssueTypeIDWithContext returns the ID of the issue type with the given name
+func (s *ResolverService) IssueTypeIDWithContext(ctx context.Context, name string) (string, error) {
+	return s.resolve(ctx, ResolverKindIssueType, name)
+}
+
+// IssueTypeID wraps IssueTypeIDWithContext using the background context.
+func (s *ResolverService) IssueTypeID(name string) (string, error) {
+	return s.IssueTypeIDWithContext(context.Background(), name)
+}
+
+// PriorityIDWithContext returns the ID of the priority with the given name
+func (s *ResolverService) PriorityIDWithContext(ctx context.Context, name string) (string, error) {
+	return s.resolve(ctx, ResolverKindPriority, name)
+}
+
+// PriorityID wraps PriorityIDWithContext using the background context.
+func (s *ResolverService) PriorityID(name string) (string, error) {
+	return s.PriorityIDWithContext(context.Background(), name)
+}
+
+// StatusIDWithContext returns the ID of the status with the given name
+func (s *ResolverService) StatusIDWithContext(ctx context.Context, name string) (string, error) {
+	return s.resolve(ctx, ResolverKindStatus, name)
+}
+
+// StatusID wraps StatusIDWithContext using the background context.
+func (s *ResolverService) StatusID(name string) (string, error) {
+	return s.StatusIDWithContext(context.Background(), name)
+}
+
+// Invalidate drops the cached entities of the given kinds (see ResolverKind* constants),
+// or of all kinds if no kind is given. They are loaded again on the next lookup.
+// It is the same as MetadataCacheService.Invalidate.
+func (s *ResolverService) Invalidate(kinds ...string) {
+	s.client.MetadataCache.Invalidate(kinds...)
+}
+
+// resolve returns the ID of the entity of the given kind with the given name
+func (s *ResolverService) resolve(ctx context.Context, kind, name string) (string, error) {
+	entry, err := s.client.MetadataCache.names(ctx, kind)
+	if err != nil {
+		return "", err
+	}
+
+	id, ok := entry.ids[strings.ToLower(name)]
+	if !ok {
+		return "", fmt.Errorf("no %s found with name %q", kind, name)
+	}
+	return id, nil
+}
+
+// newResolverEntry returns the IDs by lower case name of the entities of a MetadataCacheService response
+func newResolverEntry(value interface{}) *resolverEntry {
+	entry := &resolverEntry{ids: map[string]string{}, names: map[string]string{}}
+	add := func(name, id string) {
+		// the first entity wins if names are not unique, e.g. for custom fields
+		if _, exists := entry.ids[strings.ToLower(name)]; !exists {
+			entry.ids[strings.ToLower(name)] = id
+		}
+		if _, exists := entry.names[id]; !exists {
+			entry.names[id] = name
+		}
+	}
+
+	switch value := value.(type) {
+	case *ProjectList:
+		for _, project := range *value {
+			add(project.Key, project.ID)
+		}
+		for _, project := range *value {
+			add(project.Name, project.ID)
+		}
+	case []Field:
+		for _, field := range value {
+			add(field.Name, field.ID)
+		}
+	case []IssueType:
+		for _, issueType := range value {
+			add(issueType.Name, issueType.ID)
+		}
+	case []Priority:
+		for _, priority := range value {
+			add(priority.Name, priority.ID)
+		}
+	case []Status:
+		for _, status := range value {
+			add(status.Name, status.ID)
+		}
+	}
+	return entry
+}
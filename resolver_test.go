@@ -0,0 +1,161 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestResolverService_ProjectID(t *testing.T) {
+	setup()
+	defer teardown()
+	requests := 0
+	testMux.HandleFunc("/rest/api/2/project", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		requests++
+		fmt.Fprint(w, `[{"id":"10000","key":"TEST","name":"Test Project"},{"id":"10001","key":"OPS","name":"Operations"}]`)
+	})
+
+	for _, keyOrName := range []string{"TEST", "test", "Test Project"} {
+		id, err := testClient.Resolver.ProjectID(keyOrName)
+		if err != nil {
+			t.Errorf("Error given: %s", err)
+		}
+		if id != "10000" {
+			t.Errorf("Expected ID 10000 for %s. Got %s", keyOrName, id)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("Expected the projects to be loaded once. Got %d requests", requests)
+	}
+
+	if _, err := testClient.Resolver.ProjectID("UNKNOWN"); err == nil {
+		t.Error("Expected an error for an unknown project. Got none")
+	}
+}
+
+func TestResolverService_Invalidate(t *testing.T) {
+	setup()
+	defer teardown()
+	requests := 0
+	testMux.HandleFunc("/rest/api/2/field", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		requests++
+		if requests == 1 {
+			fmt.Fprint(w, `[{"id":"summary","name":"Summary"}]`)
+			return
+		}
+		fmt.Fprint(w, `[{"id":"summary","name":"Summary"},{"id":"customfield_10002","name":"Story Points","custom":true}]`)
+	})
+
+	if _, err := testClient.Resolver.FieldID("Story Points"); err == nil {
+		t.Error("Expected an error before the field exists. Got none")
+	}
+
+	testClient.Resolver.Invalidate(ResolverKindField)
+
+	id, err := testClient.Resolver.FieldID("story points")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if id != "customfield_10002" {
+		t.Errorf("Expected customfield_10002. Got %s", id)
+	}
+	if requests != 2 {
+		t.Errorf("Expected 2 requests. Got %d", requests)
+	}
+}
+
+func TestResolverService_TTL(t *testing.T) {
+	setup()
+	defer teardown()
+	requests := 0
+	testMux.HandleFunc("/rest/api/2/priority", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprint(w, `[{"id":"1","name":"Highest"},{"id":"3","name":"Medium"}]`)
+	})
+	testMux.HandleFunc("/rest/api/2/status", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id":"10000","name":"To Do"}]`)
+	})
+	testMux.HandleFunc("/rest/api/2/issuetype", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id":"10001","name":"Story"}]`)
+	})
+
+	testClient.MetadataCache.TTL = time.Nanosecond
+	for i := 0; i < 2; i++ {
+		time.Sleep(time.Millisecond)
+		if id, err := testClient.Resolver.PriorityID("medium"); err != nil || id != "3" {
+			t.Errorf("Unexpected result %s, %v", id, err)
+		}
+	}
+	if requests != 2 {
+		t.Errorf("Expected the priorities to be reloaded after the TTL. Got %d requests", requests)
+	}
+
+	if id, err := testClient.Resolver.StatusID("to do"); err != nil || id != "10000" {
+		t.Errorf("Unexpected status ID %s, %v", id, err)
+	}
+	if id, err := testClient.Resolver.IssueTypeID("Story"); err != nil || id != "10001" {
+		t.Errorf("Unexpected issue type ID %s, %v", id, err)
+	}
+}
+
+func TestResolverService_FieldName(t *testing.T) {
+	setup()
+	defer teardown()
+	requests := 0
+	testMux.HandleFunc("/rest/api/2/field", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		requests++
+		fmt.Fprint(w, `[{"id":"summary","name":"Summary"},{"id":"customfield_10016","name":"Story Points","custom":true}]`)
+	})
+
+	name, err := testClient.Resolver.FieldName("customfield_10016")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if name != "Story Points" {
+		t.Errorf("Expected Story Points. Got %s", name)
+	}
+	if id, err := testClient.Resolver.FieldID("story points"); err != nil || id != "customfield_10016" {
+		t.Errorf("Unexpected field ID %s, %v", id, err)
+	}
+	if requests != 1 {
+		t.Errorf("Expected the fields to be loaded once. Got %d requests", requests)
+	}
+
+	if _, err := testClient.Resolver.FieldName("customfield_99999"); err == nil {
+		t.Error("Expected an error for an unknown field. Got none")
+	}
+}
+
+func TestResolverService_MetadataCacheInvalidate(t *testing.T) {
+	setup()
+	defer teardown()
+	requests := 0
+	testMux.HandleFunc("/rest/api/2/field", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			fmt.Fprint(w, `[{"id":"summary","name":"Summary"}]`)
+			return
+		}
+		fmt.Fprint(w, `[{"id":"summary","name":"Summary"},{"id":"customfield_10002","name":"Story Points","custom":true}]`)
+	})
+
+	if _, err := testClient.Resolver.FieldID("Story Points"); err == nil {
+		t.Error("Expected an error before the field exists. Got none")
+	}
+
+	testClient.MetadataCache.Invalidate(MetadataKindField)
+
+	if id, err := testClient.Resolver.FieldID("Story Points"); err != nil || id != "customfield_10002" {
+		t.Errorf("Unexpected field ID %s, %v", id, err)
+	}
+	if name, err := testClient.Resolver.FieldName("customfield_10002"); err != nil || name != "Story Points" {
+		t.Errorf("Unexpected field name %s, %v", name, err)
+	}
+	if requests != 2 {
+		t.Errorf("Expected 2 requests. Got %d", requests)
+	}
+}
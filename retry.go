@@ -0,0 +1,131 @@
+package jira
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+// These constants are the defaults of RetryPolicy
+const (
+	DefaultRetryBaseDelay = time.Second
+	DefaultRetryMaxDelay  = 30 * time.Second
+)
+
+// ClientOption configures a Client created by NewClient
+type ClientOption func(*Client)
+
+// RetryPolicy configures how requests are retried, which JIRA rejected with 429 Too Many Requests
+// or failed with a 5xx server error. The delay between two attempts grows exponentially
+// from BaseDelay up to MaxDelay and is randomized to spread the retries of concurrent requests.
+// If JIRA sends a Retry-After header, its delay is used instead.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of times a request is sent, including the first one.
+	// Values <= 1 disable retries.
+	MaxAttempts int
+	// BaseDelay is the delay before the first retry, DefaultRetryBaseDelay if 0
+	BaseDelay time.Duration
+	// MaxDelay caps the exponential delay, DefaultRetryMaxDelay if 0
+	MaxDelay time.Duration
+	// RetryAllMethods also retries POST and PATCH requests on 5xx errors, which JIRA might have processed already.
+	// Requests rejected with 429 are retried regardless of their method.
+	RetryAllMethods bool
+}
+
+// WithRetryPolicy makes the client retry requests according to policy.
+// Requests are not retried by default.
+// Requests with a body, which can not be read again (see http.Request.GetBody), are sent only once.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *Client) {
+		c.retryPolicy = &policy
+	}
+}
+
+// retryPolicyContextKey is the context key of the retry policy set by ContextWithRetryPolicy
+type retryPolicyContextKey struct{}
+
+// ContextWithRetryPolicy returns a copy of ctx, which overrides the retry policy of the client
+// for the requests sent with it, e.g. to disable retries of a single call:
+//
+//	ctx := jira.ContextWithRetryPolicy(context.Background(), jira.RetryPolicy{MaxAttempts: 1})
+//	issue, _, err := client.Issue.GetWithContext(ctx, "MESOS-3325", nil)
+func ContextWithRetryPolicy(ctx context.Context, policy RetryPolicy) context.Context {
+	return context.WithValue(ctx, retryPolicyContextKey{}, policy)
+}
+
+// sendWithRetry sends req with the HTTP client and retries it according to the retry policy of the request or the client
+func (c *Client) sendWithRetry(req *http.Request) (*http.Response, error) {
+	policy := c.retryPolicy
+	if p, ok := req.Context().Value(retryPolicyContextKey{}).(RetryPolicy); ok {
+		policy = &p
+	}
+	if policy == nil || policy.MaxAttempts <= 1 || (req.Body != nil && req.GetBody == nil) {
+		return c.client.Do(req)
+	}
+
+	for attempt := 1; ; attempt++ {
+		resp, err := c.client.Do(req)
+		if err != nil || attempt >= policy.MaxAttempts || !policy.shouldRetry(req, resp) {
+			return resp, err
+		}
+
+		delay := parseRetryAfter(resp.Header.Get("Retry-After"))
+		if delay == 0 {
+			delay = policy.backoff(attempt)
+		}
+		io.Copy(ioutil.Discard, resp.Body)
+		resp.Body.Close()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+
+		retry := cloneRequest(req)
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			retry.Body = body
+		}
+		req = retry
+	}
+}
+
+// shouldRetry reports whether req should be sent again after resp
+func (p *RetryPolicy) shouldRetry(req *http.Request, resp *http.Response) bool {
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	if resp.StatusCode < 500 {
+		return false
+	}
+	return p.RetryAllMethods || (req.Method != "POST" && req.Method != "PATCH")
+}
+
+// backoff returns the randomized exponential delay after the given attempt, between half and all of the exponential delay
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	base, max := p.BaseDelay, p.MaxDelay
+	if base <= 0 {
+		base = DefaultRetryBaseDelay
+	}
+	if max <= 0 {
+		max = DefaultRetryMaxDelay
+	}
+
+	delay := base
+	for i := 1; i < attempt && delay < max; i++ {
+		delay *= 2
+	}
+	if delay > max {
+		delay = max
+	}
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
@@ -0,0 +1,134 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestClient_RetryPolicy(t *testing.T) {
+	setup()
+	defer teardown()
+	attempts := 0
+	testMux.HandleFunc("/rest/api/2/issue/10002", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		attempts++
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"fields":{"summary":"Retried"}}`+"\n" {
+			t.Errorf("Unexpected body %s in attempt %d", body, attempts)
+		}
+		switch attempts {
+		case 1:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case 2:
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	client, _ := NewClient(nil, testServer.URL, WithRetryPolicy(RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}))
+	req, _ := client.NewRequest("PUT", "rest/api/2/issue/10002", map[string]interface{}{"fields": map[string]string{"summary": "Retried"}})
+	if _, err := client.Do(req, nil); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if attempts != 3 {
+		t.Errorf("Expected 3 attempts. Got %d", attempts)
+	}
+}
+
+func TestClient_RetryPolicy_MaxAttempts(t *testing.T) {
+	setup()
+	defer teardown()
+	attempts := 0
+	testMux.HandleFunc("/rest/api/2/myself", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusBadGateway)
+		fmt.Fprint(w, `{"errorMessages":["Bad gateway"]}`)
+	})
+
+	client, _ := NewClient(nil, testServer.URL, WithRetryPolicy(RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}))
+	req, _ := client.NewRequest("GET", "rest/api/2/myself", nil)
+	resp, err := client.Do(req, nil)
+	if err == nil {
+		t.Fatal("Expected an error. Got none")
+	}
+	if resp.StatusCode != http.StatusBadGateway || attempts != 3 {
+		t.Errorf("Expected 3 attempts with status 502. Got %d attempts with status %d", attempts, resp.StatusCode)
+	}
+}
+
+func TestClient_RetryPolicy_Methods(t *testing.T) {
+	setup()
+	defer teardown()
+	attempts := 0
+	status := http.StatusInternalServerError
+	testMux.HandleFunc("/rest/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		attempts++
+		w.WriteHeader(status)
+	})
+
+	client, _ := NewClient(nil, testServer.URL, WithRetryPolicy(RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond}))
+	req, _ := client.NewRequest("POST", "rest/api/2/issue", &Issue{})
+	client.Do(req, nil)
+	if attempts != 1 {
+		t.Errorf("Expected a POST with 500 not to be retried. Got %d attempts", attempts)
+	}
+
+	attempts = 0
+	status = http.StatusTooManyRequests
+	req, _ = client.NewRequest("POST", "rest/api/2/issue", &Issue{})
+	client.Do(req, nil)
+	if attempts != 2 {
+		t.Errorf("Expected a POST with 429 to be retried. Got %d attempts", attempts)
+	}
+}
+
+func TestClient_RetryPolicy_Context(t *testing.T) {
+	setup()
+	defer teardown()
+	attempts := 0
+	testMux.HandleFunc("/rest/api/2/myself", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Retry-After", "10")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	client, _ := NewClient(nil, testServer.URL, WithRetryPolicy(RetryPolicy{MaxAttempts: 3}))
+	ctx := ContextWithRetryPolicy(context.Background(), RetryPolicy{MaxAttempts: 1})
+	req, _ := client.NewRequestWithContext(ctx, "GET", "rest/api/2/myself", nil)
+	if _, err := client.Do(req, nil); err == nil {
+		t.Error("Expected an error. Got none")
+	}
+	if attempts != 1 {
+		t.Errorf("Expected the retries to be disabled by the context. Got %d attempts", attempts)
+	}
+
+	attempts = 0
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	req, _ = client.NewRequestWithContext(ctx, "GET", "rest/api/2/myself", nil)
+	start := time.Now()
+	if _, err := client.Do(req, nil); err != context.DeadlineExceeded {
+		t.Errorf("Expected the deadline to be exceeded while waiting for the Retry-After delay. Got %v", err)
+	}
+	if attempts != 1 || time.Since(start) > 5*time.Second {
+		t.Errorf("Expected the wait to be cancelled after 1 attempt. Got %d attempts in %s", attempts, time.Since(start))
+	}
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := &RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+	for attempt, max := range map[int]time.Duration{1: 100 * time.Millisecond, 2: 200 * time.Millisecond, 4: 800 * time.Millisecond, 10: time.Second} {
+		for i := 0; i < 20; i++ {
+			if delay := policy.backoff(attempt); delay < max/2 || delay > max {
+				t.Errorf("Expected the delay of attempt %d between %s and %s. Got %s", attempt, max/2, max, delay)
+			}
+		}
+	}
+}
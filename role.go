@@ -1,6 +1,7 @@
 package jira
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -22,12 +23,13 @@ type Role struct {
 
 // Actor represents a JIRA actor
 type Actor struct {
-	ID          int        `json:"id" structs:"id"`
-	DisplayName string     `json:"displayName" structs:"displayName"`
-	Type        string     `json:"type" structs:"type"`
-	Name        string     `json:"name" structs:"name"`
-	AvatarURL   string     `json:"avatarUrl" structs:"avatarUrl"`
-	ActorUser   *ActorUser `json:"actorUser" structs:"actoruser"`
+	ID          int         `json:"id" structs:"id"`
+	DisplayName string      `json:"displayName" structs:"displayName"`
+	Type        string      `json:"type" structs:"type"`
+	Name        string      `json:"name" structs:"name"`
+	AvatarURL   string      `json:"avatarUrl" structs:"avatarUrl"`
+	ActorUser   *ActorUser  `json:"actorUser" structs:"actoruser"`
+	ActorGroup  *ActorGroup `json:"actorGroup,omitempty" structs:"actorGroup,omitempty"`
 }
 
 // ActorUser contains the account id of the actor/user
@@ -35,12 +37,35 @@ type ActorUser struct {
 	AccountID string `json:"accountId" structs:"accountId"`
 }
 
-// GetList returns a list of all available project roles
+// ActorGroup contains the group of the actor
+type ActorGroup struct {
+	Name        string `json:"name,omitempty" structs:"name,omitempty"`
+	DisplayName string `json:"displayName,omitempty" structs:"displayName,omitempty"`
+	GroupID     string `json:"groupId,omitempty" structs:"groupId,omitempty"`
+}
+
+// RoleActors identifies users and groups to add as actors to a role.
+// Users are identified by their account ID, groups either by ID or by name.
+type RoleActors struct {
+	User    []string `json:"user,omitempty" structs:"user,omitempty"`
+	GroupID []string `json:"groupId,omitempty" structs:"groupId,omitempty"`
+	Group   []string `json:"group,omitempty" structs:"group,omitempty"`
+}
+
+// RoleActorOptions identifies the actor to remove from a role.
+// Exactly one of the fields has to be set.
+type RoleActorOptions struct {
+	User    string `url:"user,omitempty"`
+	GroupID string `url:"groupId,omitempty"`
+	Group   string `url:"group,omitempty"`
+}
+
+// GetListWithContext returns a list of all available project roles
 //
 // JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-api-3-role-get
-func (s *RoleService) GetList() (*[]Role, *Response, error) {
+func (s *RoleService) GetListWithContext(ctx context.Context) (*[]Role, *Response, error) {
 	apiEndpoint := "rest/api/3/role"
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -53,12 +78,17 @@ func (s *RoleService) GetList() (*[]Role, *Response, error) {
 	return roles, resp, err
 }
 
-// Get retreives a single Role from Jira
+// GetList wraps GetListWithContext using the background context.
+func (s *RoleService) GetList() (*[]Role, *Response, error) {
+	return s.GetListWithContext(context.Background())
+}
+
+// GetWithContext retreives a single Role from Jira
 //
 // JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-api-3-role-id-get
-func (s *RoleService) Get(roleID int) (*Role, *Response, error) {
+func (s *RoleService) GetWithContext(ctx context.Context, roleID int) (*Role, *Response, error) {
 	apiEndpoint := fmt.Sprintf("rest/api/3/role/%d", roleID)
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -74,3 +104,78 @@ func (s *RoleService) Get(roleID int) (*Role, *Response, error) {
 
 	return role, resp, err
 }
+
+// Get wraps GetWithContext using the background context.
+func (s *RoleService) Get(roleID int) (*Role, *Response, error) {
+	return s.GetWithContext(context.Background(), roleID)
+}
+
+// GetDefaultActorsWithContext returns the default actors of the role.
+// The default actors are added to the role of new projects.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-role-actors/#api-rest-api-3-role-id-actors-get
+func (s *RoleService) GetDefaultActorsWithContext(ctx context.Context, roleID int) (*Role, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/3/role/%d/actors", roleID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	role := new(Role)
+	resp, err := s.client.Do(req, role)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return role, resp, nil
+}
+
+// GetDefaultActors wraps GetDefaultActorsWithContext using the background context.
+func (s *RoleService) GetDefaultActors(roleID int) (*Role, *Response, error) {
+	return s.GetDefaultActorsWithContext(context.Background(), roleID)
+}
+
+// AddDefaultActorsWithContext adds users and groups to the default actors of the role
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-role-actors/#api-rest-api-3-role-id-actors-post
+func (s *RoleService) AddDefaultActorsWithContext(ctx context.Context, roleID int, actors *RoleActors) (*Role, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/3/role/%d/actors", roleID)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, actors)
+	if err != nil {
+		return nil, nil, err
+	}
+	role := new(Role)
+	resp, err := s.client.Do(req, role)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return role, resp, nil
+}
+
+// AddDefaultActors wraps AddDefaultActorsWithContext using the background context.
+func (s *RoleService) AddDefaultActors(roleID int, actors *RoleActors) (*Role, *Response, error) {
+	return s.AddDefaultActorsWithContext(context.Background(), roleID, actors)
+}
+
+// RemoveDefaultActorWithContext removes a user or group from the default actors of the role
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-role-actors/#api-rest-api-3-role-id-actors-delete
+func (s *RoleService) RemoveDefaultActorWithContext(ctx context.Context, roleID int, actor *RoleActorOptions) (*Role, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/api/3/role/%d/actors", roleID), actor)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	role := new(Role)
+	resp, err := s.client.Do(req, role)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return role, resp, nil
+}
+
+// RemoveDefaultActor wraps RemoveDefaultActorWithContext using the background context.
+func (s *RoleService) RemoveDefaultActor(roleID int, actor *RoleActorOptions) (*Role, *Response, error) {
+	return s.RemoveDefaultActorWithContext(context.Background(), roleID, actor)
+}
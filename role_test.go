@@ -104,3 +104,63 @@ func TestRoleService_Get(t *testing.T) {
 		t.Errorf("Error given: %s", err)
 	}
 }
+
+func TestRoleService_GetDefaultActors(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/role/10360/actors"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"actors":[{"id":10240,"displayName":"jira-developers","type":"atlassian-group-role-actor","name":"jira-developers","actorGroup":{"name":"jira-developers","displayName":"jira-developers","groupId":"952d12c3-5b5b-4d04-bb32-44d383afc4b2"}}]}`)
+	})
+
+	role, _, err := testClient.Role.GetDefaultActors(10360)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(role.Actors) != 1 || role.Actors[0].ActorGroup == nil || role.Actors[0].ActorGroup.GroupID != "952d12c3-5b5b-4d04-bb32-44d383afc4b2" {
+		t.Errorf("Unexpected actors %+v", role.Actors)
+	}
+}
+
+func TestRoleService_AddDefaultActors(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/role/10360/actors"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEndpoint)
+		body, _ := ioutil.ReadAll(r.Body)
+		if expected := `{"user":["5b10a2844c20165700ede21g"],"groupId":["952d12c3-5b5b-4d04-bb32-44d383afc4b2"]}` + "\n"; string(body) != expected {
+			t.Errorf("Unexpected body %s", body)
+		}
+		fmt.Fprint(w, `{"actors":[{"id":10240,"type":"atlassian-user-role-actor","actorUser":{"accountId":"5b10a2844c20165700ede21g"}}]}`)
+	})
+
+	role, _, err := testClient.Role.AddDefaultActors(10360, &RoleActors{
+		User:    []string{"5b10a2844c20165700ede21g"},
+		GroupID: []string{"952d12c3-5b5b-4d04-bb32-44d383afc4b2"},
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(role.Actors) != 1 {
+		t.Errorf("Unexpected actors %+v", role.Actors)
+	}
+}
+
+func TestRoleService_RemoveDefaultActor(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/role/10360/actors"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, testAPIEndpoint+"?user=5b10a2844c20165700ede21g")
+		fmt.Fprint(w, `{"actors":[]}`)
+	})
+
+	if _, _, err := testClient.Role.RemoveDefaultActor(10360, &RoleActorOptions{User: "5b10a2844c20165700ede21g"}); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
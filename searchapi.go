@@ -0,0 +1,83 @@
+package jira
+
+import (
+	"context"
+	"strings"
+)
+
+// SearchAPI selects the endpoint searches are sent to, see WithSearchAPI
+type SearchAPI int
+
+// These constants are the search endpoints of WithSearchAPI
+const (
+	// SearchAPIAuto uses the token based endpoint on JIRA Cloud, recognized by a host ending with ".atlassian.net",
+	// and the offset based endpoint otherwise
+	SearchAPIAuto SearchAPI = iota
+	// SearchAPIOffset always uses the offset based endpoint rest/api/2/search of JIRA Server and Data Center
+	SearchAPIOffset
+	// SearchAPIToken always uses the token based endpoint rest/api/2/search/jql of JIRA Cloud
+	SearchAPIToken
+)
+
+// WithSearchAPI selects the endpoint of the searches, which do not need to address a page by its offset:
+// SearchPages, SearchStream, SearchIterator, SearchChan, Count and the helpers built on them,
+// e.g. GetFlowMetrics, ExportWatchersAndVoters, GetSprintSummary or CreateIdempotent.
+// The default is SearchAPIAuto. Search and SearchJQL always use their own endpoint.
+//
+// With the token based endpoint, SearchOptions.StartAt issues are skipped by the client,
+// ValidateQuery is ignored and Count returns the approximate count of ApproximateCount.
+func WithSearchAPI(api SearchAPI) ClientOption {
+	return func(c *Client) {
+		c.searchAPI = api
+	}
+}
+
+// useSearchJQL reports whether searches are sent to the token based endpoint
+func (c *Client) useSearchJQL() bool {
+	switch c.searchAPI {
+	case SearchAPIToken:
+		return true
+	case SearchAPIOffset:
+		return false
+	}
+	return strings.HasSuffix(strings.ToLower(c.baseURL.Hostname()), ".atlassian.net")
+}
+
+// jqlOptions converts the options of an offset based search into the options of a token based search.
+// The token based endpoint only returns the issue IDs by default, so all navigable fields are requested
+// if no fields are given, like the offset based endpoint does.
+func (o *SearchOptions) jqlOptions() *SearchJQLOptions {
+	options := &SearchJQLOptions{Fields: []string{"*navigable"}}
+	if o == nil {
+		return options
+	}
+	options.MaxResults = o.MaxResults
+	options.Expand = o.Expand
+	if len(o.Fields) > 0 {
+		options.Fields = o.Fields
+	}
+	return options
+}
+
+// skipIssues returns f, which skips the first n issues before it calls f.
+// It emulates SearchOptions.StartAt for the token based endpoint.
+func skipIssues(n int, f func(Issue) error) func(Issue) error {
+	return func(issue Issue) error {
+		if n > 0 {
+			n--
+			return nil
+		}
+		return f(issue)
+	}
+}
+
+// searchPageWithContext requests one page of a search from the endpoint selected by WithSearchAPI.
+// pageToken is the NextPageToken of the previous page for the token based endpoint, options.StartAt is ignored by it.
+func (s *IssueService) searchPageWithContext(ctx context.Context, jql string, options *SearchOptions, pageToken string) ([]Issue, *Response, error) {
+	if !s.client.useSearchJQL() {
+		return s.SearchWithContext(ctx, jql, options)
+	}
+	jqlOptions := options.jqlOptions()
+	jqlOptions.NextPageToken = pageToken
+	return s.SearchJQLWithContext(ctx, jql, jqlOptions)
+}
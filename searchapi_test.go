@@ -0,0 +1,135 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+// handleSearchJQLPages serves total issues with the keys EX-0, EX-1, ... on /rest/api/2/search/jql.
+// The page token is the offset of the page.
+func handleSearchJQLPages(t *testing.T, total int) {
+	testMux.HandleFunc("/rest/api/2/search/jql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got := r.URL.Query().Get("fields"); got != "*navigable" {
+			t.Errorf("fields = %q, want *navigable", got)
+		}
+		startAt, _ := strconv.Atoi(r.URL.Query().Get("nextPageToken"))
+		maxResults, _ := strconv.Atoi(r.URL.Query().Get("maxResults"))
+		issues := ""
+		for i := startAt; i < startAt+maxResults && i < total; i++ {
+			if issues != "" {
+				issues += ","
+			}
+			issues += fmt.Sprintf(`{"id":"%d","key":"EX-%d"}`, i, i)
+		}
+		if startAt+maxResults >= total {
+			fmt.Fprintf(w, `{"isLast":true,"issues":[%s]}`, issues)
+			return
+		}
+		fmt.Fprintf(w, `{"nextPageToken":"%d","isLast":false,"issues":[%s]}`, startAt+maxResults, issues)
+	})
+}
+
+func TestClient_useSearchJQL(t *testing.T) {
+	tests := []struct {
+		baseURL string
+		options []ClientOption
+		want    bool
+	}{
+		{"https://example.atlassian.net/", nil, true},
+		{"https://EXAMPLE.Atlassian.net:443/", nil, true},
+		{"https://jira.example.com/", nil, false},
+		{"https://example.atlassian.net/", []ClientOption{WithSearchAPI(SearchAPIOffset)}, false},
+		{"https://jira.example.com/", []ClientOption{WithSearchAPI(SearchAPIToken)}, true},
+	}
+	for _, test := range tests {
+		client, err := NewClient(nil, test.baseURL, test.options...)
+		if err != nil {
+			t.Fatalf("Error given: %s", err)
+		}
+		if got := client.useSearchJQL(); got != test.want {
+			t.Errorf("useSearchJQL() for %s = %v, want %v", test.baseURL, got, test.want)
+		}
+	}
+}
+
+func TestIssueService_SearchPages_SearchJQL(t *testing.T) {
+	setup()
+	defer teardown()
+	handleSearchJQLPages(t, 5)
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Unexpected request to the offset based endpoint")
+	})
+
+	client, _ := NewClient(nil, testServer.URL, WithSearchAPI(SearchAPIToken))
+	var keys []string
+	err := client.Issue.SearchPages("project = EX", &SearchOptions{StartAt: 1, MaxResults: 2}, func(issue Issue) error {
+		keys = append(keys, issue.Key)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if want := []string{"EX-1", "EX-2", "EX-3", "EX-4"}; !reflect.DeepEqual(keys, want) {
+		t.Errorf("Keys = %v, want %v", keys, want)
+	}
+}
+
+func TestIssueService_SearchStream_SearchJQL(t *testing.T) {
+	setup()
+	defer teardown()
+	handleSearchJQLPages(t, 3)
+
+	client, _ := NewClient(nil, testServer.URL, WithSearchAPI(SearchAPIToken))
+	var keys []string
+	err := client.Issue.SearchStream("project = EX", &SearchOptions{MaxResults: 2}, func(issue Issue) error {
+		keys = append(keys, issue.Key)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if want := []string{"EX-0", "EX-1", "EX-2"}; !reflect.DeepEqual(keys, want) {
+		t.Errorf("Keys = %v, want %v", keys, want)
+	}
+}
+
+func TestIssueService_SearchIterator_SearchJQL(t *testing.T) {
+	setup()
+	defer teardown()
+	handleSearchJQLPages(t, 5)
+
+	client, _ := NewClient(nil, testServer.URL, WithSearchAPI(SearchAPIToken))
+	it := client.Issue.SearchIterator("project = EX", &SearchOptions{StartAt: 3, MaxResults: 2})
+	var keys []string
+	for it.Next() {
+		keys = append(keys, it.Issue().Key)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if want := []string{"EX-3", "EX-4"}; !reflect.DeepEqual(keys, want) {
+		t.Errorf("Keys = %v, want %v", keys, want)
+	}
+}
+
+func TestIssueService_Count_SearchJQL(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/search/approximate-count", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"count":42}`)
+	})
+
+	client, _ := NewClient(nil, testServer.URL, WithSearchAPI(SearchAPIToken))
+	count, _, err := client.Issue.Count("project = EX")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if count != 42 {
+		t.Errorf("Count = %d, want 42", count)
+	}
+}
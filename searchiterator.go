@@ -0,0 +1,149 @@
+package jira
+
+import (
+	"context"
+)
+
+// SearchIterator walks through all issues of a search, fetching the pages on demand.
+//
+//	it := client.Issue.SearchIterator("project = EX", nil)
+//	for it.Next() {
+//		issue := it.Issue()
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type SearchIterator struct {
+	service *IssueService
+	ctx     context.Context
+	jql     string
+	options SearchOptions
+
+	issues []Issue
+	pos    int
+	total  int
+	done   bool
+	err    error
+
+	// Token of the next page and number of issues still to skip for the token based endpoint
+	nextPageToken string
+	skip          int
+}
+
+// SearchIteratorWithContext returns an iterator over the issues matching jql.
+// The pages are requested with options, starting at options.StartAt and with options.MaxResults issues per page (50 if 0).
+// No request is sent before the first call of Next.
+// The pages are requested from the endpoint selected by WithSearchAPI, like SearchPages.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-search/#api-rest-api-2-search-get
+func (s *IssueService) SearchIteratorWithContext(ctx context.Context, jql string, options *SearchOptions) *SearchIterator {
+	opts := SearchOptions{MaxResults: 50}
+	if options != nil {
+		opts = *options
+		if opts.MaxResults == 0 {
+			opts.MaxResults = 50
+		}
+	}
+	it := &SearchIterator{service: s, ctx: ctx, jql: jql, options: opts, pos: -1}
+	if s.client.useSearchJQL() {
+		it.skip = opts.StartAt
+	}
+	return it
+}
+
+// SearchIterator wraps SearchIteratorWithContext using the background context.
+func (s *IssueService) SearchIterator(jql string, options *SearchOptions) *SearchIterator {
+	return s.SearchIteratorWithContext(context.Background(), jql, options)
+}
+
+// Next advances the iterator to the next issue and fetches the next page if needed.
+// It returns false when all issues have been read or an error occurred, see Err.
+func (it *SearchIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	it.pos++
+	if it.pos < len(it.issues) {
+		return true
+	}
+
+	for !it.done {
+		issues, resp, err := it.service.searchPageWithContext(it.ctx, it.jql, &it.options, it.nextPageToken)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		if it.service.client.useSearchJQL() {
+			if resp.IsLast || resp.NextPageToken == "" {
+				it.done = true
+			}
+			it.nextPageToken = resp.NextPageToken
+			for it.skip > 0 && len(issues) > 0 {
+				issues = issues[1:]
+				it.skip--
+			}
+		} else {
+			it.total = resp.Total
+			if len(issues) == 0 || resp.StartAt+resp.MaxResults >= resp.Total {
+				it.done = true
+			}
+			it.options.StartAt = resp.StartAt + resp.MaxResults
+		}
+		if len(issues) > 0 {
+			it.issues = issues
+			it.pos = 0
+			return true
+		}
+	}
+	return false
+}
+
+// Issue returns the current issue. It is only valid after Next returned true.
+func (it *SearchIterator) Issue() Issue {
+	return it.issues[it.pos]
+}
+
+// Total returns the total number of issues matching the search, as reported with the last page.
+// It is 0 for the token based endpoint, which does not report a total, see ApproximateCount.
+func (it *SearchIterator) Total() int {
+	return it.total
+}
+
+// Err returns the error which stopped the iteration, if any
+func (it *SearchIterator) Err() error {
+	return it.err
+}
+
+// SearchChanWithContext searches for issues like SearchPages and sends all issues of all pages to the returned issue channel.
+// The issue channel is closed when all issues were sent or an error occurred.
+// The error channel receives the error, if any, and is closed afterwards.
+// Cancel ctx to stop the search before all issues were read, otherwise the search blocks until the issues are received.
+func (s *IssueService) SearchChanWithContext(ctx context.Context, jql string, options *SearchOptions) (<-chan Issue, <-chan error) {
+	issues := make(chan Issue)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(errs)
+		defer close(issues)
+
+		err := s.SearchPagesWithContext(ctx, jql, options, func(issue Issue) error {
+			select {
+			case issues <- issue:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+		if err != nil {
+			errs <- err
+		}
+	}()
+
+	return issues, errs
+}
+
+// SearchChan wraps SearchChanWithContext using the background context.
+func (s *IssueService) SearchChan(jql string, options *SearchOptions) (<-chan Issue, <-chan error) {
+	return s.SearchChanWithContext(context.Background(), jql, options)
+}
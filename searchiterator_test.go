@@ -0,0 +1,102 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+// handleSearchPages serves total issues with the keys EX-0, EX-1, ... on /rest/api/2/search
+func handleSearchPages(t *testing.T, total int) {
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		startAt, _ := strconv.Atoi(r.URL.Query().Get("startAt"))
+		maxResults, _ := strconv.Atoi(r.URL.Query().Get("maxResults"))
+		issues := ""
+		for i := startAt; i < startAt+maxResults && i < total; i++ {
+			if issues != "" {
+				issues += ","
+			}
+			issues += fmt.Sprintf(`{"id":"%d","key":"EX-%d"}`, i, i)
+		}
+		fmt.Fprintf(w, `{"startAt":%d,"maxResults":%d,"total":%d,"issues":[%s]}`, startAt, maxResults, total, issues)
+	})
+}
+
+func TestIssueService_SearchIterator(t *testing.T) {
+	setup()
+	defer teardown()
+	handleSearchPages(t, 5)
+
+	options := &SearchOptions{MaxResults: 2}
+	it := testClient.Issue.SearchIterator("project = EX", options)
+	var keys []string
+	for it.Next() {
+		keys = append(keys, it.Issue().Key)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(keys) != 5 || keys[0] != "EX-0" || keys[4] != "EX-4" {
+		t.Errorf("Unexpected issues %v", keys)
+	}
+	if it.Total() != 5 {
+		t.Errorf("Expected a total of 5, got %d", it.Total())
+	}
+	if options.StartAt != 0 {
+		t.Errorf("Expected the options not to be changed, got StartAt %d", options.StartAt)
+	}
+}
+
+func TestIssueService_SearchIterator_Error(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"errorMessages":["Error in the JQL Query"]}`)
+	})
+
+	it := testClient.Issue.SearchIterator("project = ", nil)
+	if it.Next() {
+		t.Error("Expected no issue")
+	}
+	if it.Err() == nil {
+		t.Error("Expected an error")
+	}
+}
+
+func TestIssueService_SearchChan(t *testing.T) {
+	setup()
+	defer teardown()
+	handleSearchPages(t, 5)
+
+	issues, errs := testClient.Issue.SearchChan("project = EX", &SearchOptions{MaxResults: 2})
+	count := 0
+	for range issues {
+		count++
+	}
+	if err := <-errs; err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if count != 5 {
+		t.Errorf("Expected 5 issues, got %d", count)
+	}
+}
+
+func TestIssueService_SearchChanWithContext_Cancel(t *testing.T) {
+	setup()
+	defer teardown()
+	handleSearchPages(t, 100)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	issues, errs := testClient.Issue.SearchChanWithContext(ctx, "project = EX", &SearchOptions{MaxResults: 10})
+	<-issues
+	cancel()
+	for range issues {
+	}
+	if err := <-errs; err != context.Canceled {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
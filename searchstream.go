@@ -0,0 +1,200 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+)
+
+// searchPage holds the paging values of a streamed search response
+type searchPage struct {
+	StartAt       int
+	MaxResults    int
+	Total         int
+	NextPageToken string
+	IsLast        bool
+}
+
+// SearchStreamWithContext searches for issues like SearchPages and calls f for every issue of all pages.
+// Unlike SearchPages, the issues are decoded one by one while the response is read,
+// so the memory usage stays flat even for pages with large issues, e.g. with expanded changelogs.
+// Returning an error from f stops the search and the error is returned.
+// Like SearchPages, it uses the endpoint selected by WithSearchAPI.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-search/#api-rest-api-2-search-get
+func (s *IssueService) SearchStreamWithContext(ctx context.Context, jql string, options *SearchOptions, f func(Issue) error) error {
+	if s.client.useSearchJQL() {
+		var skip int
+		if options != nil {
+			skip = options.StartAt
+		}
+		return s.SearchJQLStreamWithContext(ctx, jql, options.jqlOptions(), skipIssues(skip, f))
+	}
+
+	opts := SearchOptions{MaxResults: 50}
+	if options != nil {
+		opts = *options
+		if opts.MaxResults == 0 {
+			opts.MaxResults = 50
+		}
+	}
+
+	for {
+		u := fmt.Sprintf("rest/api/2/search?jql=%s&maxResults=%d", url.QueryEscape(jql), opts.MaxResults)
+		if opts.StartAt != 0 {
+			u += fmt.Sprintf("&startAt=%d", opts.StartAt)
+		}
+		if opts.Expand != "" {
+			u += fmt.Sprintf("&expand=%s", opts.Expand)
+		}
+		if len(opts.Fields) > 0 {
+			u += fmt.Sprintf("&fields=%s", strings.Join(opts.Fields, ","))
+		}
+		if opts.ValidateQuery != "" {
+			u += fmt.Sprintf("&validateQuery=%s", opts.ValidateQuery)
+		}
+
+		page, count, err := s.streamSearchPage(ctx, u, f)
+		if err != nil {
+			return err
+		}
+		if count == 0 || page.StartAt+page.MaxResults >= page.Total {
+			return nil
+		}
+		opts.StartAt = page.StartAt + page.MaxResults
+	}
+}
+
+// SearchStream wraps SearchStreamWithContext using the background context.
+func (s *IssueService) SearchStream(jql string, options *SearchOptions, f func(Issue) error) error {
+	return s.SearchStreamWithContext(context.Background(), jql, options, f)
+}
+
+// SearchJQLStreamWithContext searches for issues like SearchJQLPages and calls f for every issue of all pages.
+// The issues are decoded one by one while the response is read, see SearchStream.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-search/#api-rest-api-2-search-jql-get
+func (s *IssueService) SearchJQLStreamWithContext(ctx context.Context, jql string, options *SearchJQLOptions, f func(Issue) error) error {
+	opts := SearchJQLOptions{}
+	if options != nil {
+		opts = *options
+	}
+
+	for {
+		u, err := addOptions("rest/api/2/search/jql", &opts)
+		if err != nil {
+			return err
+		}
+		if strings.Contains(u, "?") {
+			u += "&jql=" + url.QueryEscape(jql)
+		} else {
+			u += "?jql=" + url.QueryEscape(jql)
+		}
+
+		page, _, err := s.streamSearchPage(ctx, u, f)
+		if err != nil {
+			return err
+		}
+		if page.IsLast || page.NextPageToken == "" {
+			return nil
+		}
+		opts.NextPageToken = page.NextPageToken
+	}
+}
+
+// SearchJQLStream wraps SearchJQLStreamWithContext using the background context.
+func (s *IssueService) SearchJQLStream(jql string, options *SearchJQLOptions, f func(Issue) error) error {
+	return s.SearchJQLStreamWithContext(context.Background(), jql, options, f)
+}
+
+// streamSearchPage requests one page of a search and calls f for every issue while the response is decoded.
+// It returns the paging values and the number of issues of the page.
+func (s *IssueService) streamSearchPage(ctx context.Context, u string, f func(Issue) error) (*searchPage, int, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, 0, NewJiraError(resp, err)
+	}
+	defer resp.Body.Close()
+
+	return decodeSearchStream(resp.Body, f)
+}
+
+// decodeSearchStream decodes a search response from r token by token,
+// calling f for every element of the issues array as soon as it is decoded
+func decodeSearchStream(r io.Reader, f func(Issue) error) (*searchPage, int, error) {
+	dec := json.NewDecoder(r)
+	if err := expectDelim(dec, '{'); err != nil {
+		return nil, 0, err
+	}
+
+	page := &searchPage{}
+	count := 0
+	for dec.More() {
+		token, err := dec.Token()
+		if err != nil {
+			return nil, count, err
+		}
+		key, ok := token.(string)
+		if !ok {
+			return nil, count, fmt.Errorf("unexpected token %v in search response", token)
+		}
+
+		switch key {
+		case "issues":
+			if err := expectDelim(dec, '['); err != nil {
+				return nil, count, err
+			}
+			for dec.More() {
+				var issue Issue
+				if err := dec.Decode(&issue); err != nil {
+					return nil, count, err
+				}
+				count++
+				if err := f(issue); err != nil {
+					return nil, count, err
+				}
+			}
+			if err := expectDelim(dec, ']'); err != nil {
+				return nil, count, err
+			}
+		case "startAt":
+			err = dec.Decode(&page.StartAt)
+		case "maxResults":
+			err = dec.Decode(&page.MaxResults)
+		case "total":
+			err = dec.Decode(&page.Total)
+		case "nextPageToken":
+			err = dec.Decode(&page.NextPageToken)
+		case "isLast":
+			err = dec.Decode(&page.IsLast)
+		default:
+			var skip json.RawMessage
+			err = dec.Decode(&skip)
+		}
+		if err != nil {
+			return nil, count, err
+		}
+	}
+
+	return page, count, expectDelim(dec, '}')
+}
+
+// expectDelim reads the next token of dec and returns an error if it is not the delimiter d
+func expectDelim(dec *json.Decoder, d json.Delim) error {
+	token, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != d {
+		return fmt.Errorf("expected %s in search response, got %v", d, token)
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package jira
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestIssueService_SearchStream(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.URL.Query().Get("startAt") {
+		case "":
+			testRequestURL(t, r, "/rest/api/2/search?jql=something&maxResults=2&expand=changelog")
+			fmt.Fprint(w, `{"expand":"schema,names","startAt":0,"maxResults":2,"total":3,"issues":[{"id":"1","key":"TEST-1","changelog":{"histories":[]}},{"id":"2","key":"TEST-2"}]}`)
+		case "2":
+			fmt.Fprint(w, `{"expand":"schema,names","startAt":2,"maxResults":2,"total":3,"issues":[{"id":"3","key":"TEST-3"}]}`)
+		default:
+			t.Errorf("Unexpected page %s", r.URL.RawQuery)
+		}
+	})
+
+	var keys []string
+	err := testClient.Issue.SearchStream("something", &SearchOptions{MaxResults: 2, Expand: ExpandChangelog}, func(issue Issue) error {
+		keys = append(keys, issue.Key)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if strings.Join(keys, ",") != "TEST-1,TEST-2,TEST-3" {
+		t.Errorf("Unexpected issues %v", keys)
+	}
+}
+
+func TestIssueService_SearchJQLStream(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/search/jql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if r.URL.Query().Get("nextPageToken") == "" {
+			fmt.Fprint(w, `{"issues":[{"id":"1","key":"TEST-1"}],"nextPageToken":"abc","isLast":false}`)
+			return
+		}
+		fmt.Fprint(w, `{"issues":[{"id":"2","key":"TEST-2"}],"isLast":true}`)
+	})
+
+	var keys []string
+	err := testClient.Issue.SearchJQLStream("project = TEST", nil, func(issue Issue) error {
+		keys = append(keys, issue.Key)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if strings.Join(keys, ",") != "TEST-1,TEST-2" {
+		t.Errorf("Unexpected issues %v", keys)
+	}
+}
+
+func TestIssueService_SearchStream_StopOnError(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"startAt":0,"maxResults":50,"total":2,"issues":[{"key":"TEST-1"},{"key":"TEST-2"}]}`)
+	})
+
+	stop := errors.New("stop")
+	calls := 0
+	err := testClient.Issue.SearchStream("something", nil, func(issue Issue) error {
+		calls++
+		return stop
+	})
+	if err != stop {
+		t.Errorf("Expected stop error, got %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("Expected 1 call, got %d", calls)
+	}
+}
+
+func TestDecodeSearchStream_Invalid(t *testing.T) {
+	_, _, err := decodeSearchStream(strings.NewReader(`[]`), func(Issue) error { return nil })
+	if err == nil {
+		t.Error("Expected error for invalid response")
+	}
+}
@@ -0,0 +1,228 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// SecuritySchemeService handles issue security schemes for the JIRA instance / API.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-security-schemes/
+type SecuritySchemeService struct {
+	client *Client
+}
+
+// SecurityScheme represents an issue security scheme
+type SecurityScheme struct {
+	Self                   string          `json:"self,omitempty" structs:"self,omitempty"`
+	ID                     int64           `json:"id,omitempty" structs:"id,omitempty"`
+	Name                   string          `json:"name" structs:"name"`
+	Description            string          `json:"description,omitempty" structs:"description,omitempty"`
+	DefaultSecurityLevelID int64           `json:"defaultSecurityLevelId,omitempty" structs:"defaultSecurityLevelId,omitempty"`
+	Levels                 []SecurityLevel `json:"levels,omitempty" structs:"levels,omitempty"`
+}
+
+// SecurityLevel represents a security level of an issue security scheme.
+// Members are only used when creating levels.
+type SecurityLevel struct {
+	Self        string                `json:"self,omitempty" structs:"self,omitempty"`
+	ID          string                `json:"id,omitempty" structs:"id,omitempty"`
+	Name        string                `json:"name" structs:"name"`
+	Description string                `json:"description,omitempty" structs:"description,omitempty"`
+	IsDefault   bool                  `json:"isDefault,omitempty" structs:"isDefault,omitempty"`
+	Members     []SecurityLevelMember `json:"members,omitempty" structs:"members,omitempty"`
+}
+
+// SecurityLevelMember is a holder which can see the issues of a security level.
+// Type is one of the HolderType* constants, Parameter identifies the holder, e.g. the group name.
+type SecurityLevelMember struct {
+	Type      string `json:"type" structs:"type"`
+	Parameter string `json:"parameter,omitempty" structs:"parameter,omitempty"`
+}
+
+// GetListWithContext returns all issue security schemes
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-security-schemes/#api-rest-api-2-issuesecurityschemes-get
+func (s *SecuritySchemeService) GetListWithContext(ctx context.Context) ([]SecurityScheme, *Response, error) {
+	apiEndpoint := "rest/api/2/issuesecurityschemes"
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(struct {
+		IssueSecuritySchemes []SecurityScheme `json:"issueSecuritySchemes"`
+	})
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result.IssueSecuritySchemes, resp, nil
+}
+
+// GetList wraps GetListWithContext using the background context.
+func (s *SecuritySchemeService) GetList() ([]SecurityScheme, *Response, error) {
+	return s.GetListWithContext(context.Background())
+}
+
+// GetWithContext returns the issue security scheme with the given ID, including its levels
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-security-schemes/#api-rest-api-2-issuesecurityschemes-id-get
+func (s *SecuritySchemeService) GetWithContext(ctx context.Context, schemeID int64) (*SecurityScheme, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issuesecurityschemes/%d", schemeID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scheme := new(SecurityScheme)
+	resp, err := s.client.Do(req, scheme)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return scheme, resp, nil
+}
+
+// Get wraps GetWithContext using the background context.
+func (s *SecuritySchemeService) Get(schemeID int64) (*SecurityScheme, *Response, error) {
+	return s.GetWithContext(context.Background(), schemeID)
+}
+
+// CreateWithContext creates an issue security scheme, optionally with its levels and their members.
+// The ID of the created scheme is returned.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-security-schemes/#api-rest-api-2-issuesecurityschemes-post
+func (s *SecuritySchemeService) CreateWithContext(ctx context.Context, scheme *SecurityScheme) (int64, *Response, error) {
+	apiEndpoint := "rest/api/2/issuesecurityschemes"
+	payload := struct {
+		Name        string          `json:"name"`
+		Description string          `json:"description,omitempty"`
+		Levels      []SecurityLevel `json:"levels,omitempty"`
+	}{scheme.Name, scheme.Description, scheme.Levels}
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, payload)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	result := new(struct {
+		ID string `json:"id"`
+	})
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return 0, resp, NewJiraError(resp, err)
+	}
+
+	id, err := strconv.ParseInt(result.ID, 10, 64)
+	if err != nil {
+		return 0, resp, err
+	}
+	return id, resp, nil
+}
+
+// Create wraps CreateWithContext using the background context.
+func (s *SecuritySchemeService) Create(scheme *SecurityScheme) (int64, *Response, error) {
+	return s.CreateWithContext(context.Background(), scheme)
+}
+
+// DeleteWithContext deletes the issue security scheme. It must not be associated with any project.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-security-schemes/#api-rest-api-2-issuesecurityschemes-schemeid-delete
+func (s *SecuritySchemeService) DeleteWithContext(ctx context.Context, schemeID int64) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issuesecurityschemes/%d", schemeID)
+	return s.delete(ctx, apiEndpoint)
+}
+
+// Delete wraps DeleteWithContext using the background context.
+func (s *SecuritySchemeService) Delete(schemeID int64) (*Response, error) {
+	return s.DeleteWithContext(context.Background(), schemeID)
+}
+
+// AddLevelsWithContext adds security levels, optionally with their members, to the issue security scheme
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-security-schemes/#api-rest-api-2-issuesecurityschemes-schemeid-level-put
+func (s *SecuritySchemeService) AddLevelsWithContext(ctx context.Context, schemeID int64, levels []SecurityLevel) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issuesecurityschemes/%d/level", schemeID)
+	payload := struct {
+		Levels []SecurityLevel `json:"levels"`
+	}{levels}
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// AddLevels wraps AddLevelsWithContext using the background context.
+func (s *SecuritySchemeService) AddLevels(schemeID int64, levels []SecurityLevel) (*Response, error) {
+	return s.AddLevelsWithContext(context.Background(), schemeID, levels)
+}
+
+// RemoveLevelWithContext removes the security level from the issue security scheme
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-security-schemes/#api-rest-api-2-issuesecurityschemes-schemeid-level-levelid-delete
+func (s *SecuritySchemeService) RemoveLevelWithContext(ctx context.Context, schemeID int64, levelID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issuesecurityschemes/%d/level/%s", schemeID, levelID)
+	return s.delete(ctx, apiEndpoint)
+}
+
+// RemoveLevel wraps RemoveLevelWithContext using the background context.
+func (s *SecuritySchemeService) RemoveLevel(schemeID int64, levelID string) (*Response, error) {
+	return s.RemoveLevelWithContext(context.Background(), schemeID, levelID)
+}
+
+// AddLevelMembersWithContext adds members to the security level of the issue security scheme
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-security-schemes/#api-rest-api-2-issuesecurityschemes-schemeid-level-levelid-member-put
+func (s *SecuritySchemeService) AddLevelMembersWithContext(ctx context.Context, schemeID int64, levelID string, members []SecurityLevelMember) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issuesecurityschemes/%d/level/%s/member", schemeID, levelID)
+	payload := struct {
+		Members []SecurityLevelMember `json:"members"`
+	}{members}
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// AddLevelMembers wraps AddLevelMembersWithContext using the background context.
+func (s *SecuritySchemeService) AddLevelMembers(schemeID int64, levelID string, members []SecurityLevelMember) (*Response, error) {
+	return s.AddLevelMembersWithContext(context.Background(), schemeID, levelID, members)
+}
+
+// RemoveLevelMemberWithContext removes the member with the given ID from the security level of the issue security scheme
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-security-schemes/#api-rest-api-2-issuesecurityschemes-schemeid-level-levelid-member-memberid-delete
+func (s *SecuritySchemeService) RemoveLevelMemberWithContext(ctx context.Context, schemeID int64, levelID, memberID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issuesecurityschemes/%d/level/%s/member/%s", schemeID, levelID, memberID)
+	return s.delete(ctx, apiEndpoint)
+}
+
+// RemoveLevelMember wraps RemoveLevelMemberWithContext using the background context.
+func (s *SecuritySchemeService) RemoveLevelMember(schemeID int64, levelID, memberID string) (*Response, error) {
+	return s.RemoveLevelMemberWithContext(context.Background(), schemeID, levelID, memberID)
+}
+
+func (s *SecuritySchemeService) delete(ctx context.Context, apiEndpoint string) (*Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,116 @@
+package jira
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestSecuritySchemeService_GetList(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/issuesecurityschemes"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"issueSecuritySchemes":[{"self":"https://example.atlassian.net/rest/api/2/issuesecurityschemes/10000","id":10000,"name":"Default Issue Security Scheme","description":"Description for the default issue security scheme","defaultSecurityLevelId":10021}]}`)
+	})
+
+	schemes, _, err := testClient.SecurityScheme.GetList()
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(schemes) != 1 || schemes[0].DefaultSecurityLevelID != 10021 {
+		t.Errorf("Unexpected schemes %+v", schemes)
+	}
+}
+
+func TestSecuritySchemeService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/issuesecurityschemes"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEndpoint)
+		body, _ := ioutil.ReadAll(r.Body)
+		expected := `{"name":"Confidential","levels":[{"name":"Staff","isDefault":true,"members":[{"type":"group","parameter":"staff"}]}]}` + "\n"
+		if string(body) != expected {
+			t.Errorf("Unexpected body %s", body)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"10001"}`)
+	})
+
+	id, _, err := testClient.SecurityScheme.Create(&SecurityScheme{
+		Name: "Confidential",
+		Levels: []SecurityLevel{{
+			Name:      "Staff",
+			IsDefault: true,
+			Members:   []SecurityLevelMember{{Type: HolderTypeGroup, Parameter: "staff"}},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if id != 10001 {
+		t.Errorf("Expected ID 10001. Got %d", id)
+	}
+}
+
+func TestSecuritySchemeService_AddLevels(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/issuesecurityschemes/10001/level"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, testAPIEndpoint)
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"levels":[{"name":"Management"}]}`+"\n" {
+			t.Errorf("Unexpected body %s", body)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.SecurityScheme.AddLevels(10001, []SecurityLevel{{Name: "Management"}}); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestSecuritySchemeService_AddLevelMembers(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/issuesecurityschemes/10001/level/10020/member"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, testAPIEndpoint)
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"members":[{"type":"reporter"},{"type":"projectRole","parameter":"10002"}]}`+"\n" {
+			t.Errorf("Unexpected body %s", body)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.SecurityScheme.AddLevelMembers(10001, "10020", []SecurityLevelMember{
+		{Type: HolderTypeReporter},
+		{Type: HolderTypeProjectRole, Parameter: "10002"},
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestSecuritySchemeService_RemoveLevelMember(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/issuesecurityschemes/10001/level/10020/member/10100"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, testAPIEndpoint)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.SecurityScheme.RemoveLevelMember(10001, "10020", "10100"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
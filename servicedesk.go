@@ -0,0 +1,125 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// ServiceDeskService handles the service desks, requests and queues of JIRA Service Management.
+// The API is served under "rest/servicedeskapi", its pages are described by the PageInfo of the Response.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/service-desk/rest/intro/
+type ServiceDeskService struct {
+	client *Client
+}
+
+// ServiceDeskPageOptions specifies the pagination of the list methods of the ServiceDeskService
+type ServiceDeskPageOptions struct {
+	Start int `url:"start,omitempty"`
+	Limit int `url:"limit,omitempty"`
+}
+
+// SLADuration is a duration of an SLA cycle
+type SLADuration struct {
+	Millis   int64  `json:"millis" structs:"millis"`
+	Friendly string `json:"friendly,omitempty" structs:"friendly,omitempty"`
+}
+
+// Duration returns the duration as time.Duration
+func (d SLADuration) Duration() time.Duration {
+	return time.Duration(d.Millis) * time.Millisecond
+}
+
+// SLADateTime is a point in time of an SLA cycle
+type SLADateTime struct {
+	ISO8601     string `json:"iso8601,omitempty" structs:"iso8601,omitempty"`
+	Jira        string `json:"jira,omitempty" structs:"jira,omitempty"`
+	Friendly    string `json:"friendly,omitempty" structs:"friendly,omitempty"`
+	EpochMillis int64  `json:"epochMillis" structs:"epochMillis"`
+}
+
+// Time returns the point in time as time.Time
+func (d SLADateTime) Time() time.Time {
+	return time.Unix(0, d.EpochMillis*int64(time.Millisecond))
+}
+
+// SLACycle is a single cycle of an SLA, from the start to the stop of the clock.
+// RemainingTime is negative if the goal was breached.
+type SLACycle struct {
+	StartTime           *SLADateTime `json:"startTime,omitempty" structs:"startTime,omitempty"`
+	StopTime            *SLADateTime `json:"stopTime,omitempty" structs:"stopTime,omitempty"`
+	BreachTime          *SLADateTime `json:"breachTime,omitempty" structs:"breachTime,omitempty"`
+	Breached            bool         `json:"breached" structs:"breached"`
+	Paused              bool         `json:"paused,omitempty" structs:"paused,omitempty"`
+	WithinCalendarHours bool         `json:"withinCalendarHours,omitempty" structs:"withinCalendarHours,omitempty"`
+	GoalDuration        SLADuration  `json:"goalDuration" structs:"goalDuration"`
+	ElapsedTime         SLADuration  `json:"elapsedTime" structs:"elapsedTime"`
+	RemainingTime       SLADuration  `json:"remainingTime" structs:"remainingTime"`
+}
+
+// SLAInformation is an SLA of a customer request with its cycles
+type SLAInformation struct {
+	ID              string     `json:"id" structs:"id"`
+	Name            string     `json:"name" structs:"name"`
+	OngoingCycle    *SLACycle  `json:"ongoingCycle,omitempty" structs:"ongoingCycle,omitempty"`
+	CompletedCycles []SLACycle `json:"completedCycles,omitempty" structs:"completedCycles,omitempty"`
+}
+
+// GetQueueIssuesWithContext returns a page of the issues in the queue queueID of the service desk serviceDeskID.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-servicedesk/#api-rest-servicedeskapi-servicedesk-servicedeskid-queue-queueid-issue-get
+func (s *ServiceDeskService) GetQueueIssuesWithContext(ctx context.Context, serviceDeskID, queueID string, options *ServiceDeskPageOptions) ([]Issue, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/servicedeskapi/servicedesk/%s/queue/%s/issue", serviceDeskID, queueID), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(struct {
+		pageValues
+		Values []Issue `json:"values"`
+	})
+	resp, err := s.client.Do(req, page)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return page.Values, resp, nil
+}
+
+// GetQueueIssues wraps GetQueueIssuesWithContext using the background context.
+func (s *ServiceDeskService) GetQueueIssues(serviceDeskID, queueID string, options *ServiceDeskPageOptions) ([]Issue, *Response, error) {
+	return s.GetQueueIssuesWithContext(context.Background(), serviceDeskID, queueID, options)
+}
+
+// GetRequestSLAsWithContext returns a page of the SLAs of the customer request issueID.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-request/#api-rest-servicedeskapi-request-issueidorkey-sla-get
+func (s *ServiceDeskService) GetRequestSLAsWithContext(ctx context.Context, issueID string, options *ServiceDeskPageOptions) ([]SLAInformation, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/servicedeskapi/request/%s/sla", issueID), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(struct {
+		pageValues
+		Values []SLAInformation `json:"values"`
+	})
+	resp, err := s.client.Do(req, page)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return page.Values, resp, nil
+}
+
+// GetRequestSLAs wraps GetRequestSLAsWithContext using the background context.
+func (s *ServiceDeskService) GetRequestSLAs(issueID string, options *ServiceDeskPageOptions) ([]SLAInformation, *Response, error) {
+	return s.GetRequestSLAsWithContext(context.Background(), issueID, options)
+}
@@ -0,0 +1,59 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestServiceDeskService_GetQueueIssues(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/servicedeskapi/servicedesk/1/queue/10/issue", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/servicedeskapi/servicedesk/1/queue/10/issue?limit=2&start=2")
+		fmt.Fprint(w, `{"size":2,"start":2,"limit":2,"isLastPage":false,"values":[{"id":"10010","key":"SD-3"},{"id":"10011","key":"SD-4"}]}`)
+	})
+
+	issues, resp, err := testClient.ServiceDesk.GetQueueIssues("1", "10", &ServiceDeskPageOptions{Start: 2, Limit: 2})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(issues) != 2 || issues[1].Key != "SD-4" {
+		t.Errorf("Unexpected issues %+v", issues)
+	}
+	if resp.StartAt != 2 || resp.MaxResults != 2 || resp.IsLast {
+		t.Errorf("Unexpected page %+v", resp.PageInfo)
+	}
+}
+
+func TestServiceDeskService_GetRequestSLAs(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/servicedeskapi/request/SD-3/sla", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/servicedeskapi/request/SD-3/sla")
+		fmt.Fprint(w, `{"size":1,"start":0,"limit":50,"isLastPage":true,"values":[{"id":"1","name":"Time to first response",
+			"ongoingCycle":{"startTime":{"iso8601":"2020-01-01T10:00:00+0000","epochMillis":1577872800000},
+			"breachTime":{"iso8601":"2020-01-01T14:00:00+0000","epochMillis":1577887200000},
+			"breached":false,"paused":false,"withinCalendarHours":true,
+			"goalDuration":{"millis":14400000,"friendly":"4h"},"elapsedTime":{"millis":3600000,"friendly":"1h"},
+			"remainingTime":{"millis":10800000,"friendly":"3h"}}}]}`)
+	})
+
+	slas, _, err := testClient.ServiceDesk.GetRequestSLAs("SD-3", nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(slas) != 1 || slas[0].OngoingCycle == nil {
+		t.Fatalf("Unexpected SLAs %+v", slas)
+	}
+	cycle := slas[0].OngoingCycle
+	if cycle.RemainingTime.Duration() != 3*time.Hour {
+		t.Errorf("Expected 3h remaining, got %s", cycle.RemainingTime.Duration())
+	}
+	if !cycle.BreachTime.Time().Equal(time.Date(2020, 1, 1, 14, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected breach time %s", cycle.BreachTime.Time())
+	}
+}
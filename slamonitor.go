@@ -0,0 +1,172 @@
+package jira
+
+import (
+	"context"
+	"time"
+)
+
+// These constants are the kinds of an SLAEvent
+const (
+	// SLAEventApproaching is sent when the remaining time of an ongoing cycle falls below the threshold
+	SLAEventApproaching = "approaching"
+	// SLAEventBreached is sent when an ongoing cycle is breached
+	SLAEventBreached = "breached"
+)
+
+// SLAEvent reports an ongoing SLA cycle of a customer request, which approaches or passed its breach time
+type SLAEvent struct {
+	Kind     string
+	IssueKey string
+	SLA      SLAInformation
+	// Remaining is the remaining time of the ongoing cycle, it is negative for breached cycles
+	Remaining time.Duration
+}
+
+// SLAMonitorOptions configures the SLA monitor of MonitorSLAs
+type SLAMonitorOptions struct {
+	// Interval is the time between two polls of the queue, 1 minute if 0
+	Interval time.Duration
+	// Threshold is the remaining time below which an SLAEventApproaching is sent
+	Threshold time.Duration
+	// SLANames restricts the monitor to the SLAs with these names, all SLAs are monitored if empty
+	SLANames []string
+}
+
+// MonitorSLAsWithContext polls the SLAs of the requests in the queue queueID of the service desk serviceDeskID
+// and calls f for every ongoing cycle, which approaches its breach time or is breached.
+// Paused cycles are ignored. Every kind of event is sent once per cycle, as long as the monitor runs.
+//
+// The queue is polled immediately and then every options.Interval, until ctx is done or a request fails.
+// The error of ctx or of the request is returned.
+func (s *ServiceDeskService) MonitorSLAsWithContext(ctx context.Context, serviceDeskID, queueID string, options *SLAMonitorOptions, f func(SLAEvent)) error {
+	opts := SLAMonitorOptions{}
+	if options != nil {
+		opts = *options
+	}
+	if opts.Interval == 0 {
+		opts.Interval = time.Minute
+	}
+	names := map[string]bool{}
+	for _, name := range opts.SLANames {
+		names[name] = true
+	}
+
+	sent := map[string]bool{}
+	ticker := time.NewTicker(opts.Interval)
+	defer ticker.Stop()
+	for {
+		events, err := s.pollSLAs(ctx, serviceDeskID, queueID, opts.Threshold, names)
+		if err != nil {
+			return err
+		}
+
+		// Events of cycles, which are not reported anymore, are forgotten
+		seen := map[string]bool{}
+		for _, event := range events {
+			key := slaEventKey(event)
+			seen[key] = true
+			if !sent[key] {
+				f(event)
+			}
+		}
+		sent = seen
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// MonitorSLAs wraps MonitorSLAsWithContext using the background context.
+func (s *ServiceDeskService) MonitorSLAs(serviceDeskID, queueID string, options *SLAMonitorOptions, f func(SLAEvent)) error {
+	return s.MonitorSLAsWithContext(context.Background(), serviceDeskID, queueID, options, f)
+}
+
+// MonitorSLAsChanWithContext runs MonitorSLAsWithContext in a goroutine and sends the events to the returned event channel.
+// The event channel is closed when the monitor stopped, the error channel receives the error and is closed afterwards.
+// Cancel ctx to stop the monitor.
+func (s *ServiceDeskService) MonitorSLAsChanWithContext(ctx context.Context, serviceDeskID, queueID string, options *SLAMonitorOptions) (<-chan SLAEvent, <-chan error) {
+	events := make(chan SLAEvent)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(errs)
+		defer close(events)
+
+		errs <- s.MonitorSLAsWithContext(ctx, serviceDeskID, queueID, options, func(event SLAEvent) {
+			select {
+			case events <- event:
+			case <-ctx.Done():
+			}
+		})
+	}()
+
+	return events, errs
+}
+
+// MonitorSLAsChan wraps MonitorSLAsChanWithContext using the background context.
+func (s *ServiceDeskService) MonitorSLAsChan(serviceDeskID, queueID string, options *SLAMonitorOptions) (<-chan SLAEvent, <-chan error) {
+	return s.MonitorSLAsChanWithContext(context.Background(), serviceDeskID, queueID, options)
+}
+
+// pollSLAs returns the events of all requests in the queue
+func (s *ServiceDeskService) pollSLAs(ctx context.Context, serviceDeskID, queueID string, threshold time.Duration, names map[string]bool) ([]SLAEvent, error) {
+	events := []SLAEvent{}
+	options := &ServiceDeskPageOptions{}
+	for {
+		issues, resp, err := s.GetQueueIssuesWithContext(ctx, serviceDeskID, queueID, options)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, issue := range issues {
+			slas, _, err := s.GetRequestSLAsWithContext(ctx, issue.Key, nil)
+			if err != nil {
+				return nil, err
+			}
+			for _, sla := range slas {
+				if len(names) > 0 && !names[sla.Name] {
+					continue
+				}
+				if event, ok := slaEvent(issue.Key, sla, threshold); ok {
+					events = append(events, event)
+				}
+			}
+		}
+
+		if len(issues) == 0 || resp.IsLast {
+			return events, nil
+		}
+		options.Start += len(issues)
+	}
+}
+
+// slaEvent returns the event of the ongoing cycle of sla, if it is breached or its remaining time is below threshold
+func slaEvent(issueKey string, sla SLAInformation, threshold time.Duration) (SLAEvent, bool) {
+	cycle := sla.OngoingCycle
+	if cycle == nil || cycle.Paused {
+		return SLAEvent{}, false
+	}
+
+	event := SLAEvent{IssueKey: issueKey, SLA: sla, Remaining: cycle.RemainingTime.Duration()}
+	switch {
+	case cycle.Breached:
+		event.Kind = SLAEventBreached
+	case event.Remaining <= threshold:
+		event.Kind = SLAEventApproaching
+	default:
+		return SLAEvent{}, false
+	}
+	return event, true
+}
+
+// slaEventKey identifies the event of a cycle, to send it only once
+func slaEventKey(event SLAEvent) string {
+	key := event.Kind + "/" + event.IssueKey + "/" + event.SLA.ID
+	if start := event.SLA.OngoingCycle.StartTime; start != nil {
+		key += "/" + start.ISO8601
+	}
+	return key
+}
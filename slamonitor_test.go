@@ -0,0 +1,92 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"testing"
+	"time"
+)
+
+func handleSLAMonitor(t *testing.T, cancel func()) {
+	polls := 0
+	testMux.HandleFunc("/rest/servicedeskapi/servicedesk/1/queue/10/issue", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if r.URL.Query().Get("start") == "2" {
+			fmt.Fprint(w, `{"size":1,"start":2,"limit":2,"isLastPage":true,"values":[{"key":"SD-3"}]}`)
+			return
+		}
+		polls++
+		if polls == 3 {
+			cancel()
+		}
+		fmt.Fprint(w, `{"size":2,"start":0,"limit":2,"isLastPage":false,"values":[{"key":"SD-1"},{"key":"SD-2"}]}`)
+	})
+	slas := map[string]string{
+		"SD-1": `{"breached":true,"remainingTime":{"millis":-60000}}`,
+		"SD-2": `{"breached":false,"remainingTime":{"millis":600000}}`,
+		"SD-3": `{"breached":false,"paused":true,"remainingTime":{"millis":60000}}`,
+	}
+	for key, cycle := range slas {
+		cycle := cycle
+		testMux.HandleFunc("/rest/servicedeskapi/request/"+key+"/sla", func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "GET")
+			fmt.Fprintf(w, `{"isLastPage":true,"values":[{"id":"1","name":"Time to resolution","ongoingCycle":%s},
+				{"id":"2","name":"Time to first response","completedCycles":[{"breached":false}]}]}`, cycle)
+		})
+	}
+}
+
+func TestServiceDeskService_MonitorSLAs(t *testing.T) {
+	setup()
+	defer teardown()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	handleSLAMonitor(t, cancel)
+
+	events := []SLAEvent{}
+	options := &SLAMonitorOptions{Interval: time.Millisecond, Threshold: 15 * time.Minute}
+	err := testClient.ServiceDesk.MonitorSLAsWithContext(ctx, "1", "10", options, func(event SLAEvent) {
+		events = append(events, event)
+	})
+	if err == nil {
+		t.Error("Expected the error of the cancelled context")
+	}
+
+	if len(events) != 2 {
+		t.Fatalf("Expected 2 events, got %+v", events)
+	}
+	sort.Slice(events, func(i, j int) bool { return events[i].IssueKey < events[j].IssueKey })
+	if events[0].IssueKey != "SD-1" || events[0].Kind != SLAEventBreached || events[0].Remaining != -time.Minute {
+		t.Errorf("Unexpected event %+v", events[0])
+	}
+	if events[1].IssueKey != "SD-2" || events[1].Kind != SLAEventApproaching || events[1].SLA.Name != "Time to resolution" {
+		t.Errorf("Unexpected event %+v", events[1])
+	}
+}
+
+func TestServiceDeskService_MonitorSLAsChan(t *testing.T) {
+	setup()
+	defer teardown()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	handleSLAMonitor(t, func() {})
+
+	options := &SLAMonitorOptions{Interval: time.Millisecond, Threshold: 15 * time.Minute, SLANames: []string{"Time to resolution"}}
+	events, errs := testClient.ServiceDesk.MonitorSLAsChanWithContext(ctx, "1", "10", options)
+	keys := []string{}
+	for event := range events {
+		keys = append(keys, event.IssueKey)
+		if len(keys) == 2 {
+			cancel()
+		}
+	}
+	if err := <-errs; err == nil {
+		t.Error("Expected the error of the cancelled context")
+	}
+	sort.Strings(keys)
+	if len(keys) != 2 || keys[0] != "SD-1" || keys[1] != "SD-2" {
+		t.Errorf("Unexpected events %v", keys)
+	}
+}
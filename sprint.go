@@ -1,7 +1,9 @@
 package jira
 
 import (
+	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/go-querystring/query"
 )
@@ -17,22 +19,85 @@ type IssuesWrapper struct {
 	Issues []string `json:"issues"`
 }
 
+// These constants are the states of a sprint
+const (
+	SprintStateFuture = "future"
+	SprintStateActive = "active"
+	SprintStateClosed = "closed"
+)
+
+// SprintCreatePayload is the payload to create a sprint.
+// Name and OriginBoardID are required, the dates can be set later when the sprint is started.
+type SprintCreatePayload struct {
+	Name          string     `json:"name"`
+	OriginBoardID int        `json:"originBoardId"`
+	StartDate     *time.Time `json:"startDate,omitempty"`
+	EndDate       *time.Time `json:"endDate,omitempty"`
+	Goal          string     `json:"goal,omitempty"`
+}
+
+// SprintUpdatePayload is the payload of a partial update of a sprint, only the set values are changed.
+// A sprint is started by setting State to SprintStateActive together with the dates,
+// and completed by setting State to SprintStateClosed.
+type SprintUpdatePayload struct {
+	Name         string     `json:"name,omitempty"`
+	State        string     `json:"state,omitempty"`
+	StartDate    *time.Time `json:"startDate,omitempty"`
+	EndDate      *time.Time `json:"endDate,omitempty"`
+	CompleteDate *time.Time `json:"completeDate,omitempty"`
+	Goal         string     `json:"goal,omitempty"`
+}
+
 // IssuesInSprintResult represents a wrapper struct for search result
 type IssuesInSprintResult struct {
-	Issues []Issue `json:"issues"`
+	StartAt    int     `json:"startAt"`
+	MaxResults int     `json:"maxResults"`
+	Total      int     `json:"total"`
+	Issues     []Issue `json:"issues"`
+}
+
+func (l *IssuesInSprintResult) pageInfo() PageInfo {
+	return PageInfo{StartAt: l.StartAt, MaxResults: l.MaxResults, Total: l.Total}
 }
 
-// MoveIssuesToSprint moves issues to a sprint, for a given sprint Id.
+// GetWithContext returns the sprint for the given sprint ID.
+// The sprint will only be returned if the user can view the board that the sprint was created on,
+// or view at least one of the issues in the sprint.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/sprint-getSprint
+func (s *SprintService) GetWithContext(ctx context.Context, sprintID int) (*Sprint, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/sprint/%d", sprintID)
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sprint := new(Sprint)
+	resp, err := s.client.Do(req, sprint)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return sprint, resp, nil
+}
+
+// Get wraps GetWithContext using the background context.
+func (s *SprintService) Get(sprintID int) (*Sprint, *Response, error) {
+	return s.GetWithContext(context.Background(), sprintID)
+}
+
+// MoveIssuesToSprintWithContext moves issues to a sprint, for a given sprint Id.
 // Issues can only be moved to open or active sprints.
 // The maximum number of issues that can be moved in one operation is 50.
 //
 // JIRA API docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/sprint-moveIssuesToSprint
-func (s *SprintService) MoveIssuesToSprint(sprintID int, issueIDs []string) (*Response, error) {
+func (s *SprintService) MoveIssuesToSprintWithContext(ctx context.Context, sprintID int, issueIDs []string) (*Response, error) {
 	apiEndpoint := fmt.Sprintf("rest/agile/1.0/sprint/%d/issue", sprintID)
 
 	payload := IssuesWrapper{Issues: issueIDs}
 
-	req, err := s.client.NewRequest("POST", apiEndpoint, payload)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, payload)
 
 	if err != nil {
 		return nil, err
@@ -45,15 +110,20 @@ func (s *SprintService) MoveIssuesToSprint(sprintID int, issueIDs []string) (*Re
 	return resp, err
 }
 
-// GetIssuesForSprint returns all issues in a sprint, for a given sprint Id.
+// MoveIssuesToSprint wraps MoveIssuesToSprintWithContext using the background context.
+func (s *SprintService) MoveIssuesToSprint(sprintID int, issueIDs []string) (*Response, error) {
+	return s.MoveIssuesToSprintWithContext(context.Background(), sprintID, issueIDs)
+}
+
+// GetIssuesForSprintWithContext returns all issues in a sprint, for a given sprint Id.
 // This only includes issues that the user has permission to view.
 // By default, the returned issues are ordered by rank.
 //
 //  JIRA API Docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/sprint-getIssuesForSprint
-func (s *SprintService) GetIssuesForSprint(sprintID int) ([]Issue, *Response, error) {
+func (s *SprintService) GetIssuesForSprintWithContext(ctx context.Context, sprintID int) ([]Issue, *Response, error) {
 	apiEndpoint := fmt.Sprintf("rest/agile/1.0/sprint/%d/issue", sprintID)
 
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 
 	if err != nil {
 		return nil, nil, err
@@ -68,7 +138,12 @@ func (s *SprintService) GetIssuesForSprint(sprintID int) ([]Issue, *Response, er
 	return result.Issues, resp, err
 }
 
-// GetIssue returns a full representation of the issue for the given issue key.
+// GetIssuesForSprint wraps GetIssuesForSprintWithContext using the background context.
+func (s *SprintService) GetIssuesForSprint(sprintID int) ([]Issue, *Response, error) {
+	return s.GetIssuesForSprintWithContext(context.Background(), sprintID)
+}
+
+// GetIssueWithContext returns a full representation of the issue for the given issue key.
 // JIRA will attempt to identify the issue by the issueIdOrKey path parameter.
 // This can be an issue id, or an issue key.
 // If the issue cannot be found via an exact match, JIRA will also look for the issue in a case-insensitive way, or by looking to see if the issue was moved.
@@ -78,10 +153,10 @@ func (s *SprintService) GetIssuesForSprint(sprintID int) ([]Issue, *Response, er
 // JIRA API docs: https://docs.atlassian.com/jira-software/REST/7.3.1/#agile/1.0/issue-getIssue
 //
 // TODO: create agile service for holding all agile apis' implementation
-func (s *SprintService) GetIssue(issueID string, options *GetQueryOptions) (*Issue, *Response, error) {
+func (s *SprintService) GetIssueWithContext(ctx context.Context, issueID string, options *GetQueryOptions) (*Issue, *Response, error) {
 	apiEndpoint := fmt.Sprintf("rest/agile/1.0/issue/%s", issueID)
 
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 
 	if err != nil {
 		return nil, nil, err
@@ -105,3 +180,92 @@ func (s *SprintService) GetIssue(issueID string, options *GetQueryOptions) (*Iss
 
 	return issue, resp, nil
 }
+
+// GetIssue wraps GetIssueWithContext using the background context.
+func (s *SprintService) GetIssue(issueID string, options *GetQueryOptions) (*Issue, *Response, error) {
+	return s.GetIssueWithContext(context.Background(), issueID, options)
+}
+
+// CreateWithContext creates a future sprint on the board payload.OriginBoardID.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/sprint-createSprint
+func (s *SprintService) CreateWithContext(ctx context.Context, payload *SprintCreatePayload) (*Sprint, *Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "POST", "rest/agile/1.0/sprint", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sprint := new(Sprint)
+	resp, err := s.client.Do(req, sprint)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return sprint, resp, nil
+}
+
+// Create wraps CreateWithContext using the background context.
+func (s *SprintService) Create(payload *SprintCreatePayload) (*Sprint, *Response, error) {
+	return s.CreateWithContext(context.Background(), payload)
+}
+
+// UpdateWithContext changes the values of payload on the sprint, the other values are kept.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/sprint-partiallyUpdateSprint
+func (s *SprintService) UpdateWithContext(ctx context.Context, sprintID int, payload *SprintUpdatePayload) (*Sprint, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/sprint/%d", sprintID)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sprint := new(Sprint)
+	resp, err := s.client.Do(req, sprint)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return sprint, resp, nil
+}
+
+// Update wraps UpdateWithContext using the background context.
+func (s *SprintService) Update(sprintID int, payload *SprintUpdatePayload) (*Sprint, *Response, error) {
+	return s.UpdateWithContext(context.Background(), sprintID, payload)
+}
+
+// StartWithContext starts the future sprint with the given start and end date.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/sprint-partiallyUpdateSprint
+func (s *SprintService) StartWithContext(ctx context.Context, sprintID int, startDate, endDate time.Time) (*Sprint, *Response, error) {
+	return s.UpdateWithContext(ctx, sprintID, &SprintUpdatePayload{State: SprintStateActive, StartDate: &startDate, EndDate: &endDate})
+}
+
+// Start wraps StartWithContext using the background context.
+func (s *SprintService) Start(sprintID int, startDate, endDate time.Time) (*Sprint, *Response, error) {
+	return s.StartWithContext(context.Background(), sprintID, startDate, endDate)
+}
+
+// CompleteWithContext closes the active sprint. Unresolved issues are not moved, move them
+// to the next sprint with MoveIssuesToSprint before, or they are moved to the backlog.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/sprint-partiallyUpdateSprint
+func (s *SprintService) CompleteWithContext(ctx context.Context, sprintID int) (*Sprint, *Response, error) {
+	return s.UpdateWithContext(ctx, sprintID, &SprintUpdatePayload{State: SprintStateClosed})
+}
+
+// Complete wraps CompleteWithContext using the background context.
+func (s *SprintService) Complete(sprintID int) (*Sprint, *Response, error) {
+	return s.CompleteWithContext(context.Background(), sprintID)
+}
+
+// GetIssuesForSprintPagesWithContext calls f for all issues of all pages of the issues in a sprint.
+// In contrast to GetIssuesForSprint, which only returns the first page, all issues are returned.
+// Returning an error from f stops the paging and the error is returned.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/sprint-getIssuesForSprint
+func (s *SprintService) GetIssuesForSprintPagesWithContext(ctx context.Context, sprintID int, options *BoardIssuesOptions, f func(Issue) error) error {
+	return s.client.Board.issuePages(ctx, fmt.Sprintf("rest/agile/1.0/sprint/%d/issue", sprintID), options, f)
+}
+
+// GetIssuesForSprintPages wraps GetIssuesForSprintPagesWithContext using the background context.
+func (s *SprintService) GetIssuesForSprintPages(sprintID int, options *BoardIssuesOptions, f func(Issue) error) error {
+	return s.GetIssuesForSprintPagesWithContext(context.Background(), sprintID, options, f)
+}
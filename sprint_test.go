@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestSprintService_MoveIssuesToSprint(t *testing.T) {
@@ -94,3 +95,101 @@ func TestSprintService_GetIssue(t *testing.T) {
 	}
 
 }
+
+func TestSprintService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testAPIEndpoint := "/rest/agile/1.0/sprint/37"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"id":37,"self":"https://example.atlassian.net/rest/agile/1.0/sprint/37","state":"closed","name":"sprint 1","startDate":"2015-04-11T15:22:00.000+10:00","endDate":"2015-04-20T01:22:00.000+10:00","completeDate":"2015-04-20T11:04:00.000+10:00","originBoardId":5}`)
+	})
+
+	sprint, _, err := testClient.Sprint.Get(37)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if sprint == nil {
+		t.Fatal("Expected sprint. Sprint is nil")
+	}
+	if sprint.ID != 37 || sprint.State != "closed" || sprint.CompleteDate == nil {
+		t.Errorf("Unexpected sprint %+v", sprint)
+	}
+}
+
+func TestSprintService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/agile/1.0/sprint", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		b, _ := ioutil.ReadAll(r.Body)
+		if string(b) != `{"name":"Sprint 2","originBoardId":5,"goal":"Ship it"}`+"\n" {
+			t.Errorf("Unexpected body %s", b)
+		}
+		fmt.Fprint(w, `{"id":37,"self":"http://www.example.com/jira/rest/agile/1.0/sprint/37","state":"future","name":"Sprint 2","originBoardId":5,"goal":"Ship it"}`)
+	})
+
+	sprint, _, err := testClient.Sprint.Create(&SprintCreatePayload{Name: "Sprint 2", OriginBoardID: 5, Goal: "Ship it"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if sprint.ID != 37 || sprint.State != SprintStateFuture || sprint.Goal != "Ship it" {
+		t.Errorf("Unexpected sprint %+v", sprint)
+	}
+}
+
+func TestSprintService_StartAndComplete(t *testing.T) {
+	setup()
+	defer teardown()
+	var bodies []string
+	testMux.HandleFunc("/rest/agile/1.0/sprint/37", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		b, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		fmt.Fprint(w, `{"id":37,"state":"active","name":"Sprint 2"}`)
+	})
+
+	start := time.Date(2020, 5, 18, 9, 0, 0, 0, time.UTC)
+	if _, _, err := testClient.Sprint.Start(37, start, start.AddDate(0, 0, 14)); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if _, _, err := testClient.Sprint.Complete(37); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	expected := []string{
+		`{"state":"active","startDate":"2020-05-18T09:00:00Z","endDate":"2020-06-01T09:00:00Z"}` + "\n",
+		`{"state":"closed"}` + "\n",
+	}
+	if !reflect.DeepEqual(bodies, expected) {
+		t.Errorf("Expected %q, got %q", expected, bodies)
+	}
+}
+
+func TestSprintService_GetIssuesForSprintPages(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/agile/1.0/sprint/37/issue", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if r.URL.Query().Get("startAt") == "1" {
+			fmt.Fprint(w, `{"startAt":1,"maxResults":1,"total":2,"issues":[{"key":"EX-2"}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"startAt":0,"maxResults":1,"total":2,"issues":[{"key":"EX-1"}]}`)
+	})
+
+	var keys []string
+	err := testClient.Sprint.GetIssuesForSprintPages(37, &BoardIssuesOptions{MaxResults: 1}, func(issue Issue) error {
+		keys = append(keys, issue.Key)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if !reflect.DeepEqual(keys, []string{"EX-1", "EX-2"}) {
+		t.Errorf("Unexpected issues %v", keys)
+	}
+}
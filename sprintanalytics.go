@@ -0,0 +1,287 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SprintSummary represents the scope changes and the completion of a sprint.
+// All issues are referenced by their keys.
+type SprintSummary struct {
+	Sprint Sprint
+	// Committed are the issues which were part of the sprint when it was started
+	Committed []string
+	// Added are the issues which were added to the sprint after it was started
+	Added []string
+	// Removed are the issues which were part of the sprint when it was started, but not at its end
+	Removed []string
+	// Completed are the issues which are part of the sprint at its end and are done
+	Completed []string
+	// Incomplete are the issues which are part of the sprint at its end, but are not done
+	Incomplete []string
+}
+
+// Scope returns the number of issues which are part of the sprint at its end
+func (s *SprintSummary) Scope() int {
+	return len(s.Completed) + len(s.Incomplete)
+}
+
+// CompletionRatio returns the ratio of completed issues to all issues which are part of the sprint at its end.
+// It returns 0 for an empty sprint.
+func (s *SprintSummary) CompletionRatio() float64 {
+	if s.Scope() == 0 {
+		return 0
+	}
+	return float64(len(s.Completed)) / float64(s.Scope())
+}
+
+// CommitmentRatio returns the ratio of completed issues to the committed issues.
+// It returns 0 if nothing was committed.
+func (s *SprintSummary) CommitmentRatio() float64 {
+	if len(s.Committed) == 0 {
+		return 0
+	}
+	return float64(len(s.Completed)) / float64(len(s.Committed))
+}
+
+// sprintReport is the part of the Agile sprint report used by GetSprintSummary
+type sprintReport struct {
+	Contents struct {
+		PuntedIssues []struct {
+			Key string `json:"key"`
+		} `json:"puntedIssues"`
+	} `json:"contents"`
+}
+
+// GetSprintSummaryWithContext computes the SprintSummary for the given sprint.
+// The issues are searched with the JQL "sprint = sprintID", including their changelog.
+// Issues removed from the sprint no longer match that JQL, so they are taken from the sprint report
+// of the board of the sprint and searched by their keys.
+// The categories of the statuses are requested from JIRA, see ComputeSprintSummary for details of the computation.
+func (s *SprintService) GetSprintSummaryWithContext(ctx context.Context, sprintID int) (*SprintSummary, error) {
+	sprint, _, err := s.GetWithContext(ctx, sprintID)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses, _, err := s.client.Status.GetAllStatusesWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	categories := map[string]string{}
+	for _, status := range statuses {
+		categories[status.ID] = status.StatusCategory.Key
+	}
+
+	issues := []Issue{}
+	found := map[string]bool{}
+	options := &SearchOptions{
+		MaxResults: 100,
+		Expand:     ExpandChangelog,
+		Fields:     []string{"status", "summary"},
+	}
+	collect := func(issue Issue) error {
+		if !found[issue.Key] {
+			found[issue.Key] = true
+			issues = append(issues, issue)
+		}
+		return nil
+	}
+	err = s.client.Issue.SearchPagesWithContext(ctx, fmt.Sprintf("sprint = %d", sprintID), options, collect)
+	if err != nil {
+		return nil, err
+	}
+
+	removed, err := s.getRemovedIssueKeys(ctx, sprint)
+	if err != nil {
+		return nil, err
+	}
+	keys := []string{}
+	for _, key := range removed {
+		if !found[key] {
+			keys = append(keys, quoteJQL(key))
+		}
+	}
+	if len(keys) > 0 {
+		err = s.client.Issue.SearchPagesWithContext(ctx, fmt.Sprintf("key in (%s)", strings.Join(keys, ", ")), options, collect)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return ComputeSprintSummary(sprint, issues, categories), nil
+}
+
+// GetSprintSummary wraps GetSprintSummaryWithContext using the background context.
+func (s *SprintService) GetSprintSummary(sprintID int) (*SprintSummary, error) {
+	return s.GetSprintSummaryWithContext(context.Background(), sprintID)
+}
+
+// getRemovedIssueKeys returns the keys of the issues removed from the started sprint, as listed by the sprint report
+// of its board. A sprint which was not started or has no board has no report.
+func (s *SprintService) getRemovedIssueKeys(ctx context.Context, sprint *Sprint) ([]string, error) {
+	if sprint.StartDate == nil || sprint.OriginBoardID == 0 {
+		return nil, nil
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/greenhopper/1.0/rapid/charts/sprintreport?rapidViewId=%d&sprintId=%d", sprint.OriginBoardID, sprint.ID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	report := new(sprintReport)
+	resp, err := s.client.Do(req, report)
+	if err != nil {
+		return nil, NewJiraError(resp, err)
+	}
+
+	keys := []string{}
+	for _, issue := range report.Contents.PuntedIssues {
+		keys = append(keys, issue.Key)
+	}
+	return keys, nil
+}
+
+// ComputeSprintSummary computes the SprintSummary of sprint from the given issues.
+// The issues have to include their changelog (expand=changelog) and status field.
+// categories maps the status IDs (or names, if the ID is not mapped) to the keys of their status category.
+//
+// The sprint membership of an issue at the start and at the end (the complete date, or now for
+// an active sprint) of the sprint is reconstructed from the "Sprint" items of its changelog.
+// Issues without sprint changes are considered to have been part of the sprint the whole time.
+// An issue is done if its status at the end of the sprint, reconstructed with FieldHistory,
+// is in the category StatusCategoryComplete.
+func ComputeSprintSummary(sprint *Sprint, issues []Issue, categories map[string]string) *SprintSummary {
+	summary := &SprintSummary{Sprint: *sprint}
+
+	start := time.Now()
+	if sprint.StartDate != nil {
+		start = *sprint.StartDate
+	}
+	end := time.Now()
+	if sprint.CompleteDate != nil {
+		end = *sprint.CompleteDate
+	}
+
+	for _, issue := range issues {
+		changes := sprintChanges(issue, sprint.ID)
+		atStart := inSprintAt(changes, start)
+		atEnd := inSprintAt(changes, end)
+
+		if atStart {
+			summary.Committed = append(summary.Committed, issue.Key)
+			if !atEnd {
+				summary.Removed = append(summary.Removed, issue.Key)
+			}
+		} else if atEnd {
+			summary.Added = append(summary.Added, issue.Key)
+		}
+
+		if !atEnd {
+			continue
+		}
+		if statusCategoryAt(issue, end, categories) == StatusCategoryComplete {
+			summary.Completed = append(summary.Completed, issue.Key)
+		} else {
+			summary.Incomplete = append(summary.Incomplete, issue.Key)
+		}
+	}
+
+	return summary
+}
+
+// statusCategoryAt returns the key of the status category of issue at the given time.
+// The first status change after at holds the status at that time, otherwise the current status is used.
+func statusCategoryAt(issue Issue, at time.Time, categories map[string]string) string {
+	category := func(id, name string) string {
+		if c, ok := categories[id]; ok {
+			return c
+		}
+		return categories[name]
+	}
+
+	for _, change := range FieldHistory(&issue, "status") {
+		if change.At.After(at) {
+			return category(change.From, change.FromString)
+		}
+	}
+	if issue.Fields == nil || issue.Fields.Status == nil {
+		return ""
+	}
+	status := issue.Fields.Status
+	if c := category(status.ID, status.Name); c != "" {
+		return c
+	}
+	return status.StatusCategory.Key
+}
+
+// sprintChange is a single change of the sprint membership of an issue
+type sprintChange struct {
+	at        time.Time
+	wasMember bool
+	isMember  bool
+}
+
+// sprintChanges returns the changes of the membership of issue in the sprint sprintID, ordered by time
+func sprintChanges(issue Issue, sprintID int) []sprintChange {
+	changes := []sprintChange{}
+	if issue.Changelog == nil {
+		return changes
+	}
+
+	for _, history := range issue.Changelog.Histories {
+		at, err := history.CreatedTime()
+		if err != nil {
+			continue
+		}
+		for _, item := range history.Items {
+			if item.Field != "Sprint" {
+				continue
+			}
+			changes = append(changes, sprintChange{
+				at:        at,
+				wasMember: containsSprintID(item.From, sprintID),
+				isMember:  containsSprintID(item.To, sprintID),
+			})
+		}
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].at.Before(changes[j].at)
+	})
+	return changes
+}
+
+// inSprintAt reports if the issue was part of the sprint at the given time
+func inSprintAt(changes []sprintChange, at time.Time) bool {
+	if len(changes) == 0 {
+		return true
+	}
+
+	member := changes[0].wasMember
+	for _, change := range changes {
+		if change.at.After(at) {
+			break
+		}
+		member = change.isMember
+	}
+	return member
+}
+
+// containsSprintID reports if the changelog value v (a comma separated list of sprint IDs) contains sprintID
+func containsSprintID(v interface{}, sprintID int) bool {
+	if v == nil {
+		return false
+	}
+	for _, id := range strings.Split(fmt.Sprint(v), ",") {
+		if i, err := strconv.Atoi(strings.TrimSpace(id)); err == nil && i == sprintID {
+			return true
+		}
+	}
+	return false
+}
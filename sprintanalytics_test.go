@@ -0,0 +1,137 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func sprintAnalyticsIssue(key, statusCategory string, histories ...ChangelogHistory) Issue {
+	issue := Issue{
+		Key: key,
+		Fields: &IssueFields{
+			Status: &Status{StatusCategory: StatusCategory{Key: statusCategory}},
+		},
+	}
+	if len(histories) > 0 {
+		issue.Changelog = &Changelog{Histories: histories}
+	}
+	return issue
+}
+
+func sprintAnalyticsHistory(created string, from, to interface{}) ChangelogHistory {
+	return ChangelogHistory{
+		Created: created,
+		Items:   []ChangelogItems{{Field: "Sprint", From: from, To: to}},
+	}
+}
+
+func TestComputeSprintSummary(t *testing.T) {
+	start := time.Date(2020, 3, 2, 9, 0, 0, 0, time.UTC)
+	complete := time.Date(2020, 3, 16, 17, 0, 0, 0, time.UTC)
+	sprint := &Sprint{ID: 7, StartDate: &start, CompleteDate: &complete}
+
+	issues := []Issue{
+		// part of the sprint from the beginning, done
+		sprintAnalyticsIssue("TEST-1", StatusCategoryComplete),
+		// added before the start, not done
+		sprintAnalyticsIssue("TEST-2", StatusCategoryInProgress,
+			sprintAnalyticsHistory("2020-02-28T10:00:00.000+0000", nil, "7")),
+		// added after the start, done
+		sprintAnalyticsIssue("TEST-3", StatusCategoryComplete,
+			sprintAnalyticsHistory("2020-03-05T10:00:00.000+0000", "6", "6, 7")),
+		// removed after the start
+		sprintAnalyticsIssue("TEST-4", StatusCategoryToDo,
+			sprintAnalyticsHistory("2020-03-10T10:00:00.000+0000", "7", "8")),
+		// added after the start and moved to the next sprint after completion
+		sprintAnalyticsIssue("TEST-5", StatusCategoryToDo,
+			sprintAnalyticsHistory("2020-03-04T10:00:00.000+0000", "", "7"),
+			sprintAnalyticsHistory("2020-03-17T10:00:00.000+0000", "7", "7,8")),
+		// part of the sprint from the beginning, done after completion
+		sprintAnalyticsIssue("TEST-6", StatusCategoryComplete, ChangelogHistory{
+			Created: "2020-03-17T10:00:00.000+0000",
+			Items:   []ChangelogItems{{Field: "status", From: "3", FromString: "In Progress", To: "10001", ToString: "Done"}},
+		}),
+	}
+	categories := map[string]string{"3": StatusCategoryInProgress, "10001": StatusCategoryComplete}
+
+	summary := ComputeSprintSummary(sprint, issues, categories)
+
+	expected := &SprintSummary{
+		Sprint:     *sprint,
+		Committed:  []string{"TEST-1", "TEST-2", "TEST-4", "TEST-6"},
+		Added:      []string{"TEST-3", "TEST-5"},
+		Removed:    []string{"TEST-4"},
+		Completed:  []string{"TEST-1", "TEST-3"},
+		Incomplete: []string{"TEST-2", "TEST-5", "TEST-6"},
+	}
+	if !reflect.DeepEqual(summary, expected) {
+		t.Errorf("Expected %+v. Got %+v", expected, summary)
+	}
+
+	if summary.Scope() != 5 {
+		t.Errorf("Expected scope 5. Got %d", summary.Scope())
+	}
+	if summary.CompletionRatio() != 0.4 {
+		t.Errorf("Expected completion ratio 0.4. Got %f", summary.CompletionRatio())
+	}
+	if summary.CommitmentRatio() != 0.5 {
+		t.Errorf("Expected commitment ratio 0.5. Got %f", summary.CommitmentRatio())
+	}
+}
+
+func TestSprintService_GetSprintSummary(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/agile/1.0/sprint/7", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/agile/1.0/sprint/7")
+		fmt.Fprint(w, `{"id":7,"state":"closed","name":"Sprint 7","startDate":"2020-03-02T09:00:00.000Z","completeDate":"2020-03-16T17:00:00.000Z","originBoardId":5}`)
+	})
+	testMux.HandleFunc("/rest/api/2/status", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":"1","name":"Open","statusCategory":{"key":"new"}},{"id":"10001","name":"Done","statusCategory":{"key":"done"}}]`)
+	})
+	testMux.HandleFunc("/rest/greenhopper/1.0/rapid/charts/sprintreport", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/greenhopper/1.0/rapid/charts/sprintreport?rapidViewId=5&sprintId=7")
+		fmt.Fprint(w, `{"contents":{"puntedIssues":[{"key":"TEST-1"},{"key":"TEST-3"}]}}`)
+	})
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch jql := r.URL.Query().Get("jql"); jql {
+		case "sprint = 7":
+			testRequestParams(t, r, map[string]string{"jql": jql, "maxResults": "100", "expand": "changelog", "fields": "status,summary"})
+			fmt.Fprint(w, `{"startAt":0,"maxResults":100,"total":2,"issues":[
+				{"key":"TEST-1","fields":{"status":{"id":"10001","statusCategory":{"key":"done"}}}},
+				{"key":"TEST-2","fields":{"status":{"id":"1","statusCategory":{"key":"new"}}},"changelog":{"histories":[{"created":"2020-03-05T10:00:00.000+0000","items":[{"field":"Sprint","from":null,"to":"7"}]}]}}
+			]}`)
+		case `key in ("TEST-3")`:
+			fmt.Fprint(w, `{"startAt":0,"maxResults":100,"total":1,"issues":[
+				{"key":"TEST-3","fields":{"status":{"id":"1","statusCategory":{"key":"new"}}},"changelog":{"histories":[{"created":"2020-03-10T10:00:00.000+0000","items":[{"field":"Sprint","from":"7","to":null}]}]}}
+			]}`)
+		default:
+			t.Errorf("Unexpected JQL %q", jql)
+		}
+	})
+
+	summary, err := testClient.Sprint.GetSprintSummary(7)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if !reflect.DeepEqual(summary.Committed, []string{"TEST-1", "TEST-3"}) {
+		t.Errorf("Expected TEST-1 and TEST-3 to be committed. Got %v", summary.Committed)
+	}
+	if !reflect.DeepEqual(summary.Removed, []string{"TEST-3"}) {
+		t.Errorf("Expected TEST-3 to be removed. Got %v", summary.Removed)
+	}
+	if !reflect.DeepEqual(summary.Added, []string{"TEST-2"}) {
+		t.Errorf("Expected TEST-2 to be added. Got %v", summary.Added)
+	}
+	if summary.CompletionRatio() != 0.5 {
+		t.Errorf("Expected completion ratio 0.5. Got %f", summary.CompletionRatio())
+	}
+}
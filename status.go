@@ -1,5 +1,7 @@
 package jira
 
+import "context"
+
 // StatusService handles staties for the JIRA instance / API.
 //
 // JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/#api-group-Workflow-statuses
@@ -19,12 +21,12 @@ type Status struct {
 	StatusCategory StatusCategory `json:"statusCategory" structs:"statusCategory"`
 }
 
-// GetAllStatuses returns a list of all statuses associated with workflows.
+// GetAllStatusesWithContext returns a list of all statuses associated with workflows.
 //
 // JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/#api-rest-api-2-status-get
-func (s *StatusService) GetAllStatuses() ([]Status, *Response, error) {
+func (s *StatusService) GetAllStatusesWithContext(ctx context.Context) ([]Status, *Response, error) {
 	apiEndpoint := "rest/api/2/status"
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 
 	if err != nil {
 		return nil, nil, err
@@ -38,3 +40,129 @@ func (s *StatusService) GetAllStatuses() ([]Status, *Response, error) {
 
 	return statusList, resp, nil
 }
+
+// GetAllStatuses wraps GetAllStatusesWithContext using the background context.
+func (s *StatusService) GetAllStatuses() ([]Status, *Response, error) {
+	return s.GetAllStatusesWithContext(context.Background())
+}
+
+// StatusDetails represents a status as returned by the status management endpoints
+type StatusDetails struct {
+	ID          string `json:"id,omitempty" structs:"id,omitempty"`
+	Name        string `json:"name" structs:"name"`
+	Description string `json:"description,omitempty" structs:"description,omitempty"`
+	// StatusCategory is one of "TODO", "IN_PROGRESS" or "DONE"
+	StatusCategory string         `json:"statusCategory" structs:"statusCategory"`
+	Scope          *WorkflowScope `json:"scope,omitempty" structs:"scope,omitempty"`
+}
+
+// StatusCreatePayload is passed to StatusService.Create.
+// All statuses are created in the same scope.
+type StatusCreatePayload struct {
+	Scope    WorkflowScope   `json:"scope" structs:"scope"`
+	Statuses []StatusDetails `json:"statuses" structs:"statuses"`
+}
+
+// GetStatusesByIDWithContext returns the statuses with the given IDs.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-status/#api-rest-api-2-statuses-get
+func (s *StatusService) GetStatusesByIDWithContext(ctx context.Context, ids []string) ([]StatusDetails, *Response, error) {
+	apiEndpoint, err := addOptions("rest/api/2/statuses", &struct {
+		ID []string `url:"id"`
+	}{ids})
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	statuses := []StatusDetails{}
+	resp, err := s.client.Do(req, &statuses)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return statuses, resp, nil
+}
+
+// GetStatusesByID wraps GetStatusesByIDWithContext using the background context.
+func (s *StatusService) GetStatusesByID(ids []string) ([]StatusDetails, *Response, error) {
+	return s.GetStatusesByIDWithContext(context.Background(), ids)
+}
+
+// CreateWithContext creates statuses in a global or project scope.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-status/#api-rest-api-2-statuses-post
+func (s *StatusService) CreateWithContext(ctx context.Context, payload *StatusCreatePayload) ([]StatusDetails, *Response, error) {
+	apiEndpoint := "rest/api/2/statuses"
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	statuses := []StatusDetails{}
+	resp, err := s.client.Do(req, &statuses)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return statuses, resp, nil
+}
+
+// Create wraps CreateWithContext using the background context.
+func (s *StatusService) Create(payload *StatusCreatePayload) ([]StatusDetails, *Response, error) {
+	return s.CreateWithContext(context.Background(), payload)
+}
+
+// UpdateWithContext updates the name, description and status category of the statuses, identified by their ID.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-status/#api-rest-api-2-statuses-put
+func (s *StatusService) UpdateWithContext(ctx context.Context, statuses []StatusDetails) (*Response, error) {
+	apiEndpoint := "rest/api/2/statuses"
+	payload := struct {
+		Statuses []StatusDetails `json:"statuses"`
+	}{statuses}
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// Update wraps UpdateWithContext using the background context.
+func (s *StatusService) Update(statuses []StatusDetails) (*Response, error) {
+	return s.UpdateWithContext(context.Background(), statuses)
+}
+
+// DeleteWithContext deletes the statuses with the given IDs.
+// Statuses which are used by a workflow can not be deleted.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-status/#api-rest-api-2-statuses-delete
+func (s *StatusService) DeleteWithContext(ctx context.Context, ids []string) (*Response, error) {
+	apiEndpoint, err := addOptions("rest/api/2/statuses", &struct {
+		ID []string `url:"id"`
+	}{ids})
+	if err != nil {
+		return nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// Delete wraps DeleteWithContext using the background context.
+func (s *StatusService) Delete(ids []string) (*Response, error) {
+	return s.DeleteWithContext(context.Background(), ids)
+}
@@ -33,3 +33,84 @@ func TestStatusService_GetAllStatuses(t *testing.T) {
 		t.Errorf("Error given: %s", err)
 	}
 }
+
+func TestStatusService_GetStatusesByID(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/statuses", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/statuses?id=10000&id=10001")
+		fmt.Fprint(w, `[{"id":"10000","name":"To Do","statusCategory":"TODO","scope":{"type":"GLOBAL"}},{"id":"10001","name":"Done","statusCategory":"DONE","scope":{"type":"PROJECT","project":{"id":"10100"}}}]`)
+	})
+
+	statuses, _, err := testClient.Status.GetStatusesByID([]string{"10000", "10001"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(statuses) != 2 || statuses[1].Scope == nil || statuses[1].Scope.Project.ID != "10100" {
+		t.Errorf("Unexpected statuses %+v", statuses)
+	}
+}
+
+func TestStatusService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/statuses", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, "/rest/api/2/statuses")
+
+		body, _ := ioutil.ReadAll(r.Body)
+		expected := `{"scope":{"type":"PROJECT","project":{"id":"10100"}},"statuses":[{"name":"Review","statusCategory":"IN_PROGRESS"}]}` + "\n"
+		if string(body) != expected {
+			t.Errorf("Unexpected body %s", body)
+		}
+		fmt.Fprint(w, `[{"id":"10002","name":"Review","statusCategory":"IN_PROGRESS","scope":{"type":"PROJECT","project":{"id":"10100"}}}]`)
+	})
+
+	statuses, _, err := testClient.Status.Create(&StatusCreatePayload{
+		Scope:    WorkflowScope{Type: WorkflowScopeProject, Project: &WorkflowProjectReference{ID: "10100"}},
+		Statuses: []StatusDetails{{Name: "Review", StatusCategory: "IN_PROGRESS"}},
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(statuses) != 1 || statuses[0].ID != "10002" {
+		t.Errorf("Unexpected statuses %+v", statuses)
+	}
+}
+
+func TestStatusService_Update(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/statuses", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, "/rest/api/2/statuses")
+
+		body, _ := ioutil.ReadAll(r.Body)
+		expected := `{"statuses":[{"id":"10002","name":"In Review","statusCategory":"IN_PROGRESS"}]}` + "\n"
+		if string(body) != expected {
+			t.Errorf("Unexpected body %s", body)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.Status.Update([]StatusDetails{{ID: "10002", Name: "In Review", StatusCategory: "IN_PROGRESS"}})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestStatusService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/statuses", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, "/rest/api/2/statuses?id=10002")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.Status.Delete([]string{"10002"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
@@ -0,0 +1,212 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+)
+
+// TeamService handles Atlassian teams and the Team field of JIRA Cloud.
+// The teams are managed by the organization, their API is served by the JIRA site under "gateway/api/public/teams".
+//
+// API docs: https://developer.atlassian.com/platform/teams/rest/v1/api-group-teams-public-api/
+type TeamService struct {
+	client *Client
+}
+
+// TeamCustomFieldType is the custom field type of the Team field
+const TeamCustomFieldType = "com.atlassian.jira.plugin.system.customfieldtypes:atlassian-team"
+
+// Team represents an Atlassian team
+type Team struct {
+	TeamID         string `json:"teamId" structs:"teamId"`
+	DisplayName    string `json:"displayName" structs:"displayName"`
+	Description    string `json:"description,omitempty" structs:"description,omitempty"`
+	OrganizationID string `json:"organizationId,omitempty" structs:"organizationId,omitempty"`
+	// State is either "ACTIVE" or "ARCHIVED"
+	State    string `json:"state,omitempty" structs:"state,omitempty"`
+	TeamType string `json:"teamType,omitempty" structs:"teamType,omitempty"`
+}
+
+// TeamsList reflects a page of teams.
+// Cursor has to be passed as TeamListOptions.Cursor to fetch the next page, it is empty for the last page.
+type TeamsList struct {
+	Entities []Team `json:"entities" structs:"entities"`
+	Cursor   string `json:"cursor,omitempty" structs:"cursor,omitempty"`
+}
+
+func (l *TeamsList) pageInfo() PageInfo {
+	return PageInfo{IsLast: l.Cursor == "", NextPageToken: l.Cursor}
+}
+
+// TeamListOptions specifies the optional parameters to the TeamService.GetList method
+type TeamListOptions struct {
+	Cursor string `url:"cursor,omitempty"`
+	Size   int    `url:"size,omitempty"`
+}
+
+// TeamMember represents a member of a team
+type TeamMember struct {
+	AccountID string `json:"accountId" structs:"accountId"`
+}
+
+// TeamMembersPageInfo contains the cursor of the next page of team members
+type TeamMembersPageInfo struct {
+	EndCursor   string `json:"endCursor,omitempty" structs:"endCursor,omitempty"`
+	HasNextPage bool   `json:"hasNextPage" structs:"hasNextPage"`
+}
+
+// TeamMembersList reflects a page of the members of a team
+type TeamMembersList struct {
+	Results  []TeamMember        `json:"results" structs:"results"`
+	PageInfo TeamMembersPageInfo `json:"pageInfo" structs:"pageInfo"`
+}
+
+func (l *TeamMembersList) pageInfo() PageInfo {
+	return PageInfo{IsLast: !l.PageInfo.HasNextPage, NextPageToken: l.PageInfo.EndCursor}
+}
+
+// TeamMembersOptions specifies the optional parameters to the TeamService.GetMembers method
+type TeamMembersOptions struct {
+	// First is the maximum number of members to return
+	First int `json:"first,omitempty"`
+	// After is the EndCursor of the previous page
+	After string `json:"after,omitempty"`
+}
+
+// GetListWithContext returns a page of the teams of the organization.
+//
+// API docs: https://developer.atlassian.com/platform/teams/rest/v1/api-group-teams-public-api/#api-public-teams-v1-org-orgid-teams-get
+func (s *TeamService) GetListWithContext(ctx context.Context, orgID string, options *TeamListOptions) (*TeamsList, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("gateway/api/public/teams/v1/org/%s/teams/", orgID), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	teams := new(TeamsList)
+	resp, err := s.client.Do(req, teams)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return teams, resp, nil
+}
+
+// GetList wraps GetListWithContext using the background context.
+func (s *TeamService) GetList(orgID string, options *TeamListOptions) (*TeamsList, *Response, error) {
+	return s.GetListWithContext(context.Background(), orgID, options)
+}
+
+// GetWithContext returns the team with the given ID.
+//
+// API docs: https://developer.atlassian.com/platform/teams/rest/v1/api-group-teams-public-api/#api-public-teams-v1-org-orgid-teams-teamid-get
+func (s *TeamService) GetWithContext(ctx context.Context, orgID, teamID string) (*Team, *Response, error) {
+	apiEndpoint := fmt.Sprintf("gateway/api/public/teams/v1/org/%s/teams/%s", orgID, teamID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	team := new(Team)
+	resp, err := s.client.Do(req, team)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return team, resp, nil
+}
+
+// Get wraps GetWithContext using the background context.
+func (s *TeamService) Get(orgID, teamID string) (*Team, *Response, error) {
+	return s.GetWithContext(context.Background(), orgID, teamID)
+}
+
+// GetMembersWithContext returns a page of the members of the team.
+//
+// API docs: https://developer.atlassian.com/platform/teams/rest/v1/api-group-teams-members-public-api/#api-public-teams-v1-org-orgid-teams-teamid-members-post
+func (s *TeamService) GetMembersWithContext(ctx context.Context, orgID, teamID string, options *TeamMembersOptions) (*TeamMembersList, *Response, error) {
+	apiEndpoint := fmt.Sprintf("gateway/api/public/teams/v1/org/%s/teams/%s/members", orgID, teamID)
+	if options == nil {
+		options = &TeamMembersOptions{}
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	members := new(TeamMembersList)
+	resp, err := s.client.Do(req, members)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return members, resp, nil
+}
+
+// GetMembers wraps GetMembersWithContext using the background context.
+func (s *TeamService) GetMembers(orgID, teamID string, options *TeamMembersOptions) (*TeamMembersList, *Response, error) {
+	return s.GetMembersWithContext(context.Background(), orgID, teamID, options)
+}
+
+// GetTeamFieldWithContext returns the Team field of the instance, identified by its custom field type TeamCustomFieldType.
+func (s *TeamService) GetTeamFieldWithContext(ctx context.Context) (*Field, error) {
+	fields, _, err := s.client.Field.GetListWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range fields {
+		if fields[i].Schema.Custom == TeamCustomFieldType {
+			return &fields[i], nil
+		}
+	}
+	return nil, fmt.Errorf("no field of type %s found", TeamCustomFieldType)
+}
+
+// GetTeamField wraps GetTeamFieldWithContext using the background context.
+func (s *TeamService) GetTeamField() (*Field, error) {
+	return s.GetTeamFieldWithContext(context.Background())
+}
+
+// SearchIssuesWithContext searches the issues assigned to the team by the Team field.
+// The JQL is combined with jql by AND, if jql is not empty.
+func (s *TeamService) SearchIssuesWithContext(ctx context.Context, teamID, jql string, options *SearchOptions) ([]Issue, *Response, error) {
+	field, err := s.GetTeamFieldWithContext(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	teamJQL := fmt.Sprintf("cf[%d] = %s", field.Schema.CustomID, quoteJQL(teamID))
+	if jql != "" {
+		teamJQL = fmt.Sprintf("%s AND (%s)", teamJQL, jql)
+	}
+	return s.client.Issue.SearchWithContext(ctx, teamJQL, options)
+}
+
+// SearchIssues wraps SearchIssuesWithContext using the background context.
+func (s *TeamService) SearchIssues(teamID, jql string, options *SearchOptions) ([]Issue, *Response, error) {
+	return s.SearchIssuesWithContext(context.Background(), teamID, jql, options)
+}
+
+// SetIssueTeamWithContext sets the Team field of the issue to the team with the given ID.
+// An empty teamID clears the field.
+func (s *TeamService) SetIssueTeamWithContext(ctx context.Context, issueID, teamID string) (*Response, error) {
+	field, err := s.GetTeamFieldWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var value interface{}
+	if teamID != "" {
+		value = teamID
+	}
+	return s.client.Issue.UpdateIssueWithContext(ctx, issueID, map[string]interface{}{
+		"fields": map[string]interface{}{
+			field.ID: value,
+		},
+	})
+}
+
+// SetIssueTeam wraps SetIssueTeamWithContext using the background context.
+func (s *TeamService) SetIssueTeam(issueID, teamID string) (*Response, error) {
+	return s.SetIssueTeamWithContext(context.Background(), issueID, teamID)
+}
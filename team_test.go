@@ -0,0 +1,95 @@
+package jira
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+const testTeamFields = `[{"id":"summary","name":"Summary","schema":{"type":"string","system":"summary"}},{"id":"customfield_10001","name":"Team","custom":true,"schema":{"type":"team","custom":"com.atlassian.jira.plugin.system.customfieldtypes:atlassian-team","customId":10001}}]`
+
+func TestTeamService_GetList(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/gateway/api/public/teams/v1/org/org-1/teams/"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint+"?size=1")
+		fmt.Fprint(w, `{"entities":[{"teamId":"team-1","displayName":"Platform","organizationId":"org-1","state":"ACTIVE","teamType":"MEMBER_INVITE"}],"cursor":"next"}`)
+	})
+
+	teams, _, err := testClient.Team.GetList("org-1", &TeamListOptions{Size: 1})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(teams.Entities) != 1 || teams.Entities[0].DisplayName != "Platform" || teams.Cursor != "next" {
+		t.Errorf("Unexpected teams %+v", teams)
+	}
+}
+
+func TestTeamService_GetMembers(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/gateway/api/public/teams/v1/org/org-1/teams/team-1/members"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEndpoint)
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != "{\"first\":50,\"after\":\"abc\"}\n" {
+			t.Errorf("Unexpected body %q", body)
+		}
+		fmt.Fprint(w, `{"results":[{"accountId":"5b10a2844c20165700ede21g"}],"pageInfo":{"endCursor":"def","hasNextPage":false}}`)
+	})
+
+	members, _, err := testClient.Team.GetMembers("org-1", "team-1", &TeamMembersOptions{First: 50, After: "abc"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(members.Results) != 1 || members.PageInfo.HasNextPage {
+		t.Errorf("Unexpected members %+v", members)
+	}
+}
+
+func TestTeamService_SearchIssues(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/field", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, testTeamFields)
+	})
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if jql := r.URL.Query().Get("jql"); jql != `cf[10001] = "team-1" AND (status != Done)` {
+			t.Errorf("Unexpected jql %s", jql)
+		}
+		fmt.Fprint(w, `{"startAt":0,"maxResults":50,"total":1,"issues":[{"key":"TEST-1"}]}`)
+	})
+
+	issues, _, err := testClient.Team.SearchIssues("team-1", "status != Done", nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(issues) != 1 {
+		t.Errorf("Expected 1 issue. Got %d", len(issues))
+	}
+}
+
+func TestTeamService_SetIssueTeam(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/field", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, testTeamFields)
+	})
+	testMux.HandleFunc("/rest/api/2/issue/TEST-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != "{\"fields\":{\"customfield_10001\":\"team-1\"}}\n" {
+			t.Errorf("Unexpected body %q", body)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Team.SetIssueTeam("TEST-1", "team-1"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
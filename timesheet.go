@@ -0,0 +1,238 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+)
+
+// TimesheetApprovalService handles the timesheet approvals of the Tempo Timesheets app for JIRA Server / Data Center.
+// A timesheet is the work logged by a user in an approval period, it is submitted by the user
+// to a reviewer, who approves or rejects it.
+//
+// Tempo API docs: https://www.tempo.io/server-api-documentation/timesheets#timesheet-approval
+type TimesheetApprovalService struct {
+	client *Client
+}
+
+// These constants are the statuses of a timesheet approval
+const (
+	TimesheetStatusOpen               = "open"
+	TimesheetStatusReadyToSubmit      = "ready_to_submit"
+	TimesheetStatusWaitingForApproval = "waiting_for_approval"
+	TimesheetStatusApproved           = "approved"
+	TimesheetStatusRejected           = "rejected"
+)
+
+// These constants are the actions on a timesheet approval
+const (
+	TimesheetActionSubmit  = "submit"
+	TimesheetActionApprove = "approve"
+	TimesheetActionReject  = "reject"
+	TimesheetActionReopen  = "reopen"
+)
+
+// TimesheetUser represents a user in a timesheet approval
+type TimesheetUser struct {
+	Self        string `json:"self,omitempty" structs:"self,omitempty"`
+	Name        string `json:"name,omitempty" structs:"name,omitempty"`
+	Key         string `json:"key,omitempty" structs:"key,omitempty"`
+	DisplayName string `json:"displayName,omitempty" structs:"displayName,omitempty"`
+	Avatar      string `json:"avatar,omitempty" structs:"avatar,omitempty"`
+}
+
+// TimesheetPeriod represents an approval period.
+// The dates are formatted as "2006-01-02".
+type TimesheetPeriod struct {
+	PeriodView string `json:"periodView,omitempty" structs:"periodView,omitempty"`
+	DateFrom   string `json:"dateFrom,omitempty" structs:"dateFrom,omitempty"`
+	DateTo     string `json:"dateTo,omitempty" structs:"dateTo,omitempty"`
+}
+
+// TimesheetStatus represents the status of a timesheet and the last action on it
+type TimesheetStatus struct {
+	Key              string         `json:"key,omitempty" structs:"key,omitempty"`
+	Comment          string         `json:"comment,omitempty" structs:"comment,omitempty"`
+	Actor            *TimesheetUser `json:"actor,omitempty" structs:"actor,omitempty"`
+	Reviewer         *TimesheetUser `json:"reviewer,omitempty" structs:"reviewer,omitempty"`
+	ActionDate       string         `json:"actionDate,omitempty" structs:"actionDate,omitempty"`
+	WorkedSeconds    int            `json:"workedSeconds,omitempty" structs:"workedSeconds,omitempty"`
+	SubmittedSeconds int            `json:"submittedSeconds,omitempty" structs:"submittedSeconds,omitempty"`
+	RequiredSeconds  int            `json:"requiredSeconds,omitempty" structs:"requiredSeconds,omitempty"`
+}
+
+// TimesheetAction represents an action which can be taken on a timesheet
+type TimesheetAction struct {
+	Name string `json:"name,omitempty" structs:"name,omitempty"`
+}
+
+// TimesheetActions contains the actions which are currently allowed on a timesheet
+type TimesheetActions struct {
+	Submit  *TimesheetAction `json:"submit,omitempty" structs:"submit,omitempty"`
+	Approve *TimesheetAction `json:"approve,omitempty" structs:"approve,omitempty"`
+	Reject  *TimesheetAction `json:"reject,omitempty" structs:"reject,omitempty"`
+	Reopen  *TimesheetAction `json:"reopen,omitempty" structs:"reopen,omitempty"`
+}
+
+// TimesheetApproval represents the approval of the timesheet of a user in a period
+type TimesheetApproval struct {
+	User             *TimesheetUser    `json:"user,omitempty" structs:"user,omitempty"`
+	Status           *TimesheetStatus  `json:"status,omitempty" structs:"status,omitempty"`
+	Period           *TimesheetPeriod  `json:"period,omitempty" structs:"period,omitempty"`
+	Reviewer         *TimesheetUser    `json:"reviewer,omitempty" structs:"reviewer,omitempty"`
+	Actions          *TimesheetActions `json:"actions,omitempty" structs:"actions,omitempty"`
+	RequiredSeconds  int               `json:"requiredSeconds,omitempty" structs:"requiredSeconds,omitempty"`
+	WorkedSeconds    int               `json:"workedSeconds,omitempty" structs:"workedSeconds,omitempty"`
+	SubmittedSeconds int               `json:"submittedSeconds,omitempty" structs:"submittedSeconds,omitempty"`
+}
+
+// TimesheetApprovalOptions specifies the user and period of the TimesheetApprovalService.GetStatus method.
+// PeriodStartDate is formatted as "2006-01-02", the period containing this date is used.
+type TimesheetApprovalOptions struct {
+	UserKey         string `url:"userKey,omitempty"`
+	PeriodStartDate string `url:"periodStartDate,omitempty"`
+}
+
+// TimesheetApprovalRequest is the payload to submit, approve, reject or reopen a timesheet
+type TimesheetApprovalRequest struct {
+	User   *TimesheetUser                  `json:"user"`
+	Period *TimesheetPeriod                `json:"period"`
+	Action *TimesheetApprovalRequestAction `json:"action"`
+}
+
+// TimesheetApprovalRequestAction is the action of a TimesheetApprovalRequest.
+// The Reviewer is required to submit a timesheet.
+type TimesheetApprovalRequestAction struct {
+	Name     string         `json:"name"`
+	Comment  string         `json:"comment,omitempty"`
+	Reviewer *TimesheetUser `json:"reviewer,omitempty"`
+}
+
+// GetStatusWithContext returns the approval status of the timesheet of a user in a period.
+//
+// Tempo API docs: https://www.tempo.io/server-api-documentation/timesheets#timesheet-approval
+func (s *TimesheetApprovalService) GetStatusWithContext(ctx context.Context, options *TimesheetApprovalOptions) (*TimesheetApproval, *Response, error) {
+	apiEndpoint, err := addOptions("rest/tempo-timesheets/4/timesheet-approval/current", options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	approval := new(TimesheetApproval)
+	resp, err := s.client.Do(req, approval)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return approval, resp, nil
+}
+
+// GetStatus wraps GetStatusWithContext using the background context.
+func (s *TimesheetApprovalService) GetStatus(options *TimesheetApprovalOptions) (*TimesheetApproval, *Response, error) {
+	return s.GetStatusWithContext(context.Background(), options)
+}
+
+// GetPendingWithContext returns the timesheets waiting for the approval of the given reviewer.
+//
+// Tempo API docs: https://www.tempo.io/server-api-documentation/timesheets#timesheet-approval
+func (s *TimesheetApprovalService) GetPendingWithContext(ctx context.Context, reviewerKey string) ([]TimesheetApproval, *Response, error) {
+	apiEndpoint, err := addOptions("rest/tempo-timesheets/4/timesheet-approval/pending", &struct {
+		ReviewerKey string `url:"reviewerKey,omitempty"`
+	}{reviewerKey})
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	approvals := []TimesheetApproval{}
+	resp, err := s.client.Do(req, &approvals)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return approvals, resp, nil
+}
+
+// GetPending wraps GetPendingWithContext using the background context.
+func (s *TimesheetApprovalService) GetPending(reviewerKey string) ([]TimesheetApproval, *Response, error) {
+	return s.GetPendingWithContext(context.Background(), reviewerKey)
+}
+
+// SubmitWithContext submits the timesheet of the user in the period starting at periodStartDate to the reviewer.
+//
+// Tempo API docs: https://www.tempo.io/server-api-documentation/timesheets#timesheet-approval
+func (s *TimesheetApprovalService) SubmitWithContext(ctx context.Context, userKey, periodStartDate, reviewerKey, comment string) (*TimesheetApproval, *Response, error) {
+	return s.act(ctx, userKey, periodStartDate, &TimesheetApprovalRequestAction{
+		Name:     TimesheetActionSubmit,
+		Comment:  comment,
+		Reviewer: &TimesheetUser{Key: reviewerKey},
+	})
+}
+
+// Submit wraps SubmitWithContext using the background context.
+func (s *TimesheetApprovalService) Submit(userKey, periodStartDate, reviewerKey, comment string) (*TimesheetApproval, *Response, error) {
+	return s.SubmitWithContext(context.Background(), userKey, periodStartDate, reviewerKey, comment)
+}
+
+// ApproveWithContext approves the timesheet of the user in the period starting at periodStartDate.
+//
+// Tempo API docs: https://www.tempo.io/server-api-documentation/timesheets#timesheet-approval
+func (s *TimesheetApprovalService) ApproveWithContext(ctx context.Context, userKey, periodStartDate, comment string) (*TimesheetApproval, *Response, error) {
+	return s.act(ctx, userKey, periodStartDate, &TimesheetApprovalRequestAction{Name: TimesheetActionApprove, Comment: comment})
+}
+
+// Approve wraps ApproveWithContext using the background context.
+func (s *TimesheetApprovalService) Approve(userKey, periodStartDate, comment string) (*TimesheetApproval, *Response, error) {
+	return s.ApproveWithContext(context.Background(), userKey, periodStartDate, comment)
+}
+
+// RejectWithContext rejects the timesheet of the user in the period starting at periodStartDate.
+// The comment should tell the user what to correct before submitting the timesheet again.
+//
+// Tempo API docs: https://www.tempo.io/server-api-documentation/timesheets#timesheet-approval
+func (s *TimesheetApprovalService) RejectWithContext(ctx context.Context, userKey, periodStartDate, comment string) (*TimesheetApproval, *Response, error) {
+	return s.act(ctx, userKey, periodStartDate, &TimesheetApprovalRequestAction{Name: TimesheetActionReject, Comment: comment})
+}
+
+// Reject wraps RejectWithContext using the background context.
+func (s *TimesheetApprovalService) Reject(userKey, periodStartDate, comment string) (*TimesheetApproval, *Response, error) {
+	return s.RejectWithContext(context.Background(), userKey, periodStartDate, comment)
+}
+
+// ReopenWithContext reopens the approved or submitted timesheet of the user in the period starting at periodStartDate.
+//
+// Tempo API docs: https://www.tempo.io/server-api-documentation/timesheets#timesheet-approval
+func (s *TimesheetApprovalService) ReopenWithContext(ctx context.Context, userKey, periodStartDate, comment string) (*TimesheetApproval, *Response, error) {
+	return s.act(ctx, userKey, periodStartDate, &TimesheetApprovalRequestAction{Name: TimesheetActionReopen, Comment: comment})
+}
+
+// Reopen wraps ReopenWithContext using the background context.
+func (s *TimesheetApprovalService) Reopen(userKey, periodStartDate, comment string) (*TimesheetApproval, *Response, error) {
+	return s.ReopenWithContext(context.Background(), userKey, periodStartDate, comment)
+}
+
+func (s *TimesheetApprovalService) act(ctx context.Context, userKey, periodStartDate string, action *TimesheetApprovalRequestAction) (*TimesheetApproval, *Response, error) {
+	if userKey == "" || periodStartDate == "" {
+		return nil, nil, fmt.Errorf("the user key and the period start date are required")
+	}
+
+	payload := &TimesheetApprovalRequest{
+		User:   &TimesheetUser{Key: userKey},
+		Period: &TimesheetPeriod{DateFrom: periodStartDate},
+		Action: action,
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "POST", "rest/tempo-timesheets/4/timesheet-approval", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	approval := new(TimesheetApproval)
+	resp, err := s.client.Do(req, approval)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return approval, resp, nil
+}
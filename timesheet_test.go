@@ -0,0 +1,96 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestTimesheetApprovalService_GetStatus(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/tempo-timesheets/4/timesheet-approval/current", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/tempo-timesheets/4/timesheet-approval/current?periodStartDate=2020-03-01&userKey=fred")
+
+		fmt.Fprint(w, `{"user":{"key":"fred","displayName":"Fred F. User"},"status":{"key":"waiting_for_approval","comment":"March","reviewer":{"key":"boss"}},"period":{"periodView":"PERIOD","dateFrom":"2020-03-01","dateTo":"2020-03-31"},"actions":{"approve":{"name":"approve"},"reject":{"name":"reject"}},"workedSeconds":576000}`)
+	})
+
+	approval, _, err := testClient.TimesheetApproval.GetStatus(&TimesheetApprovalOptions{UserKey: "fred", PeriodStartDate: "2020-03-01"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if approval.Status.Key != TimesheetStatusWaitingForApproval {
+		t.Errorf("Expected status %q, got %q", TimesheetStatusWaitingForApproval, approval.Status.Key)
+	}
+	if approval.Period.DateTo != "2020-03-31" || approval.WorkedSeconds != 576000 {
+		t.Errorf("Unexpected approval %+v", approval)
+	}
+	if approval.Actions.Approve == nil || approval.Actions.Submit != nil {
+		t.Errorf("Unexpected actions %+v", approval.Actions)
+	}
+}
+
+func TestTimesheetApprovalService_GetPending(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/tempo-timesheets/4/timesheet-approval/pending", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/tempo-timesheets/4/timesheet-approval/pending?reviewerKey=boss")
+
+		fmt.Fprint(w, `[{"user":{"key":"fred"},"status":{"key":"waiting_for_approval"}},{"user":{"key":"wilma"},"status":{"key":"waiting_for_approval"}}]`)
+	})
+
+	approvals, _, err := testClient.TimesheetApproval.GetPending("boss")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(approvals) != 2 || approvals[1].User.Key != "wilma" {
+		t.Errorf("Unexpected approvals %+v", approvals)
+	}
+}
+
+func TestTimesheetApprovalService_Actions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var got TimesheetApprovalRequest
+	testMux.HandleFunc("/rest/tempo-timesheets/4/timesheet-approval", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		got = TimesheetApprovalRequest{}
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Errorf("Error in decoding body: %s", err)
+		}
+		fmt.Fprintf(w, `{"user":{"key":"fred"},"status":{"key":"%s"}}`, got.Action.Name)
+	})
+
+	approval, _, err := testClient.TimesheetApproval.Submit("fred", "2020-03-01", "boss", "March")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if got.User.Key != "fred" || got.Period.DateFrom != "2020-03-01" || got.Action.Reviewer.Key != "boss" || got.Action.Comment != "March" {
+		t.Errorf("Unexpected submit request %+v", got)
+	}
+	if approval.Status.Key != TimesheetActionSubmit {
+		t.Errorf("Unexpected status %q", approval.Status.Key)
+	}
+
+	if _, _, err := testClient.TimesheetApproval.Approve("fred", "2020-03-01", ""); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if got.Action.Name != TimesheetActionApprove || got.Action.Reviewer != nil {
+		t.Errorf("Unexpected approve request %+v", got.Action)
+	}
+
+	if _, _, err := testClient.TimesheetApproval.Reject("fred", "2020-03-01", "Missing Friday"); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if got.Action.Name != TimesheetActionReject || got.Action.Comment != "Missing Friday" {
+		t.Errorf("Unexpected reject request %+v", got.Action)
+	}
+
+	if _, _, err := testClient.TimesheetApproval.Reopen("", "2020-03-01", ""); err == nil {
+		t.Error("Expected an error without a user key")
+	}
+}
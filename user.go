@@ -1,9 +1,12 @@
 package jira
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"net/url"
+	"strings"
 )
 
 // UserService handles users for the JIRA instance / API.
@@ -38,6 +41,20 @@ type UserGroup struct {
 	Name string `json:"name,omitempty" structs:"name,omitempty"`
 }
 
+// ColumnItem represents a column of the issue navigator
+type ColumnItem struct {
+	Label string `json:"label,omitempty" structs:"label,omitempty"`
+	Value string `json:"value,omitempty" structs:"value,omitempty"`
+}
+
+// UserColumnsOptions identifies the user whose issue navigator columns are read or changed.
+// If neither is set, the columns of the calling user are used.
+// AccountID is used by JIRA Cloud, Username by JIRA Server.
+type UserColumnsOptions struct {
+	AccountID string `url:"accountId,omitempty"`
+	Username  string `url:"username,omitempty"`
+}
+
 type userSearchParam struct {
 	name  string
 	value string
@@ -47,12 +64,12 @@ type userSearch []userSearchParam
 
 type userSearchF func(userSearch) userSearch
 
-// Get gets user info from JIRA
+// GetWithContext gets user info from JIRA
 //
 // JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/user-getUser
-func (s *UserService) Get(username string) (*User, *Response, error) {
+func (s *UserService) GetWithContext(ctx context.Context, username string) (*User, *Response, error) {
 	apiEndpoint := fmt.Sprintf("/rest/api/2/user?username=%s", username)
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -65,19 +82,49 @@ func (s *UserService) Get(username string) (*User, *Response, error) {
 	return user, resp, nil
 }
 
-// Create creates an user in JIRA.
+// Get wraps GetWithContext using the background context.
+func (s *UserService) Get(username string) (*User, *Response, error) {
+	return s.GetWithContext(context.Background(), username)
+}
+
+// GetByAccountIDWithContext gets the user with the given account ID from JIRA Cloud.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-users/#api-rest-api-2-user-get
+func (s *UserService) GetByAccountIDWithContext(ctx context.Context, accountID string) (*User, *Response, error) {
+	return s.getUser(ctx, fmt.Sprintf("rest/api/2/user?accountId=%s", url.QueryEscape(accountID)))
+}
+
+// GetByAccountID wraps GetByAccountIDWithContext using the background context.
+func (s *UserService) GetByAccountID(accountID string) (*User, *Response, error) {
+	return s.GetByAccountIDWithContext(context.Background(), accountID)
+}
+
+// GetByUsernameWithContext gets the user with the given username from JIRA Server / Data Center.
+// Unlike Get, the username is escaped, so it may contain characters like "+" or "&".
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/user-getUser
+func (s *UserService) GetByUsernameWithContext(ctx context.Context, username string) (*User, *Response, error) {
+	return s.getUser(ctx, fmt.Sprintf("rest/api/2/user?username=%s", url.QueryEscape(username)))
+}
+
+// GetByUsername wraps GetByUsernameWithContext using the background context.
+func (s *UserService) GetByUsername(username string) (*User, *Response, error) {
+	return s.GetByUsernameWithContext(context.Background(), username)
+}
+
+// CreateWithContext creates an user in JIRA.
 //
 // JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/user-createUser
-func (s *UserService) Create(user *User) (*User, *Response, error) {
+func (s *UserService) CreateWithContext(ctx context.Context, user *User) (*User, *Response, error) {
 	apiEndpoint := "/rest/api/2/user"
-	req, err := s.client.NewRequest("POST", apiEndpoint, user)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, user)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	resp, err := s.client.Do(req, nil)
 	if err != nil {
-		return nil, resp, err
+		return nil, resp, NewJiraError(resp, err)
 	}
 
 	responseUser := new(User)
@@ -95,13 +142,18 @@ func (s *UserService) Create(user *User) (*User, *Response, error) {
 	return responseUser, resp, nil
 }
 
-// Delete deletes an user from JIRA.
+// Create wraps CreateWithContext using the background context.
+func (s *UserService) Create(user *User) (*User, *Response, error) {
+	return s.CreateWithContext(context.Background(), user)
+}
+
+// DeleteWithContext deletes an user from JIRA.
 // Returns http.StatusNoContent on success.
 //
 // JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/#api-api-2-user-delete
-func (s *UserService) Delete(username string) (*Response, error) {
+func (s *UserService) DeleteWithContext(ctx context.Context, username string) (*Response, error) {
 	apiEndpoint := fmt.Sprintf("/rest/api/2/user?username=%s", username)
-	req, err := s.client.NewRequest("DELETE", apiEndpoint, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -113,12 +165,17 @@ func (s *UserService) Delete(username string) (*Response, error) {
 	return resp, nil
 }
 
-// GetGroups returns the groups which the user belongs to
+// Delete wraps DeleteWithContext using the background context.
+func (s *UserService) Delete(username string) (*Response, error) {
+	return s.DeleteWithContext(context.Background(), username)
+}
+
+// GetGroupsWithContext returns the groups which the user belongs to
 //
 // JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/user-getUserGroups
-func (s *UserService) GetGroups(username string) (*[]UserGroup, *Response, error) {
+func (s *UserService) GetGroupsWithContext(ctx context.Context, username string) (*[]UserGroup, *Response, error) {
 	apiEndpoint := fmt.Sprintf("/rest/api/2/user/groups?username=%s", username)
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -131,12 +188,68 @@ func (s *UserService) GetGroups(username string) (*[]UserGroup, *Response, error
 	return userGroups, resp, nil
 }
 
+// GetGroups wraps GetGroupsWithContext using the background context.
+func (s *UserService) GetGroups(username string) (*[]UserGroup, *Response, error) {
+	return s.GetGroupsWithContext(context.Background(), username)
+}
+
+// GetGroupsByAccountIDWithContext returns the groups which the user with the given account ID belongs to. JIRA Cloud only.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-users/#api-rest-api-2-user-groups-get
+func (s *UserService) GetGroupsByAccountIDWithContext(ctx context.Context, accountID string) ([]UserGroup, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/user/groups?accountId=%s", url.QueryEscape(accountID))
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	userGroups := []UserGroup{}
+	resp, err := s.client.Do(req, &userGroups)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return userGroups, resp, nil
+}
+
+// GetGroupsByAccountID wraps GetGroupsByAccountIDWithContext using the background context.
+func (s *UserService) GetGroupsByAccountID(accountID string) ([]UserGroup, *Response, error) {
+	return s.GetGroupsByAccountIDWithContext(context.Background(), accountID)
+}
+
+// DeactivateWithContext deactivates the user with the given username. JIRA Data Center 8.3 and later only.
+// Deactivated users can not log in, but are kept in the history of the issues.
+// On JIRA Cloud, users are managed by the organization admin instead.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/user-updateUser
+func (s *UserService) DeactivateWithContext(ctx context.Context, username string) (*User, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/user?username=%s", url.QueryEscape(username))
+	payload := struct {
+		Active bool `json:"active"`
+	}{false}
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	user := new(User)
+	resp, err := s.client.Do(req, user)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return user, resp, nil
+}
+
+// Deactivate wraps DeactivateWithContext using the background context.
+func (s *UserService) Deactivate(username string) (*User, *Response, error) {
+	return s.DeactivateWithContext(context.Background(), username)
+}
+
 // Get information about the current logged-in user
 //
 // JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/#api-api-2-myself-get
-func (s *UserService) GetSelf() (*User, *Response, error) {
+func (s *UserService) GetSelfWithContext(ctx context.Context) (*User, *Response, error) {
 	const apiEndpoint = "rest/api/2/myself"
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -148,6 +261,11 @@ func (s *UserService) GetSelf() (*User, *Response, error) {
 	return &user, resp, nil
 }
 
+// GetSelf wraps GetSelfWithContext using the background context.
+func (s *UserService) GetSelf() (*User, *Response, error) {
+	return s.GetSelfWithContext(context.Background())
+}
+
 // WithMaxResults sets the max results to return
 func WithMaxResults(maxResults int) userSearchF {
 	return func(s userSearch) userSearch {
@@ -180,28 +298,46 @@ func WithInactive(inactive bool) userSearchF {
 	}
 }
 
-// Find searches for user info from JIRA:
-// It can find users by email, username or name
+// WithQuery searches the users by a query, which is matched against the display name and email address.
+// JIRA Cloud requires either a query or a property, pass an empty search string to Find to only search by the query.
+func WithQuery(query string) userSearchF {
+	return func(s userSearch) userSearch {
+		s = append(s, userSearchParam{name: "query", value: url.QueryEscape(query)})
+		return s
+	}
+}
+
+// WithProperty filters the users by a user property, e.g. "propertykey.something.nested=1".
+// The property is given as the key, the path of the value within the property and the value, joined by dots and "=".
+// Pass an empty search string to Find to search by the property only.
+func WithProperty(property string) userSearchF {
+	return func(s userSearch) userSearch {
+		s = append(s, userSearchParam{name: "property", value: url.QueryEscape(property)})
+		return s
+	}
+}
+
+// FindWithContext searches for user info from JIRA:
+// It can find users by email, username or name.
+// The username parameter is left out if property is empty, e.g. to search with WithProperty only.
 //
 // JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/user-findUsers
-func (s *UserService) Find(property string, tweaks ...userSearchF) ([]User, *Response, error) {
-	search := []userSearchParam{
-		{
-			name:  "username",
-			value: property,
-		},
+func (s *UserService) FindWithContext(ctx context.Context, property string, tweaks ...userSearchF) ([]User, *Response, error) {
+	search := []userSearchParam{}
+	if property != "" {
+		search = append(search, userSearchParam{name: "username", value: property})
 	}
 	for _, f := range tweaks {
 		search = f(search)
 	}
 
-	var queryString = ""
+	params := make([]string, 0, len(search))
 	for _, param := range search {
-		queryString += param.name + "=" + param.value + "&"
+		params = append(params, param.name+"="+param.value)
 	}
 
-	apiEndpoint := fmt.Sprintf("/rest/api/2/user/search?%s", queryString[:len(queryString)-1])
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	apiEndpoint := fmt.Sprintf("/rest/api/2/user/search?%s", strings.Join(params, "&"))
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -213,3 +349,100 @@ func (s *UserService) Find(property string, tweaks ...userSearchF) ([]User, *Res
 	}
 	return users, resp, nil
 }
+
+// Find wraps FindWithContext using the background context.
+func (s *UserService) Find(property string, tweaks ...userSearchF) ([]User, *Response, error) {
+	return s.FindWithContext(context.Background(), property, tweaks...)
+}
+
+// GetColumnsWithContext returns the default issue table columns of the user.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-users/#api-rest-api-2-user-columns-get
+func (s *UserService) GetColumnsWithContext(ctx context.Context, opts *UserColumnsOptions) ([]ColumnItem, *Response, error) {
+	apiEndpoint, err := addOptions("rest/api/2/user/columns", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	columns := []ColumnItem{}
+	resp, err := s.client.Do(req, &columns)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return columns, resp, nil
+}
+
+// GetColumns wraps GetColumnsWithContext using the background context.
+func (s *UserService) GetColumns(opts *UserColumnsOptions) ([]ColumnItem, *Response, error) {
+	return s.GetColumnsWithContext(context.Background(), opts)
+}
+
+// SetColumnsWithContext sets the default issue table columns of the user.
+// columns are the IDs of the fields, e.g. "issuetype", "summary" or "customfield_10000".
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-users/#api-rest-api-2-user-columns-put
+func (s *UserService) SetColumnsWithContext(ctx context.Context, opts *UserColumnsOptions, columns []string) (*Response, error) {
+	apiEndpoint, err := addOptions("rest/api/2/user/columns", opts)
+	if err != nil {
+		return nil, err
+	}
+	req, err := s.client.NewRawRequestWithContext(ctx, "PUT", apiEndpoint, strings.NewReader(url.Values{"columns": columns}.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// SetColumns wraps SetColumnsWithContext using the background context.
+func (s *UserService) SetColumns(opts *UserColumnsOptions, columns []string) (*Response, error) {
+	return s.SetColumnsWithContext(context.Background(), opts, columns)
+}
+
+// ResetColumnsWithContext resets the default issue table columns of the user to the system default.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-users/#api-rest-api-2-user-columns-delete
+func (s *UserService) ResetColumnsWithContext(ctx context.Context, opts *UserColumnsOptions) (*Response, error) {
+	apiEndpoint, err := addOptions("rest/api/2/user/columns", opts)
+	if err != nil {
+		return nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// ResetColumns wraps ResetColumnsWithContext using the background context.
+func (s *UserService) ResetColumns(opts *UserColumnsOptions) (*Response, error) {
+	return s.ResetColumnsWithContext(context.Background(), opts)
+}
+
+func (s *UserService) getUser(ctx context.Context, apiEndpoint string) (*User, *Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	user := new(User)
+	resp, err := s.client.Do(req, user)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return user, resp, nil
+}
@@ -2,6 +2,7 @@ package jira
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"testing"
 )
@@ -167,3 +168,170 @@ func TestUserService_Find_SuccessParams(t *testing.T) {
 		t.Error("Expected user. User is nil")
 	}
 }
+
+func TestUserService_Find_WithProperty(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/user/search?property=myapp.team%3Dplatform&maxResults=10")
+		if got := r.URL.Query().Get("property"); got != "myapp.team=platform" {
+			t.Errorf("Expected the property myapp.team=platform, got %q", got)
+		}
+
+		fmt.Fprint(w, `[{"accountId":"5b10ac8d82e05b22cc7d4ef5","displayName":"Fred F. User","active":true}]`)
+	})
+
+	users, _, err := testClient.User.Find("", WithProperty("myapp.team=platform"), WithMaxResults(10))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(users) != 1 || users[0].AccountID != "5b10ac8d82e05b22cc7d4ef5" {
+		t.Errorf("Unexpected users %+v", users)
+	}
+}
+
+func TestUserService_Find_WithQuery(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/user/search?query=fred+smith&startAt=50&maxResults=50")
+		fmt.Fprint(w, `[{"accountId":"5b10ac8d82e05b22cc7d4ef5","displayName":"Fred Smith"}]`)
+	})
+
+	users, _, err := testClient.User.Find("", WithQuery("fred smith"), WithStartAt(50), WithMaxResults(50))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(users) != 1 || users[0].DisplayName != "Fred Smith" {
+		t.Errorf("Unexpected users %+v", users)
+	}
+}
+
+func TestUserService_GetByAccountID(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/user?accountId=557058%3A7d1c6a6f")
+		fmt.Fprint(w, `{"accountId":"557058:7d1c6a6f","displayName":"Fred F. User","active":true}`)
+	})
+
+	user, _, err := testClient.User.GetByAccountID("557058:7d1c6a6f")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if user.AccountID != "557058:7d1c6a6f" {
+		t.Errorf("Unexpected user %+v", user)
+	}
+}
+
+func TestUserService_GetByUsername(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/user?username=fred%2Bjira")
+		fmt.Fprint(w, `{"key":"fred","name":"fred+jira","displayName":"Fred F. User"}`)
+	})
+
+	user, _, err := testClient.User.GetByUsername("fred+jira")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if user.Name != "fred+jira" {
+		t.Errorf("Unexpected user %+v", user)
+	}
+}
+
+func TestUserService_GetGroupsByAccountID(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/groups", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/user/groups?accountId=5b10ac8d82e05b22cc7d4ef5")
+		fmt.Fprint(w, `[{"name":"jira-software-users","self":"https://your-domain.atlassian.net/rest/api/2/group?groupname=jira-software-users"}]`)
+	})
+
+	groups, _, err := testClient.User.GetGroupsByAccountID("5b10ac8d82e05b22cc7d4ef5")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(groups) != 1 || groups[0].Name != "jira-software-users" {
+		t.Errorf("Unexpected groups %+v", groups)
+	}
+}
+
+func TestUserService_Deactivate(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, "/rest/api/2/user?username=fred")
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"active":false}`+"\n" {
+			t.Errorf("Unexpected body %s", body)
+		}
+		fmt.Fprint(w, `{"key":"fred","name":"fred","active":false}`)
+	})
+
+	user, _, err := testClient.User.Deactivate("fred")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if user.Active {
+		t.Error("Expected an inactive user")
+	}
+}
+
+func TestUserService_GetColumns(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/columns", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/user/columns?accountId=5b10a2844c20165700ede21g")
+		fmt.Fprint(w, `[{"label":"Key","value":"issuekey"},{"label":"Summary","value":"summary"}]`)
+	})
+
+	columns, _, err := testClient.User.GetColumns(&UserColumnsOptions{AccountID: "5b10a2844c20165700ede21g"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(columns) != 2 || columns[1].Value != "summary" {
+		t.Errorf("Unexpected columns %+v", columns)
+	}
+}
+
+func TestUserService_SetColumns(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/columns", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, "/rest/api/2/user/columns?username=fred")
+		if err := r.ParseForm(); err != nil {
+			t.Error(err)
+		}
+		if got := r.PostForm["columns"]; len(got) != 2 || got[0] != "issuekey" || got[1] != "summary" {
+			t.Errorf("Unexpected columns %v", got)
+		}
+	})
+
+	if _, err := testClient.User.SetColumns(&UserColumnsOptions{Username: "fred"}, []string{"issuekey", "summary"}); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestUserService_ResetColumns(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/columns", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, "/rest/api/2/user/columns")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.User.ResetColumns(nil); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
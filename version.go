@@ -1,9 +1,11 @@
 package jira
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"time"
 )
 
 // VersionService handles Versions for the JIRA instance / API.
@@ -27,12 +29,34 @@ type Version struct {
 	StartDate       string `json:"startDate,omitempty" structs:"startDate,omitempty"`
 }
 
-// Get gets version info from JIRA
+// These constants are the positions a version can be moved to with VersionMoveOptions.Position
+const (
+	VersionPositionEarlier = "Earlier"
+	VersionPositionLater   = "Later"
+	VersionPositionFirst   = "First"
+	VersionPositionLast    = "Last"
+)
+
+// VersionMoveOptions specifies where the Move method moves a version to.
+// Either After, the URL of the version it is moved after, or Position has to be set.
+type VersionMoveOptions struct {
+	After    string `json:"after,omitempty" structs:"after,omitempty"`
+	Position string `json:"position,omitempty" structs:"position,omitempty"`
+}
+
+// VersionUnresolvedIssueCount is the number of issues and unresolved issues of a version
+type VersionUnresolvedIssueCount struct {
+	Self                  string `json:"self,omitempty" structs:"self,omitempty"`
+	IssuesCount           int    `json:"issuesCount" structs:"issuesCount"`
+	IssuesUnresolvedCount int    `json:"issuesUnresolvedCount" structs:"issuesUnresolvedCount"`
+}
+
+// GetWithContext gets version info from JIRA
 //
 // JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/#api-api-2-version-id-get
-func (s *VersionService) Get(versionID int) (*Version, *Response, error) {
+func (s *VersionService) GetWithContext(ctx context.Context, versionID int) (*Version, *Response, error) {
 	apiEndpoint := fmt.Sprintf("/rest/api/2/version/%v", versionID)
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -45,19 +69,24 @@ func (s *VersionService) Get(versionID int) (*Version, *Response, error) {
 	return version, resp, nil
 }
 
-// Create creates a version in JIRA.
+// Get wraps GetWithContext using the background context.
+func (s *VersionService) Get(versionID int) (*Version, *Response, error) {
+	return s.GetWithContext(context.Background(), versionID)
+}
+
+// CreateWithContext creates a version in JIRA.
 //
 // JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/#api-api-2-version-post
-func (s *VersionService) Create(version *Version) (*Version, *Response, error) {
+func (s *VersionService) CreateWithContext(ctx context.Context, version *Version) (*Version, *Response, error) {
 	apiEndpoint := "/rest/api/2/version"
-	req, err := s.client.NewRequest("POST", apiEndpoint, version)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, version)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	resp, err := s.client.Do(req, nil)
 	if err != nil {
-		return nil, resp, err
+		return nil, resp, NewJiraError(resp, err)
 	}
 
 	responseVersion := new(Version)
@@ -75,12 +104,17 @@ func (s *VersionService) Create(version *Version) (*Version, *Response, error) {
 	return responseVersion, resp, nil
 }
 
-// Update updates a version from a JSON representation.
+// Create wraps CreateWithContext using the background context.
+func (s *VersionService) Create(version *Version) (*Version, *Response, error) {
+	return s.CreateWithContext(context.Background(), version)
+}
+
+// UpdateWithContext updates a version from a JSON representation.
 //
 // JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/#api-api-2-version-id-put
-func (s *VersionService) Update(version *Version) (*Version, *Response, error) {
+func (s *VersionService) UpdateWithContext(ctx context.Context, version *Version) (*Version, *Response, error) {
 	apiEndpoint := fmt.Sprintf("rest/api/2/version/%v", version.ID)
-	req, err := s.client.NewRequest("PUT", apiEndpoint, version)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, version)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -95,3 +129,105 @@ func (s *VersionService) Update(version *Version) (*Version, *Response, error) {
 	ret := *version
 	return &ret, resp, nil
 }
+
+// Update wraps UpdateWithContext using the background context.
+func (s *VersionService) Update(version *Version) (*Version, *Response, error) {
+	return s.UpdateWithContext(context.Background(), version)
+}
+
+// ReleaseWithContext marks the version as released on releaseDate, or today if releaseDate is zero.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-project-versions/#api-rest-api-2-version-id-put
+func (s *VersionService) ReleaseWithContext(ctx context.Context, versionID int, releaseDate time.Time) (*Version, *Response, error) {
+	if releaseDate.IsZero() {
+		releaseDate = time.Now()
+	}
+	apiEndpoint := fmt.Sprintf("rest/api/2/version/%v", versionID)
+	release := &Version{Released: true, ReleaseDate: releaseDate.Format("2006-01-02")}
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, release)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	version := new(Version)
+	resp, err := s.client.Do(req, version)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return version, resp, nil
+}
+
+// Release wraps ReleaseWithContext using the background context.
+func (s *VersionService) Release(versionID int, releaseDate time.Time) (*Version, *Response, error) {
+	return s.ReleaseWithContext(context.Background(), versionID, releaseDate)
+}
+
+// MoveWithContext moves the version to a new position in the version list of its project.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-project-versions/#api-rest-api-2-version-id-move-post
+func (s *VersionService) MoveWithContext(ctx context.Context, versionID int, options *VersionMoveOptions) (*Version, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/version/%v/move", versionID)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	version := new(Version)
+	resp, err := s.client.Do(req, version)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return version, resp, nil
+}
+
+// Move wraps MoveWithContext using the background context.
+func (s *VersionService) Move(versionID int, options *VersionMoveOptions) (*Version, *Response, error) {
+	return s.MoveWithContext(context.Background(), versionID, options)
+}
+
+// MergeWithContext merges the version into the version moveIssuesTo. The issues of the version are moved
+// to moveIssuesTo and the version is deleted.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-project-versions/#api-rest-api-2-version-id-mergeto-moveissuesto-put
+func (s *VersionService) MergeWithContext(ctx context.Context, versionID, moveIssuesTo int) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/version/%v/mergeto/%v", versionID, moveIssuesTo)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// Merge wraps MergeWithContext using the background context.
+func (s *VersionService) Merge(versionID, moveIssuesTo int) (*Response, error) {
+	return s.MergeWithContext(context.Background(), versionID, moveIssuesTo)
+}
+
+// GetUnresolvedIssueCountWithContext returns the number of issues and unresolved issues of the version,
+// e.g. to check that all issues are done before releasing it.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-project-versions/#api-rest-api-2-version-id-unresolvedissuecount-get
+func (s *VersionService) GetUnresolvedIssueCountWithContext(ctx context.Context, versionID int) (*VersionUnresolvedIssueCount, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/version/%v/unresolvedIssueCount", versionID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	count := new(VersionUnresolvedIssueCount)
+	resp, err := s.client.Do(req, count)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return count, resp, nil
+}
+
+// GetUnresolvedIssueCount wraps GetUnresolvedIssueCountWithContext using the background context.
+func (s *VersionService) GetUnresolvedIssueCount(versionID int) (*VersionUnresolvedIssueCount, *Response, error) {
+	return s.GetUnresolvedIssueCountWithContext(context.Background(), versionID)
+}
@@ -2,8 +2,10 @@ package jira
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"testing"
+	"time"
 )
 
 func TestVersionService_Get_Success(t *testing.T) {
@@ -109,3 +111,79 @@ func TestServiceService_Update(t *testing.T) {
 		t.Errorf("Error given: %s", err)
 	}
 }
+
+func TestVersionService_Release(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/version/10002", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, "/rest/api/2/version/10002")
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"released":true,"releaseDate":"2021-03-04"}`+"\n" {
+			t.Errorf("Unexpected body %s", body)
+		}
+		fmt.Fprint(w, `{"id":"10002","name":"1.0","released":true,"releaseDate":"2021-03-04","projectId":10000}`)
+	})
+
+	version, _, err := testClient.Version.Release(10002, time.Date(2021, 3, 4, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if !version.Released || version.ReleaseDate != "2021-03-04" {
+		t.Errorf("Unexpected version %+v", version)
+	}
+}
+
+func TestVersionService_Move(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/version/10002/move", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, "/rest/api/2/version/10002/move")
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"position":"Last"}`+"\n" {
+			t.Errorf("Unexpected body %s", body)
+		}
+		fmt.Fprint(w, `{"id":"10002","name":"1.0"}`)
+	})
+
+	version, _, err := testClient.Version.Move(10002, &VersionMoveOptions{Position: VersionPositionLast})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if version.ID != "10002" {
+		t.Errorf("Unexpected version %+v", version)
+	}
+}
+
+func TestVersionService_Merge(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/version/10002/mergeto/10003", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, "/rest/api/2/version/10002/mergeto/10003")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Version.Merge(10002, 10003); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestVersionService_GetUnresolvedIssueCount(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/version/10002/unresolvedIssueCount", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/version/10002/unresolvedIssueCount")
+		fmt.Fprint(w, `{"self":"https://your-domain.atlassian.net/rest/api/2/version/10002","issuesCount":30,"issuesUnresolvedCount":23}`)
+	})
+
+	count, _, err := testClient.Version.GetUnresolvedIssueCount(10002)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if count.IssuesCount != 30 || count.IssuesUnresolvedCount != 23 {
+		t.Errorf("Unexpected count %+v", count)
+	}
+}
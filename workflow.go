@@ -0,0 +1,365 @@
+package jira
+
+import "context"
+
+// WorkflowService handles workflows for the JIRA instance / API.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-workflows/
+type WorkflowService struct {
+	client *Client
+}
+
+// These constants are the types of a WorkflowScope
+const (
+	WorkflowScopeGlobal  = "GLOBAL"
+	WorkflowScopeProject = "PROJECT"
+)
+
+// These constants are the types of a WorkflowTransition
+const (
+	WorkflowTransitionTypeInitial  = "INITIAL"
+	WorkflowTransitionTypeGlobal   = "GLOBAL"
+	WorkflowTransitionTypeDirected = "DIRECTED"
+)
+
+// These constants are the operations of a WorkflowConditionGroup
+const (
+	WorkflowConditionOperationAll = "ALL"
+	WorkflowConditionOperationAny = "ANY"
+)
+
+// WorkflowScope defines if a workflow (or status) is available globally or only in a single project
+type WorkflowScope struct {
+	// Type is either WorkflowScopeGlobal or WorkflowScopeProject
+	Type    string                    `json:"type" structs:"type"`
+	Project *WorkflowProjectReference `json:"project,omitempty" structs:"project,omitempty"`
+}
+
+// WorkflowProjectReference is the project of a project scoped workflow
+type WorkflowProjectReference struct {
+	ID string `json:"id" structs:"id"`
+}
+
+// WorkflowStatus represents a status which is created, updated or referenced by a workflow payload.
+// StatusReference is a unique identifier of the status within the payload, used by the
+// layouts and transitions of the workflows. For existing statuses ID has to be set as well.
+type WorkflowStatus struct {
+	ID              string         `json:"id,omitempty" structs:"id,omitempty"`
+	Name            string         `json:"name" structs:"name"`
+	Description     string         `json:"description,omitempty" structs:"description,omitempty"`
+	StatusCategory  string         `json:"statusCategory" structs:"statusCategory"`
+	StatusReference string         `json:"statusReference" structs:"statusReference"`
+	Scope           *WorkflowScope `json:"scope,omitempty" structs:"scope,omitempty"`
+}
+
+// WorkflowLayout is the position of a status in the workflow diagram
+type WorkflowLayout struct {
+	X float64 `json:"x" structs:"x"`
+	Y float64 `json:"y" structs:"y"`
+}
+
+// WorkflowStatusLayout places a status, identified by its StatusReference, in a workflow
+type WorkflowStatusLayout struct {
+	StatusReference string            `json:"statusReference" structs:"statusReference"`
+	Layout          *WorkflowLayout   `json:"layout,omitempty" structs:"layout,omitempty"`
+	Properties      map[string]string `json:"properties,omitempty" structs:"properties,omitempty"`
+}
+
+// WorkflowRuleConfiguration represents a rule (condition, validator or post function) of a transition
+type WorkflowRuleConfiguration struct {
+	ID         string            `json:"id,omitempty" structs:"id,omitempty"`
+	RuleKey    string            `json:"ruleKey" structs:"ruleKey"`
+	Parameters map[string]string `json:"parameters,omitempty" structs:"parameters,omitempty"`
+}
+
+// WorkflowConditionGroup combines conditions and nested condition groups with an operation
+type WorkflowConditionGroup struct {
+	// Operation is either WorkflowConditionOperationAll or WorkflowConditionOperationAny
+	Operation       string                      `json:"operation" structs:"operation"`
+	Conditions      []WorkflowRuleConfiguration `json:"conditions,omitempty" structs:"conditions,omitempty"`
+	ConditionGroups []WorkflowConditionGroup    `json:"conditionGroups,omitempty" structs:"conditionGroups,omitempty"`
+}
+
+// WorkflowTransitionLink connects a transition with the status it starts from
+type WorkflowTransitionLink struct {
+	FromStatusReference string `json:"fromStatusReference,omitempty" structs:"fromStatusReference,omitempty"`
+	FromPort            *int   `json:"fromPort,omitempty" structs:"fromPort,omitempty"`
+	ToPort              *int   `json:"toPort,omitempty" structs:"toPort,omitempty"`
+}
+
+// WorkflowTransition represents a transition of a workflow including its rules
+type WorkflowTransition struct {
+	ID          string `json:"id,omitempty" structs:"id,omitempty"`
+	Name        string `json:"name" structs:"name"`
+	Description string `json:"description,omitempty" structs:"description,omitempty"`
+	// Type is one of WorkflowTransitionTypeInitial, WorkflowTransitionTypeGlobal or WorkflowTransitionTypeDirected
+	Type              string                      `json:"type" structs:"type"`
+	ToStatusReference string                      `json:"toStatusReference" structs:"toStatusReference"`
+	Links             []WorkflowTransitionLink    `json:"links,omitempty" structs:"links,omitempty"`
+	Actions           []WorkflowRuleConfiguration `json:"actions,omitempty" structs:"actions,omitempty"`
+	Validators        []WorkflowRuleConfiguration `json:"validators,omitempty" structs:"validators,omitempty"`
+	Conditions        *WorkflowConditionGroup     `json:"conditions,omitempty" structs:"conditions,omitempty"`
+	Properties        map[string]string           `json:"properties,omitempty" structs:"properties,omitempty"`
+}
+
+// WorkflowVersion is the version of a workflow.
+// It is required to update a workflow and protects against concurrent updates.
+type WorkflowVersion struct {
+	ID            string `json:"id" structs:"id"`
+	VersionNumber int    `json:"versionNumber" structs:"versionNumber"`
+}
+
+// Workflow represents a workflow with its statuses and transitions
+type Workflow struct {
+	ID          string                 `json:"id,omitempty" structs:"id,omitempty"`
+	Name        string                 `json:"name,omitempty" structs:"name,omitempty"`
+	Description string                 `json:"description,omitempty" structs:"description,omitempty"`
+	Scope       *WorkflowScope         `json:"scope,omitempty" structs:"scope,omitempty"`
+	Version     *WorkflowVersion       `json:"version,omitempty" structs:"version,omitempty"`
+	Statuses    []WorkflowStatusLayout `json:"statuses" structs:"statuses"`
+	Transitions []WorkflowTransition   `json:"transitions" structs:"transitions"`
+	IsEditable  bool                   `json:"isEditable,omitempty" structs:"isEditable,omitempty"`
+}
+
+// WorkflowCreatePayload is passed to WorkflowService.Create.
+// All statuses used by the workflows have to be part of Statuses.
+type WorkflowCreatePayload struct {
+	Scope     WorkflowScope    `json:"scope" structs:"scope"`
+	Statuses  []WorkflowStatus `json:"statuses" structs:"statuses"`
+	Workflows []Workflow       `json:"workflows" structs:"workflows"`
+}
+
+// WorkflowUpdatePayload is passed to WorkflowService.Update.
+// Each workflow has to contain its ID and current Version.
+type WorkflowUpdatePayload struct {
+	Statuses  []WorkflowStatus `json:"statuses" structs:"statuses"`
+	Workflows []Workflow       `json:"workflows" structs:"workflows"`
+}
+
+// WorkflowsResult is returned by WorkflowService.Create and WorkflowService.Update
+type WorkflowsResult struct {
+	Statuses  []WorkflowStatus `json:"statuses" structs:"statuses"`
+	Workflows []Workflow       `json:"workflows" structs:"workflows"`
+	// TaskID is set if an update requires an asynchronous migration of issues
+	TaskID string `json:"taskId,omitempty" structs:"taskId,omitempty"`
+}
+
+// CreateWithContext creates workflows and the statuses they use in a single request.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-workflows/#api-rest-api-2-workflows-create-post
+func (s *WorkflowService) CreateWithContext(ctx context.Context, payload *WorkflowCreatePayload) (*WorkflowsResult, *Response, error) {
+	apiEndpoint := "rest/api/2/workflows/create"
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(WorkflowsResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result, resp, nil
+}
+
+// Create wraps CreateWithContext using the background context.
+func (s *WorkflowService) Create(payload *WorkflowCreatePayload) (*WorkflowsResult, *Response, error) {
+	return s.CreateWithContext(context.Background(), payload)
+}
+
+// UpdateWithContext updates workflows and the statuses they use in a single request.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-workflows/#api-rest-api-2-workflows-update-post
+func (s *WorkflowService) UpdateWithContext(ctx context.Context, payload *WorkflowUpdatePayload) (*WorkflowsResult, *Response, error) {
+	apiEndpoint := "rest/api/2/workflows/update"
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(WorkflowsResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result, resp, nil
+}
+
+// Update wraps UpdateWithContext using the background context.
+func (s *WorkflowService) Update(payload *WorkflowUpdatePayload) (*WorkflowsResult, *Response, error) {
+	return s.UpdateWithContext(context.Background(), payload)
+}
+
+// These constants are the types of the workflow rules which can be provided by Connect apps
+const (
+	WorkflowRuleTypePostFunction = "postfunction"
+	WorkflowRuleTypeCondition    = "condition"
+	WorkflowRuleTypeValidator    = "validator"
+)
+
+// WorkflowID identifies a workflow, or its draft
+type WorkflowID struct {
+	Name  string `json:"name" structs:"name"`
+	Draft bool   `json:"draft" structs:"draft"`
+}
+
+// ConnectWorkflowRuleConfiguration is the configuration of a workflow rule provided by a Connect app
+type ConnectWorkflowRuleConfiguration struct {
+	// Value is the configuration of the rule, as stored by the app. Usually a JSON document.
+	Value    string `json:"value" structs:"value"`
+	Disabled bool   `json:"disabled,omitempty" structs:"disabled,omitempty"`
+	Tag      string `json:"tag,omitempty" structs:"tag,omitempty"`
+}
+
+// ConnectWorkflowTransition is the transition a workflow rule belongs to
+type ConnectWorkflowTransition struct {
+	ID   int    `json:"id" structs:"id"`
+	Name string `json:"name" structs:"name"`
+}
+
+// ConnectWorkflowTransitionRule represents a post function, condition or validator provided by a Connect app
+type ConnectWorkflowTransitionRule struct {
+	ID            string                           `json:"id" structs:"id"`
+	Key           string                           `json:"key,omitempty" structs:"key,omitempty"`
+	Configuration ConnectWorkflowRuleConfiguration `json:"configuration" structs:"configuration"`
+	Transition    *ConnectWorkflowTransition       `json:"transition,omitempty" structs:"transition,omitempty"`
+}
+
+// WorkflowTransitionRules are the Connect app rules of a workflow
+type WorkflowTransitionRules struct {
+	WorkflowID    WorkflowID                      `json:"workflowId" structs:"workflowId"`
+	PostFunctions []ConnectWorkflowTransitionRule `json:"postFunctions" structs:"postFunctions"`
+	Conditions    []ConnectWorkflowTransitionRule `json:"conditions" structs:"conditions"`
+	Validators    []ConnectWorkflowTransitionRule `json:"validators" structs:"validators"`
+}
+
+// WorkflowTransitionRulesList reflects a list of workflow transition rules
+type WorkflowTransitionRulesList struct {
+	MaxResults int                       `json:"maxResults" structs:"maxResults"`
+	StartAt    int                       `json:"startAt" structs:"startAt"`
+	Total      int                       `json:"total" structs:"total"`
+	IsLast     bool                      `json:"isLast" structs:"isLast"`
+	Values     []WorkflowTransitionRules `json:"values" structs:"values"`
+}
+
+func (l *WorkflowTransitionRulesList) pageInfo() PageInfo {
+	return PageInfo{StartAt: l.StartAt, MaxResults: l.MaxResults, Total: l.Total, IsLast: l.IsLast}
+}
+
+// WorkflowRuleConfigOptions specifies the parameters to the WorkflowService.GetRuleConfigs method
+type WorkflowRuleConfigOptions struct {
+	// Types are the types of the rules to return, see the WorkflowRuleType* constants. Required.
+	Types []string `url:"types"`
+	// Keys are the keys of the rules to return
+	Keys []string `url:"keys,omitempty"`
+	// WorkflowNames are the names of the workflows to return rules for
+	WorkflowNames []string `url:"workflowNames,omitempty"`
+	// WithTags only returns rules with any of the given tags
+	WithTags []string `url:"withTags,omitempty"`
+	// Draft returns the rules of the draft workflows instead of the published ones
+	Draft bool `url:"draft,omitempty"`
+	// Expand "transition" to include the transition of each rule
+	Expand string `url:"expand,omitempty"`
+
+	StartAt    int `url:"startAt,omitempty"`
+	MaxResults int `url:"maxResults,omitempty"`
+}
+
+// WorkflowTransitionRulesUpdateError reports the failed updates of a single workflow
+type WorkflowTransitionRulesUpdateError struct {
+	WorkflowID WorkflowID `json:"workflowId" structs:"workflowId"`
+	// RuleUpdateErrors maps the IDs of the rules to their errors
+	RuleUpdateErrors map[string][]string `json:"ruleUpdateErrors" structs:"ruleUpdateErrors"`
+	UpdateErrors     []string            `json:"updateErrors" structs:"updateErrors"`
+}
+
+// WorkflowTransitionRulesUpdateResult is returned by WorkflowService.UpdateRuleConfigs and WorkflowService.DeleteRuleConfigs
+type WorkflowTransitionRulesUpdateResult struct {
+	UpdateResults []WorkflowTransitionRulesUpdateError `json:"updateResults" structs:"updateResults"`
+}
+
+// WorkflowTransitionRulesDetails identifies the rules of a workflow which should be deleted
+type WorkflowTransitionRulesDetails struct {
+	WorkflowID      WorkflowID `json:"workflowId" structs:"workflowId"`
+	WorkflowRuleIDs []string   `json:"workflowRuleIds" structs:"workflowRuleIds"`
+}
+
+// GetRuleConfigsWithContext returns the workflows with the transition rules (post functions, conditions and validators)
+// provided by the calling Connect app. Only Connect apps can use this resource.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-workflow-transition-rules/#api-rest-api-2-workflow-rule-config-get
+func (s *WorkflowService) GetRuleConfigsWithContext(ctx context.Context, options *WorkflowRuleConfigOptions) (*WorkflowTransitionRulesList, *Response, error) {
+	apiEndpoint, err := addOptions("rest/api/2/workflow/rule/config", options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(WorkflowTransitionRulesList)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result, resp, nil
+}
+
+// GetRuleConfigs wraps GetRuleConfigsWithContext using the background context.
+func (s *WorkflowService) GetRuleConfigs(options *WorkflowRuleConfigOptions) (*WorkflowTransitionRulesList, *Response, error) {
+	return s.GetRuleConfigsWithContext(context.Background(), options)
+}
+
+// UpdateRuleConfigsWithContext updates the configuration of the transition rules provided by the calling Connect app.
+// The rules are identified by their ID, the configuration value of each rule is replaced.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-workflow-transition-rules/#api-rest-api-2-workflow-rule-config-put
+func (s *WorkflowService) UpdateRuleConfigsWithContext(ctx context.Context, workflows []WorkflowTransitionRules) (*WorkflowTransitionRulesUpdateResult, *Response, error) {
+	apiEndpoint := "rest/api/2/workflow/rule/config"
+	payload := struct {
+		Workflows []WorkflowTransitionRules `json:"workflows"`
+	}{workflows}
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(WorkflowTransitionRulesUpdateResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result, resp, nil
+}
+
+// UpdateRuleConfigs wraps UpdateRuleConfigsWithContext using the background context.
+func (s *WorkflowService) UpdateRuleConfigs(workflows []WorkflowTransitionRules) (*WorkflowTransitionRulesUpdateResult, *Response, error) {
+	return s.UpdateRuleConfigsWithContext(context.Background(), workflows)
+}
+
+// DeleteRuleConfigsWithContext deletes transition rules provided by the calling Connect app from workflows.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-workflow-transition-rules/#api-rest-api-2-workflow-rule-config-delete-put
+func (s *WorkflowService) DeleteRuleConfigsWithContext(ctx context.Context, workflows []WorkflowTransitionRulesDetails) (*WorkflowTransitionRulesUpdateResult, *Response, error) {
+	apiEndpoint := "rest/api/2/workflow/rule/config/delete"
+	payload := struct {
+		Workflows []WorkflowTransitionRulesDetails `json:"workflows"`
+	}{workflows}
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(WorkflowTransitionRulesUpdateResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result, resp, nil
+}
+
+// DeleteRuleConfigs wraps DeleteRuleConfigsWithContext using the background context.
+func (s *WorkflowService) DeleteRuleConfigs(workflows []WorkflowTransitionRulesDetails) (*WorkflowTransitionRulesUpdateResult, *Response, error) {
+	return s.DeleteRuleConfigsWithContext(context.Background(), workflows)
+}
@@ -0,0 +1,201 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestWorkflowService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/workflows/create"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload WorkflowCreatePayload
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Error(err)
+		}
+		if payload.Scope.Type != WorkflowScopeGlobal {
+			t.Errorf("Expected scope GLOBAL. Got %s", payload.Scope.Type)
+		}
+		if len(payload.Workflows) != 1 || len(payload.Workflows[0].Transitions) != 2 {
+			t.Fatalf("Unexpected workflows %+v", payload.Workflows)
+		}
+		if c := payload.Workflows[0].Transitions[1].Conditions; c == nil || c.Conditions[0].RuleKey != "system:restrict-issue-transition" {
+			t.Errorf("Unexpected conditions %+v", c)
+		}
+
+		fmt.Fprint(w, `{"statuses":[{"id":"10001","name":"To Do","statusCategory":"TODO","statusReference":"todo","scope":{"type":"GLOBAL"}},{"id":"10002","name":"Done","statusCategory":"DONE","statusReference":"done","scope":{"type":"GLOBAL"}}],"workflows":[{"id":"b9ff2384-d3b6-4d4e-9509-3ee19f607168","name":"Software workflow","scope":{"type":"GLOBAL"},"version":{"id":"f010ac1b-3dd3-43a3-aa66-0ee8a447f76e","versionNumber":0},"statuses":[{"statusReference":"todo"},{"statusReference":"done"}],"transitions":[{"id":"1","name":"Create","type":"INITIAL","toStatusReference":"todo"},{"id":"11","name":"Done","type":"DIRECTED","toStatusReference":"done","links":[{"fromStatusReference":"todo"}]}]}]}`)
+	})
+
+	payload := &WorkflowCreatePayload{
+		Scope: WorkflowScope{Type: WorkflowScopeGlobal},
+		Statuses: []WorkflowStatus{
+			{Name: "To Do", StatusCategory: "TODO", StatusReference: "todo"},
+			{Name: "Done", StatusCategory: "DONE", StatusReference: "done"},
+		},
+		Workflows: []Workflow{{
+			Name:     "Software workflow",
+			Statuses: []WorkflowStatusLayout{{StatusReference: "todo"}, {StatusReference: "done"}},
+			Transitions: []WorkflowTransition{
+				{Name: "Create", Type: WorkflowTransitionTypeInitial, ToStatusReference: "todo"},
+				{
+					Name:              "Done",
+					Type:              WorkflowTransitionTypeDirected,
+					ToStatusReference: "done",
+					Links:             []WorkflowTransitionLink{{FromStatusReference: "todo"}},
+					Conditions: &WorkflowConditionGroup{
+						Operation:  WorkflowConditionOperationAll,
+						Conditions: []WorkflowRuleConfiguration{{RuleKey: "system:restrict-issue-transition", Parameters: map[string]string{"roleIds": "10002"}}},
+					},
+				},
+			},
+		}},
+	}
+
+	result, _, err := testClient.Workflow.Create(payload)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(result.Workflows) != 1 || result.Workflows[0].Version == nil || result.Workflows[0].Version.ID != "f010ac1b-3dd3-43a3-aa66-0ee8a447f76e" {
+		t.Errorf("Unexpected workflows %+v", result.Workflows)
+	}
+	if len(result.Statuses) != 2 || result.Statuses[1].ID != "10002" {
+		t.Errorf("Unexpected statuses %+v", result.Statuses)
+	}
+}
+
+func TestWorkflowService_Update(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/workflows/update"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload WorkflowUpdatePayload
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Error(err)
+		}
+		if len(payload.Workflows) != 1 || payload.Workflows[0].Version == nil || payload.Workflows[0].Version.VersionNumber != 3 {
+			t.Errorf("Unexpected workflows %+v", payload.Workflows)
+		}
+
+		fmt.Fprint(w, `{"statuses":[],"workflows":[{"id":"b9ff2384-d3b6-4d4e-9509-3ee19f607168","name":"Software workflow","version":{"id":"f010ac1b-3dd3-43a3-aa66-0ee8a447f76e","versionNumber":4},"statuses":[],"transitions":[]}],"taskId":"10050"}`)
+	})
+
+	result, _, err := testClient.Workflow.Update(&WorkflowUpdatePayload{
+		Workflows: []Workflow{{
+			ID:          "b9ff2384-d3b6-4d4e-9509-3ee19f607168",
+			Description: "Updated",
+			Version:     &WorkflowVersion{ID: "f010ac1b-3dd3-43a3-aa66-0ee8a447f76e", VersionNumber: 3},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if result.TaskID != "10050" || result.Workflows[0].Version.VersionNumber != 4 {
+		t.Errorf("Unexpected result %+v", result)
+	}
+}
+
+func TestWorkflowService_GetRuleConfigs(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/workflow/rule/config"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/workflow/rule/config?expand=transition&types=postfunction&workflowNames=Software+workflow")
+
+		fmt.Fprint(w, `{"maxResults":10,"startAt":0,"total":1,"isLast":true,"values":[{"workflowId":{"name":"Software workflow","draft":false},"postFunctions":[{"id":"b4d6cbdc-59f5-11e9-8647-d663bd873d93","key":"postfunction-key","configuration":{"value":"{ \"color\": \"red\" }","disabled":false,"tag":"Sample tag"},"transition":{"id":1,"name":"Open"}}],"conditions":[],"validators":[]}]}`)
+	})
+
+	result, _, err := testClient.Workflow.GetRuleConfigs(&WorkflowRuleConfigOptions{
+		Types:         []string{WorkflowRuleTypePostFunction},
+		WorkflowNames: []string{"Software workflow"},
+		Expand:        "transition",
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(result.Values) != 1 || len(result.Values[0].PostFunctions) != 1 {
+		t.Fatalf("Unexpected result %+v", result)
+	}
+	postFunction := result.Values[0].PostFunctions[0]
+	if postFunction.Configuration.Tag != "Sample tag" || postFunction.Transition == nil || postFunction.Transition.ID != 1 {
+		t.Errorf("Unexpected post function %+v", postFunction)
+	}
+}
+
+func TestWorkflowService_UpdateRuleConfigs(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/workflow/rule/config"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload struct {
+			Workflows []WorkflowTransitionRules `json:"workflows"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Error(err)
+		}
+		if len(payload.Workflows) != 1 || payload.Workflows[0].PostFunctions[0].Configuration.Value != `{"color":"blue"}` {
+			t.Errorf("Unexpected payload %+v", payload)
+		}
+
+		fmt.Fprint(w, `{"updateResults":[{"workflowId":{"name":"Software workflow","draft":false},"ruleUpdateErrors":{},"updateErrors":[]}]}`)
+	})
+
+	result, _, err := testClient.Workflow.UpdateRuleConfigs([]WorkflowTransitionRules{{
+		WorkflowID: WorkflowID{Name: "Software workflow"},
+		PostFunctions: []ConnectWorkflowTransitionRule{{
+			ID:            "b4d6cbdc-59f5-11e9-8647-d663bd873d93",
+			Configuration: ConnectWorkflowRuleConfiguration{Value: `{"color":"blue"}`},
+		}},
+	}})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(result.UpdateResults) != 1 || len(result.UpdateResults[0].UpdateErrors) != 0 {
+		t.Errorf("Unexpected result %+v", result)
+	}
+}
+
+func TestWorkflowService_DeleteRuleConfigs(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/workflow/rule/config/delete"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload struct {
+			Workflows []WorkflowTransitionRulesDetails `json:"workflows"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Error(err)
+		}
+		if len(payload.Workflows) != 1 || payload.Workflows[0].WorkflowRuleIDs[0] != "b4d6cbdc-59f5-11e9-8647-d663bd873d93" {
+			t.Errorf("Unexpected payload %+v", payload)
+		}
+
+		fmt.Fprint(w, `{"updateResults":[{"workflowId":{"name":"Software workflow","draft":false},"ruleUpdateErrors":{"b4d6cbdc-59f5-11e9-8647-d663bd873d93":["Rule not found"]},"updateErrors":[]}]}`)
+	})
+
+	result, _, err := testClient.Workflow.DeleteRuleConfigs([]WorkflowTransitionRulesDetails{{
+		WorkflowID:      WorkflowID{Name: "Software workflow"},
+		WorkflowRuleIDs: []string{"b4d6cbdc-59f5-11e9-8647-d663bd873d93"},
+	}})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if errs := result.UpdateResults[0].RuleUpdateErrors["b4d6cbdc-59f5-11e9-8647-d663bd873d93"]; len(errs) != 1 {
+		t.Errorf("Unexpected result %+v", result)
+	}
+}
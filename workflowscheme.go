@@ -0,0 +1,309 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+)
+
+// WorkflowSchemeService handles workflow schemes for the JIRA instance / API.
+//
+// A workflow scheme which is used by a project can not be changed directly.
+// Changes have to be made on a draft of the scheme, which is published afterwards.
+// Either work on the draft explicitly (CreateDraft, SetDraftIssueTypeWorkflow, PublishDraft, ...)
+// or pass updateDraftIfNeeded to let JIRA create and update the draft.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-workflow-schemes/
+type WorkflowSchemeService struct {
+	client *Client
+}
+
+// WorkflowScheme represents a workflow scheme, or the draft of it
+type WorkflowScheme struct {
+	ID                        int64             `json:"id,omitempty" structs:"id,omitempty"`
+	Name                      string            `json:"name,omitempty" structs:"name,omitempty"`
+	Description               string            `json:"description,omitempty" structs:"description,omitempty"`
+	DefaultWorkflow           string            `json:"defaultWorkflow,omitempty" structs:"defaultWorkflow,omitempty"`
+	IssueTypeMappings         map[string]string `json:"issueTypeMappings,omitempty" structs:"issueTypeMappings,omitempty"`
+	OriginalDefaultWorkflow   string            `json:"originalDefaultWorkflow,omitempty" structs:"originalDefaultWorkflow,omitempty"`
+	OriginalIssueTypeMappings map[string]string `json:"originalIssueTypeMappings,omitempty" structs:"originalIssueTypeMappings,omitempty"`
+	Draft                     bool              `json:"draft,omitempty" structs:"draft,omitempty"`
+	LastModified              string            `json:"lastModified,omitempty" structs:"lastModified,omitempty"`
+	UpdateDraftIfNeeded       bool              `json:"updateDraftIfNeeded,omitempty" structs:"updateDraftIfNeeded,omitempty"`
+	Self                      string            `json:"self,omitempty" structs:"self,omitempty"`
+}
+
+// WorkflowSchemeStatusMapping maps the statuses of an issue type, which are not available in the new workflow,
+// to a status of the new workflow when a draft is published
+type WorkflowSchemeStatusMapping struct {
+	IssueTypeID string `json:"issueTypeId" structs:"issueTypeId"`
+	StatusID    string `json:"statusId" structs:"statusId"`
+	NewStatusID string `json:"newStatusId" structs:"newStatusId"`
+}
+
+// GetWithContext returns the workflow scheme with the given ID
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-workflow-schemes/#api-rest-api-2-workflowscheme-id-get
+func (s *WorkflowSchemeService) GetWithContext(ctx context.Context, schemeID int64) (*WorkflowScheme, *Response, error) {
+	return s.get(ctx, fmt.Sprintf("rest/api/2/workflowscheme/%d", schemeID))
+}
+
+// Get wraps GetWithContext using the background context.
+func (s *WorkflowSchemeService) Get(schemeID int64) (*WorkflowScheme, *Response, error) {
+	return s.GetWithContext(context.Background(), schemeID)
+}
+
+// SetIssueTypeWorkflowWithContext assigns the workflow to the issue type in the workflow scheme.
+// If the scheme is used by a project, updateDraftIfNeeded has to be set and the draft of the scheme is updated.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-workflow-schemes/#api-rest-api-2-workflowscheme-id-issuetype-issuetype-put
+func (s *WorkflowSchemeService) SetIssueTypeWorkflowWithContext(ctx context.Context, schemeID int64, issueTypeID, workflow string, updateDraftIfNeeded bool) (*WorkflowScheme, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/workflowscheme/%d/issuetype/%s", schemeID, issueTypeID)
+	return s.setIssueTypeWorkflow(ctx, apiEndpoint, issueTypeID, workflow, updateDraftIfNeeded)
+}
+
+// SetIssueTypeWorkflow wraps SetIssueTypeWorkflowWithContext using the background context.
+func (s *WorkflowSchemeService) SetIssueTypeWorkflow(schemeID int64, issueTypeID, workflow string, updateDraftIfNeeded bool) (*WorkflowScheme, *Response, error) {
+	return s.SetIssueTypeWorkflowWithContext(context.Background(), schemeID, issueTypeID, workflow, updateDraftIfNeeded)
+}
+
+// DeleteIssueTypeWorkflowWithContext removes the workflow assignment of the issue type from the workflow scheme,
+// the issue type uses the default workflow afterwards.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-workflow-schemes/#api-rest-api-2-workflowscheme-id-issuetype-issuetype-delete
+func (s *WorkflowSchemeService) DeleteIssueTypeWorkflowWithContext(ctx context.Context, schemeID int64, issueTypeID string, updateDraftIfNeeded bool) (*WorkflowScheme, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/workflowscheme/%d/issuetype/%s?updateDraftIfNeeded=%t", schemeID, issueTypeID, updateDraftIfNeeded)
+	return s.delete(ctx, apiEndpoint)
+}
+
+// DeleteIssueTypeWorkflow wraps DeleteIssueTypeWorkflowWithContext using the background context.
+func (s *WorkflowSchemeService) DeleteIssueTypeWorkflow(schemeID int64, issueTypeID string, updateDraftIfNeeded bool) (*WorkflowScheme, *Response, error) {
+	return s.DeleteIssueTypeWorkflowWithContext(context.Background(), schemeID, issueTypeID, updateDraftIfNeeded)
+}
+
+// SetDefaultWorkflowWithContext sets the default workflow of the workflow scheme
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-workflow-schemes/#api-rest-api-2-workflowscheme-id-default-put
+func (s *WorkflowSchemeService) SetDefaultWorkflowWithContext(ctx context.Context, schemeID int64, workflow string, updateDraftIfNeeded bool) (*WorkflowScheme, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/workflowscheme/%d/default", schemeID)
+	return s.setDefaultWorkflow(ctx, apiEndpoint, workflow, updateDraftIfNeeded)
+}
+
+// SetDefaultWorkflow wraps SetDefaultWorkflowWithContext using the background context.
+func (s *WorkflowSchemeService) SetDefaultWorkflow(schemeID int64, workflow string, updateDraftIfNeeded bool) (*WorkflowScheme, *Response, error) {
+	return s.SetDefaultWorkflowWithContext(context.Background(), schemeID, workflow, updateDraftIfNeeded)
+}
+
+// DeleteDefaultWorkflowWithContext resets the default workflow of the workflow scheme to "jira"
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-workflow-schemes/#api-rest-api-2-workflowscheme-id-default-delete
+func (s *WorkflowSchemeService) DeleteDefaultWorkflowWithContext(ctx context.Context, schemeID int64, updateDraftIfNeeded bool) (*WorkflowScheme, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/workflowscheme/%d/default?updateDraftIfNeeded=%t", schemeID, updateDraftIfNeeded)
+	return s.delete(ctx, apiEndpoint)
+}
+
+// DeleteDefaultWorkflow wraps DeleteDefaultWorkflowWithContext using the background context.
+func (s *WorkflowSchemeService) DeleteDefaultWorkflow(schemeID int64, updateDraftIfNeeded bool) (*WorkflowScheme, *Response, error) {
+	return s.DeleteDefaultWorkflowWithContext(context.Background(), schemeID, updateDraftIfNeeded)
+}
+
+// CreateDraftWithContext creates a draft of the workflow scheme, which is a copy of the active scheme
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-workflow-scheme-drafts/#api-rest-api-2-workflowscheme-id-createdraft-post
+func (s *WorkflowSchemeService) CreateDraftWithContext(ctx context.Context, schemeID int64) (*WorkflowScheme, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/workflowscheme/%d/createdraft", schemeID)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	draft := new(WorkflowScheme)
+	resp, err := s.client.Do(req, draft)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return draft, resp, nil
+}
+
+// CreateDraft wraps CreateDraftWithContext using the background context.
+func (s *WorkflowSchemeService) CreateDraft(schemeID int64) (*WorkflowScheme, *Response, error) {
+	return s.CreateDraftWithContext(context.Background(), schemeID)
+}
+
+// GetDraftWithContext returns the draft of the workflow scheme
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-workflow-scheme-drafts/#api-rest-api-2-workflowscheme-id-draft-get
+func (s *WorkflowSchemeService) GetDraftWithContext(ctx context.Context, schemeID int64) (*WorkflowScheme, *Response, error) {
+	return s.get(ctx, fmt.Sprintf("rest/api/2/workflowscheme/%d/draft", schemeID))
+}
+
+// GetDraft wraps GetDraftWithContext using the background context.
+func (s *WorkflowSchemeService) GetDraft(schemeID int64) (*WorkflowScheme, *Response, error) {
+	return s.GetDraftWithContext(context.Background(), schemeID)
+}
+
+// DeleteDraftWithContext discards the draft of the workflow scheme
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-workflow-scheme-drafts/#api-rest-api-2-workflowscheme-id-draft-delete
+func (s *WorkflowSchemeService) DeleteDraftWithContext(ctx context.Context, schemeID int64) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/workflowscheme/%d/draft", schemeID)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// DeleteDraft wraps DeleteDraftWithContext using the background context.
+func (s *WorkflowSchemeService) DeleteDraft(schemeID int64) (*Response, error) {
+	return s.DeleteDraftWithContext(context.Background(), schemeID)
+}
+
+// SetDraftIssueTypeWorkflowWithContext assigns the workflow to the issue type in the draft of the workflow scheme
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-workflow-scheme-drafts/#api-rest-api-2-workflowscheme-id-draft-issuetype-issuetype-put
+func (s *WorkflowSchemeService) SetDraftIssueTypeWorkflowWithContext(ctx context.Context, schemeID int64, issueTypeID, workflow string) (*WorkflowScheme, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/workflowscheme/%d/draft/issuetype/%s", schemeID, issueTypeID)
+	return s.setIssueTypeWorkflow(ctx, apiEndpoint, issueTypeID, workflow, false)
+}
+
+// SetDraftIssueTypeWorkflow wraps SetDraftIssueTypeWorkflowWithContext using the background context.
+func (s *WorkflowSchemeService) SetDraftIssueTypeWorkflow(schemeID int64, issueTypeID, workflow string) (*WorkflowScheme, *Response, error) {
+	return s.SetDraftIssueTypeWorkflowWithContext(context.Background(), schemeID, issueTypeID, workflow)
+}
+
+// DeleteDraftIssueTypeWorkflowWithContext removes the workflow assignment of the issue type from the draft of the workflow scheme
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-workflow-scheme-drafts/#api-rest-api-2-workflowscheme-id-draft-issuetype-issuetype-delete
+func (s *WorkflowSchemeService) DeleteDraftIssueTypeWorkflowWithContext(ctx context.Context, schemeID int64, issueTypeID string) (*WorkflowScheme, *Response, error) {
+	return s.delete(ctx, fmt.Sprintf("rest/api/2/workflowscheme/%d/draft/issuetype/%s", schemeID, issueTypeID))
+}
+
+// DeleteDraftIssueTypeWorkflow wraps DeleteDraftIssueTypeWorkflowWithContext using the background context.
+func (s *WorkflowSchemeService) DeleteDraftIssueTypeWorkflow(schemeID int64, issueTypeID string) (*WorkflowScheme, *Response, error) {
+	return s.DeleteDraftIssueTypeWorkflowWithContext(context.Background(), schemeID, issueTypeID)
+}
+
+// SetDraftDefaultWorkflowWithContext sets the default workflow of the draft of the workflow scheme
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-workflow-scheme-drafts/#api-rest-api-2-workflowscheme-id-draft-default-put
+func (s *WorkflowSchemeService) SetDraftDefaultWorkflowWithContext(ctx context.Context, schemeID int64, workflow string) (*WorkflowScheme, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/workflowscheme/%d/draft/default", schemeID)
+	return s.setDefaultWorkflow(ctx, apiEndpoint, workflow, false)
+}
+
+// SetDraftDefaultWorkflow wraps SetDraftDefaultWorkflowWithContext using the background context.
+func (s *WorkflowSchemeService) SetDraftDefaultWorkflow(schemeID int64, workflow string) (*WorkflowScheme, *Response, error) {
+	return s.SetDraftDefaultWorkflowWithContext(context.Background(), schemeID, workflow)
+}
+
+// DeleteDraftDefaultWorkflowWithContext resets the default workflow of the draft of the workflow scheme to "jira"
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-workflow-scheme-drafts/#api-rest-api-2-workflowscheme-id-draft-default-delete
+func (s *WorkflowSchemeService) DeleteDraftDefaultWorkflowWithContext(ctx context.Context, schemeID int64) (*WorkflowScheme, *Response, error) {
+	return s.delete(ctx, fmt.Sprintf("rest/api/2/workflowscheme/%d/draft/default", schemeID))
+}
+
+// DeleteDraftDefaultWorkflow wraps DeleteDraftDefaultWorkflowWithContext using the background context.
+func (s *WorkflowSchemeService) DeleteDraftDefaultWorkflow(schemeID int64) (*WorkflowScheme, *Response, error) {
+	return s.DeleteDraftDefaultWorkflowWithContext(context.Background(), schemeID)
+}
+
+// PublishDraftWithContext publishes the draft of the workflow scheme, replacing the active scheme.
+// statusMappings are required for statuses of issues, which do not exist in the new workflows.
+// Publishing is done asynchronously by JIRA: the returned response points to the task in its Location header.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-workflow-scheme-drafts/#api-rest-api-2-workflowscheme-id-draft-publish-post
+func (s *WorkflowSchemeService) PublishDraftWithContext(ctx context.Context, schemeID int64, statusMappings []WorkflowSchemeStatusMapping) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/workflowscheme/%d/draft/publish", schemeID)
+	payload := struct {
+		StatusMappings []WorkflowSchemeStatusMapping `json:"statusMappings,omitempty"`
+	}{
+		StatusMappings: statusMappings,
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// PublishDraft wraps PublishDraftWithContext using the background context.
+func (s *WorkflowSchemeService) PublishDraft(schemeID int64, statusMappings []WorkflowSchemeStatusMapping) (*Response, error) {
+	return s.PublishDraftWithContext(context.Background(), schemeID, statusMappings)
+}
+
+func (s *WorkflowSchemeService) get(ctx context.Context, apiEndpoint string) (*WorkflowScheme, *Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scheme := new(WorkflowScheme)
+	resp, err := s.client.Do(req, scheme)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return scheme, resp, nil
+}
+
+func (s *WorkflowSchemeService) setIssueTypeWorkflow(ctx context.Context, apiEndpoint, issueTypeID, workflow string, updateDraftIfNeeded bool) (*WorkflowScheme, *Response, error) {
+	payload := struct {
+		IssueType           string `json:"issueType"`
+		Workflow            string `json:"workflow"`
+		UpdateDraftIfNeeded bool   `json:"updateDraftIfNeeded,omitempty"`
+	}{
+		IssueType:           issueTypeID,
+		Workflow:            workflow,
+		UpdateDraftIfNeeded: updateDraftIfNeeded,
+	}
+	return s.put(ctx, apiEndpoint, payload)
+}
+
+func (s *WorkflowSchemeService) setDefaultWorkflow(ctx context.Context, apiEndpoint, workflow string, updateDraftIfNeeded bool) (*WorkflowScheme, *Response, error) {
+	payload := struct {
+		Workflow            string `json:"workflow"`
+		UpdateDraftIfNeeded bool   `json:"updateDraftIfNeeded,omitempty"`
+	}{
+		Workflow:            workflow,
+		UpdateDraftIfNeeded: updateDraftIfNeeded,
+	}
+	return s.put(ctx, apiEndpoint, payload)
+}
+
+func (s *WorkflowSchemeService) put(ctx context.Context, apiEndpoint string, payload interface{}) (*WorkflowScheme, *Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scheme := new(WorkflowScheme)
+	resp, err := s.client.Do(req, scheme)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return scheme, resp, nil
+}
+
+func (s *WorkflowSchemeService) delete(ctx context.Context, apiEndpoint string) (*WorkflowScheme, *Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scheme := new(WorkflowScheme)
+	resp, err := s.client.Do(req, scheme)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return scheme, resp, nil
+}
@@ -0,0 +1,115 @@
+package jira
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestWorkflowSchemeService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/workflowscheme/101010"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"id":101010,"name":"Example workflow scheme","defaultWorkflow":"jira","issueTypeMappings":{"10000":"scrum workflow"}}`)
+	})
+
+	scheme, _, err := testClient.WorkflowScheme.Get(101010)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if scheme.DefaultWorkflow != "jira" || scheme.IssueTypeMappings["10000"] != "scrum workflow" {
+		t.Errorf("Unexpected scheme %+v", scheme)
+	}
+}
+
+func TestWorkflowSchemeService_SetIssueTypeWorkflow(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/workflowscheme/101010/issuetype/10000"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, testAPIEndpoint)
+		body, _ := ioutil.ReadAll(r.Body)
+		if expected := `{"issueType":"10000","workflow":"scrum workflow","updateDraftIfNeeded":true}` + "\n"; string(body) != expected {
+			t.Errorf("Unexpected body %s", body)
+		}
+		fmt.Fprint(w, `{"id":17218781,"name":"Example workflow scheme","draft":true,"issueTypeMappings":{"10000":"scrum workflow"}}`)
+	})
+
+	draft, _, err := testClient.WorkflowScheme.SetIssueTypeWorkflow(101010, "10000", "scrum workflow", true)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if !draft.Draft {
+		t.Errorf("Expected draft to be returned, got %+v", draft)
+	}
+}
+
+func TestWorkflowSchemeService_DeleteDefaultWorkflow(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/workflowscheme/101010/default"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, testAPIEndpoint+"?updateDraftIfNeeded=false")
+		fmt.Fprint(w, `{"id":101010,"defaultWorkflow":"jira"}`)
+	})
+
+	if _, _, err := testClient.WorkflowScheme.DeleteDefaultWorkflow(101010, false); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestWorkflowSchemeService_DraftFlow(t *testing.T) {
+	setup()
+	defer teardown()
+	var calls []string
+	testMux.HandleFunc("/rest/api/2/workflowscheme/101010/createdraft", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		calls = append(calls, "createdraft")
+		fmt.Fprint(w, `{"id":17218781,"draft":true}`)
+	})
+	testMux.HandleFunc("/rest/api/2/workflowscheme/101010/draft/default", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		body, _ := ioutil.ReadAll(r.Body)
+		if expected := `{"workflow":"jira"}` + "\n"; string(body) != expected {
+			t.Errorf("Unexpected body %s", body)
+		}
+		calls = append(calls, "default")
+		fmt.Fprint(w, `{"id":17218781,"draft":true,"defaultWorkflow":"jira"}`)
+	})
+	testMux.HandleFunc("/rest/api/2/workflowscheme/101010/draft/issuetype/10000", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		calls = append(calls, "issuetype")
+		fmt.Fprint(w, `{"id":17218781,"draft":true}`)
+	})
+	testMux.HandleFunc("/rest/api/2/workflowscheme/101010/draft/publish", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		body, _ := ioutil.ReadAll(r.Body)
+		if expected := `{"statusMappings":[{"issueTypeId":"10001","statusId":"3","newStatusId":"1"}]}` + "\n"; string(body) != expected {
+			t.Errorf("Unexpected body %s", body)
+		}
+		calls = append(calls, "publish")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, _, err := testClient.WorkflowScheme.CreateDraft(101010); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if _, _, err := testClient.WorkflowScheme.SetDraftDefaultWorkflow(101010, "jira"); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if _, _, err := testClient.WorkflowScheme.DeleteDraftIssueTypeWorkflow(101010, "10000"); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if _, err := testClient.WorkflowScheme.PublishDraft(101010, []WorkflowSchemeStatusMapping{{IssueTypeID: "10001", StatusID: "3", NewStatusID: "1"}}); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if fmt.Sprint(calls) != "[createdraft default issuetype publish]" {
+		t.Errorf("Unexpected calls %v", calls)
+	}
+}